@@ -59,6 +59,24 @@ type ServerInterface interface {
 	// (GET /sandboxes/{sandboxID})
 	GetSandboxesSandboxID(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /sandboxes/{sandboxID}/checkpoints)
+	GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID SandboxID)
+
+	// (POST /sandboxes/{sandboxID}/checkpoints)
+	PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID SandboxID)
+
+	// (DELETE /sandboxes/{sandboxID}/checkpoints/{checkpointName})
+	DeleteSandboxesSandboxIDCheckpointsCheckpointName(c *gin.Context, sandboxID SandboxID, checkpointName CheckpointName)
+
+	// (POST /sandboxes/{sandboxID}/checkpoints/{checkpointName}/restore)
+	PostSandboxesSandboxIDCheckpointsCheckpointNameRestore(c *gin.Context, sandboxID SandboxID, checkpointName CheckpointName)
+
+	// (POST /sandboxes/{sandboxID}/fork)
+	PostSandboxesSandboxIDFork(c *gin.Context, sandboxID SandboxID)
+
+	// (PUT /sandboxes/{sandboxID}/ingress)
+	PutSandboxesSandboxIDIngress(c *gin.Context, sandboxID SandboxID)
+
 	// (GET /sandboxes/{sandboxID}/logs)
 	GetSandboxesSandboxIDLogs(c *gin.Context, sandboxID SandboxID, params GetSandboxesSandboxIDLogsParams)
 
@@ -71,9 +89,21 @@ type ServerInterface interface {
 	// (POST /sandboxes/{sandboxID}/refreshes)
 	PostSandboxesSandboxIDRefreshes(c *gin.Context, sandboxID SandboxID)
 
+	// (PATCH /sandboxes/{sandboxID}/resources)
+	PatchSandboxesSandboxIDResources(c *gin.Context, sandboxID SandboxID)
+
 	// (POST /sandboxes/{sandboxID}/resume)
 	PostSandboxesSandboxIDResume(c *gin.Context, sandboxID SandboxID)
 
+	// (GET /sandboxes/{sandboxID}/schedules)
+	GetSandboxesSandboxIDSchedules(c *gin.Context, sandboxID SandboxID)
+
+	// (POST /sandboxes/{sandboxID}/schedules)
+	PostSandboxesSandboxIDSchedules(c *gin.Context, sandboxID SandboxID)
+
+	// (DELETE /sandboxes/{sandboxID}/schedules/{scheduleID})
+	DeleteSandboxesSandboxIDSchedulesScheduleID(c *gin.Context, sandboxID SandboxID, scheduleID ScheduleID)
+
 	// (POST /sandboxes/{sandboxID}/timeout)
 	PostSandboxesSandboxIDTimeout(c *gin.Context, sandboxID SandboxID)
 
@@ -104,12 +134,42 @@ type ServerInterface interface {
 	// (POST /templates/{templateID}/builds/{buildID})
 	PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID TemplateID, buildID BuildID)
 
+	// (GET /templates/{templateID}/builds/{buildID}/export)
+	GetTemplatesTemplateIDBuildsBuildIDExport(c *gin.Context, templateID TemplateID, buildID BuildID, params GetTemplatesTemplateIDBuildsBuildIDExportParams)
+
+	// (GET /templates/{templateID}/builds/{buildID}/logs/stream)
+	GetTemplatesTemplateIDBuildsBuildIDLogsStream(c *gin.Context, templateID TemplateID, buildID BuildID, params GetTemplatesTemplateIDBuildsBuildIDLogsStreamParams)
+
+	// (GET /templates/{templateID}/builds/{buildID}/provenance)
+	GetTemplatesTemplateIDBuildsBuildIDProvenance(c *gin.Context, templateID TemplateID, buildID BuildID)
+
 	// (GET /templates/{templateID}/builds/{buildID}/status)
 	GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, templateID TemplateID, buildID BuildID, params GetTemplatesTemplateIDBuildsBuildIDStatusParams)
 
+	// (GET /templates/{templateID}/builds/{buildID}/verify)
+	GetTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context, templateID TemplateID, buildID BuildID)
+
+	// (POST /templates/{templateID}/builds/{buildID}/verify)
+	PostTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context, templateID TemplateID, buildID BuildID)
+
+	// (DELETE /templates/{templateID}/cache)
+	DeleteTemplatesTemplateIDCache(c *gin.Context, templateID TemplateID, params DeleteTemplatesTemplateIDCacheParams)
+
+	// (GET /templates/{templateID}/cache)
+	GetTemplatesTemplateIDCache(c *gin.Context, templateID TemplateID)
+
 	// (GET /templates/{templateID}/files/{hash})
 	GetTemplatesTemplateIDFilesHash(c *gin.Context, templateID TemplateID, hash string)
 
+	// (GET /templates/{templateID}/tags)
+	GetTemplatesTemplateIDTags(c *gin.Context, templateID TemplateID)
+
+	// (DELETE /templates/{templateID}/tags/{tag})
+	DeleteTemplatesTemplateIDTagsTag(c *gin.Context, templateID TemplateID, tag Tag)
+
+	// (PUT /templates/{templateID}/tags/{tag})
+	PutTemplatesTemplateIDTagsTag(c *gin.Context, templateID TemplateID, tag Tag)
+
 	// (GET /v2/sandboxes)
 	GetV2Sandboxes(c *gin.Context, params GetV2SandboxesParams)
 
@@ -118,6 +178,18 @@ type ServerInterface interface {
 
 	// (POST /v2/templates/{templateID}/builds/{buildID})
 	PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID TemplateID, buildID BuildID)
+
+	// (GET /volumes)
+	GetVolumes(c *gin.Context)
+
+	// (POST /volumes)
+	PostVolumes(c *gin.Context)
+
+	// (DELETE /volumes/{volumeID})
+	DeleteVolumesVolumeID(c *gin.Context, volumeID VolumeID)
+
+	// (GET /volumes/{volumeID})
+	GetVolumesVolumeID(c *gin.Context, volumeID VolumeID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -501,8 +573,8 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxID(c *gin.Context) {
 	siw.Handler.GetSandboxesSandboxID(c, sandboxID)
 }
 
-// GetSandboxesSandboxIDLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
+// GetSandboxesSandboxIDCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDCheckpoints(c *gin.Context) {
 
 	var err error
 
@@ -521,25 +593,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesSandboxIDLogsParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -547,11 +600,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
+	siw.Handler.GetSandboxesSandboxIDCheckpoints(c, sandboxID)
 }
 
-// GetSandboxesSandboxIDMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
+// PostSandboxesSandboxIDCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDCheckpoints(c *gin.Context) {
 
 	var err error
 
@@ -570,25 +623,6 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSandboxesSandboxIDMetricsParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "end" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -596,11 +630,11 @@ func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context)
 		}
 	}
 
-	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID, params)
+	siw.Handler.PostSandboxesSandboxIDCheckpoints(c, sandboxID)
 }
 
-// PostSandboxesSandboxIDPause operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
+// DeleteSandboxesSandboxIDCheckpointsCheckpointName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxIDCheckpointsCheckpointName(c *gin.Context) {
 
 	var err error
 
@@ -613,6 +647,15 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "checkpointName" -------------
+	var checkpointName CheckpointName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checkpointName", c.Param("checkpointName"), &checkpointName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checkpointName: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
@@ -626,11 +669,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
+	siw.Handler.DeleteSandboxesSandboxIDCheckpointsCheckpointName(c, sandboxID, checkpointName)
 }
 
-// PostSandboxesSandboxIDRefreshes operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
+// PostSandboxesSandboxIDCheckpointsCheckpointNameRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDCheckpointsCheckpointNameRestore(c *gin.Context) {
 
 	var err error
 
@@ -643,6 +686,15 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Contex
 		return
 	}
 
+	// ------------- Path parameter "checkpointName" -------------
+	var checkpointName CheckpointName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checkpointName", c.Param("checkpointName"), &checkpointName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checkpointName: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
@@ -656,11 +708,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Contex
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDCheckpointsCheckpointNameRestore(c, sandboxID, checkpointName)
 }
 
-// PostSandboxesSandboxIDResume operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
+// PostSandboxesSandboxIDFork operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDFork(c *gin.Context) {
 
 	var err error
 
@@ -686,11 +738,11 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
+	siw.Handler.PostSandboxesSandboxIDFork(c, sandboxID)
 }
 
-// PostSandboxesSandboxIDTimeout operation middleware
-func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
+// PutSandboxesSandboxIDIngress operation middleware
+func (siw *ServerInterfaceWrapper) PutSandboxesSandboxIDIngress(c *gin.Context) {
 
 	var err error
 
@@ -716,37 +768,20 @@ func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
-}
-
-// GetTeams operation middleware
-func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
-
-	c.Set(AccessTokenAuthScopes, []string{})
-
-	c.Set(Supabase1TokenAuthScopes, []string{})
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetTeams(c)
+	siw.Handler.PutSandboxesSandboxIDIngress(c, sandboxID)
 }
 
-// GetTeamsTeamIDMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
+// GetSandboxesSandboxIDLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDLogs(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID TeamID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -757,7 +792,7 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamsTeamIDMetricsParams
+	var params GetSandboxesSandboxIDLogsParams
 
 	// ------------- Optional query parameter "start" -------------
 
@@ -767,11 +802,11 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "end" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -782,20 +817,20 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamsTeamIDMetrics(c, teamID, params)
+	siw.Handler.GetSandboxesSandboxIDLogs(c, sandboxID, params)
 }
 
-// GetTeamsTeamIDMetricsMax operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
+// GetSandboxesSandboxIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDMetrics(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "teamID" -------------
-	var teamID TeamID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -806,7 +841,7 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
 	c.Set(Supabase2TeamAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamsTeamIDMetricsMaxParams
+	var params GetSandboxesSandboxIDMetricsParams
 
 	// ------------- Optional query parameter "start" -------------
 
@@ -824,21 +859,6 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
 		return
 	}
 
-	// ------------- Required query parameter "metric" -------------
-
-	if paramValue := c.Query("metric"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument metric is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "metric", c.Request.URL.Query(), &params.Metric)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -846,29 +866,29 @@ func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamsTeamIDMetricsMax(c, teamID, params)
+	siw.Handler.GetSandboxesSandboxIDMetrics(c, sandboxID, params)
 }
 
-// GetTemplates operation middleware
-func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+// PostSandboxesSandboxIDPause operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDPause(c *gin.Context) {
 
 	var err error
 
-	c.Set(AccessTokenAuthScopes, []string{})
-
-	c.Set(Supabase1TokenAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTemplatesParams
-
-	// ------------- Optional query parameter "teamID" -------------
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -876,16 +896,29 @@ func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTemplates(c, params)
+	siw.Handler.PostSandboxesSandboxIDPause(c, sandboxID)
 }
 
-// PostTemplates operation middleware
-func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
+// PostSandboxesSandboxIDRefreshes operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDRefreshes(c *gin.Context) {
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -893,29 +926,29 @@ func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTemplates(c)
+	siw.Handler.PostSandboxesSandboxIDRefreshes(c, sandboxID)
 }
 
-// DeleteTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
+// PatchSandboxesSandboxIDResources operation middleware
+func (siw *ServerInterfaceWrapper) PatchSandboxesSandboxIDResources(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "templateID" -------------
-	var templateID TemplateID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
-	c.Set(AccessTokenAuthScopes, []string{})
-
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -923,27 +956,29 @@ func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTemplatesTemplateID(c, templateID)
+	siw.Handler.PatchSandboxesSandboxIDResources(c, sandboxID)
 }
 
-// PatchTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
+// PostSandboxesSandboxIDResume operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDResume(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "templateID" -------------
-	var templateID TemplateID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -951,27 +986,29 @@ func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchTemplatesTemplateID(c, templateID)
+	siw.Handler.PostSandboxesSandboxIDResume(c, sandboxID)
 }
 
-// PostTemplatesTemplateID operation middleware
-func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
+// GetSandboxesSandboxIDSchedules operation middleware
+func (siw *ServerInterfaceWrapper) GetSandboxesSandboxIDSchedules(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "templateID" -------------
-	var templateID TemplateID
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(AccessTokenAuthScopes, []string{})
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -979,10 +1016,372 @@ func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTemplatesTemplateID(c, templateID)
+	siw.Handler.GetSandboxesSandboxIDSchedules(c, sandboxID)
 }
 
-// PostTemplatesTemplateIDBuildsBuildID operation middleware
+// PostSandboxesSandboxIDSchedules operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDSchedules(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDSchedules(c, sandboxID)
+}
+
+// DeleteSandboxesSandboxIDSchedulesScheduleID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSandboxesSandboxIDSchedulesScheduleID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "scheduleID" -------------
+	var scheduleID ScheduleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleID", c.Param("scheduleID"), &scheduleID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSandboxesSandboxIDSchedulesScheduleID(c, sandboxID, scheduleID)
+}
+
+// PostSandboxesSandboxIDTimeout operation middleware
+func (siw *ServerInterfaceWrapper) PostSandboxesSandboxIDTimeout(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sandboxID" -------------
+	var sandboxID SandboxID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sandboxID", c.Param("sandboxID"), &sandboxID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sandboxID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSandboxesSandboxIDTimeout(c, sandboxID)
+}
+
+// GetTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetTeams(c *gin.Context) {
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeams(c)
+}
+
+// GetTeamsTeamIDMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetrics(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDMetricsParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDMetrics(c, teamID, params)
+}
+
+// GetTeamsTeamIDMetricsMax operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamsTeamIDMetricsMax(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "teamID" -------------
+	var teamID TeamID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "teamID", c.Param("teamID"), &teamID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamsTeamIDMetricsMaxParams
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", c.Request.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", c.Request.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "metric" -------------
+
+	if paramValue := c.Query("metric"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument metric is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "metric", c.Request.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamsTeamIDMetricsMax(c, teamID, params)
+}
+
+// GetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplates(c *gin.Context) {
+
+	var err error
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesParams
+
+	// ------------- Optional query parameter "teamID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamID", c.Request.URL.Query(), &params.TeamID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter teamID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplates(c, params)
+}
+
+// PostTemplates operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplates(c *gin.Context) {
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTemplates(c)
+}
+
+// DeleteTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTemplatesTemplateID(c, templateID)
+}
+
+// PatchTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTemplatesTemplateID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchTemplatesTemplateID(c, templateID)
+}
+
+// PostTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTemplatesTemplateID(c, templateID)
+}
+
+// PostTemplatesTemplateIDBuildsBuildID operation middleware
 func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context) {
 
 	var err error
@@ -1016,11 +1415,255 @@ func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildID(c *gin.C
 		}
 	}
 
-	siw.Handler.PostTemplatesTemplateIDBuildsBuildID(c, templateID, buildID)
+	siw.Handler.PostTemplatesTemplateIDBuildsBuildID(c, templateID, buildID)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDExport operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesTemplateIDBuildsBuildIDExportParams
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDExport(c, templateID, buildID, params)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDLogsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDLogsStream(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesTemplateIDBuildsBuildIDLogsStreamParams
+
+	// ------------- Optional query parameter "logsOffset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "logsOffset", c.Request.URL.Query(), &params.LogsOffset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter logsOffset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDLogsStream(c, templateID, buildID, params)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDProvenance operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDProvenance(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDProvenance(c, templateID, buildID)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesTemplateIDBuildsBuildIDStatusParams
+
+	// ------------- Optional query parameter "logsOffset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "logsOffset", c.Request.URL.Query(), &params.LogsOffset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter logsOffset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDStatus(c, templateID, buildID, params)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "buildID" -------------
+	var buildID BuildID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "buildID", c.Param("buildID"), &buildID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter buildID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDVerify(c, templateID, buildID)
 }
 
-// GetTemplatesTemplateIDBuildsBuildIDStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context) {
+// PostTemplatesTemplateIDBuildsBuildIDVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context) {
 
 	var err error
 
@@ -1048,25 +1691,77 @@ func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *
 
 	c.Set(Supabase1TokenAuthScopes, []string{})
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTemplatesTemplateIDBuildsBuildIDVerify(c, templateID, buildID)
+}
+
+// DeleteTemplatesTemplateIDCache operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateIDCache(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTemplatesTemplateIDBuildsBuildIDStatusParams
+	var params DeleteTemplatesTemplateIDCacheParams
 
-	// ------------- Optional query parameter "logsOffset" -------------
+	// ------------- Optional query parameter "hash" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "logsOffset", c.Request.URL.Query(), &params.LogsOffset)
+	err = runtime.BindQueryParameter("form", true, false, "hash", c.Request.URL.Query(), &params.Hash)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter logsOffset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter hash: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "level" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "level", c.Request.URL.Query(), &params.Level)
+	siw.Handler.DeleteTemplatesTemplateIDCache(c, templateID, params)
+}
+
+// GetTemplatesTemplateIDCache operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDCache(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter level: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1074,7 +1769,7 @@ func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDBuildsBuildIDStatus(c *
 		}
 	}
 
-	siw.Handler.GetTemplatesTemplateIDBuildsBuildIDStatus(c, templateID, buildID, params)
+	siw.Handler.GetTemplatesTemplateIDCache(c, templateID)
 }
 
 // GetTemplatesTemplateIDFilesHash operation middleware
@@ -1116,6 +1811,114 @@ func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDFilesHash(c *gin.Contex
 	siw.Handler.GetTemplatesTemplateIDFilesHash(c, templateID, hash)
 }
 
+// GetTemplatesTemplateIDTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplatesTemplateIDTags(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplatesTemplateIDTags(c, templateID)
+}
+
+// DeleteTemplatesTemplateIDTagsTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplatesTemplateIDTagsTag(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag Tag
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", c.Param("tag"), &tag, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTemplatesTemplateIDTagsTag(c, templateID, tag)
+}
+
+// PutTemplatesTemplateIDTagsTag operation middleware
+func (siw *ServerInterfaceWrapper) PutTemplatesTemplateIDTagsTag(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateID" -------------
+	var templateID TemplateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateID", c.Param("templateID"), &templateID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag Tag
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", c.Param("tag"), &tag, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AccessTokenAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTemplatesTemplateIDTagsTag(c, templateID, tag)
+}
+
 // GetV2Sandboxes operation middleware
 func (siw *ServerInterfaceWrapper) GetV2Sandboxes(c *gin.Context) {
 
@@ -1226,6 +2029,104 @@ func (siw *ServerInterfaceWrapper) PostV2TemplatesTemplateIDBuildsBuildID(c *gin
 	siw.Handler.PostV2TemplatesTemplateIDBuildsBuildID(c, templateID, buildID)
 }
 
+// GetVolumes operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumes(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumes(c)
+}
+
+// PostVolumes operation middleware
+func (siw *ServerInterfaceWrapper) PostVolumes(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVolumes(c)
+}
+
+// DeleteVolumesVolumeID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVolumesVolumeID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteVolumesVolumeID(c, volumeID)
+}
+
+// GetVolumesVolumeID operation middleware
+func (siw *ServerInterfaceWrapper) GetVolumesVolumeID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "volumeID" -------------
+	var volumeID VolumeID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volumeID", c.Param("volumeID"), &volumeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter volumeID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	c.Set(Supabase1TokenAuthScopes, []string{})
+
+	c.Set(Supabase2TeamAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVolumesVolumeID(c, volumeID)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -1268,11 +2169,21 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/sandboxes/metrics", wrapper.GetSandboxesMetrics)
 	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID", wrapper.DeleteSandboxesSandboxID)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID", wrapper.GetSandboxesSandboxID)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/checkpoints", wrapper.GetSandboxesSandboxIDCheckpoints)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/checkpoints", wrapper.PostSandboxesSandboxIDCheckpoints)
+	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID/checkpoints/:checkpointName", wrapper.DeleteSandboxesSandboxIDCheckpointsCheckpointName)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/checkpoints/:checkpointName/restore", wrapper.PostSandboxesSandboxIDCheckpointsCheckpointNameRestore)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/fork", wrapper.PostSandboxesSandboxIDFork)
+	router.PUT(options.BaseURL+"/sandboxes/:sandboxID/ingress", wrapper.PutSandboxesSandboxIDIngress)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/logs", wrapper.GetSandboxesSandboxIDLogs)
 	router.GET(options.BaseURL+"/sandboxes/:sandboxID/metrics", wrapper.GetSandboxesSandboxIDMetrics)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/pause", wrapper.PostSandboxesSandboxIDPause)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/refreshes", wrapper.PostSandboxesSandboxIDRefreshes)
+	router.PATCH(options.BaseURL+"/sandboxes/:sandboxID/resources", wrapper.PatchSandboxesSandboxIDResources)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/resume", wrapper.PostSandboxesSandboxIDResume)
+	router.GET(options.BaseURL+"/sandboxes/:sandboxID/schedules", wrapper.GetSandboxesSandboxIDSchedules)
+	router.POST(options.BaseURL+"/sandboxes/:sandboxID/schedules", wrapper.PostSandboxesSandboxIDSchedules)
+	router.DELETE(options.BaseURL+"/sandboxes/:sandboxID/schedules/:scheduleID", wrapper.DeleteSandboxesSandboxIDSchedulesScheduleID)
 	router.POST(options.BaseURL+"/sandboxes/:sandboxID/timeout", wrapper.PostSandboxesSandboxIDTimeout)
 	router.GET(options.BaseURL+"/teams", wrapper.GetTeams)
 	router.GET(options.BaseURL+"/teams/:teamID/metrics", wrapper.GetTeamsTeamIDMetrics)
@@ -1283,9 +2194,23 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PATCH(options.BaseURL+"/templates/:templateID", wrapper.PatchTemplatesTemplateID)
 	router.POST(options.BaseURL+"/templates/:templateID", wrapper.PostTemplatesTemplateID)
 	router.POST(options.BaseURL+"/templates/:templateID/builds/:buildID", wrapper.PostTemplatesTemplateIDBuildsBuildID)
+	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/export", wrapper.GetTemplatesTemplateIDBuildsBuildIDExport)
+	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/logs/stream", wrapper.GetTemplatesTemplateIDBuildsBuildIDLogsStream)
+	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/provenance", wrapper.GetTemplatesTemplateIDBuildsBuildIDProvenance)
 	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/status", wrapper.GetTemplatesTemplateIDBuildsBuildIDStatus)
+	router.GET(options.BaseURL+"/templates/:templateID/builds/:buildID/verify", wrapper.GetTemplatesTemplateIDBuildsBuildIDVerify)
+	router.POST(options.BaseURL+"/templates/:templateID/builds/:buildID/verify", wrapper.PostTemplatesTemplateIDBuildsBuildIDVerify)
+	router.DELETE(options.BaseURL+"/templates/:templateID/cache", wrapper.DeleteTemplatesTemplateIDCache)
+	router.GET(options.BaseURL+"/templates/:templateID/cache", wrapper.GetTemplatesTemplateIDCache)
 	router.GET(options.BaseURL+"/templates/:templateID/files/:hash", wrapper.GetTemplatesTemplateIDFilesHash)
+	router.GET(options.BaseURL+"/templates/:templateID/tags", wrapper.GetTemplatesTemplateIDTags)
+	router.DELETE(options.BaseURL+"/templates/:templateID/tags/:tag", wrapper.DeleteTemplatesTemplateIDTagsTag)
+	router.PUT(options.BaseURL+"/templates/:templateID/tags/:tag", wrapper.PutTemplatesTemplateIDTagsTag)
 	router.GET(options.BaseURL+"/v2/sandboxes", wrapper.GetV2Sandboxes)
 	router.POST(options.BaseURL+"/v2/templates", wrapper.PostV2Templates)
 	router.POST(options.BaseURL+"/v2/templates/:templateID/builds/:buildID", wrapper.PostV2TemplatesTemplateIDBuildsBuildID)
+	router.GET(options.BaseURL+"/volumes", wrapper.GetVolumes)
+	router.POST(options.BaseURL+"/volumes", wrapper.PostVolumes)
+	router.DELETE(options.BaseURL+"/volumes/:volumeID", wrapper.DeleteVolumesVolumeID)
+	router.GET(options.BaseURL+"/volumes/:volumeID", wrapper.GetVolumesVolumeID)
 }
@@ -18,110 +18,202 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w92W7cOLa/Qujeh2lAsR0n07htYB4cJ+nJdJw2Unb6AmkjoKVTVRxLlJqkvEzgfx9w",
-	"kyiJlFTl8pLET4lLFJez8ez6GiVFXhYUqODR3teoxAznIICpv3CSAOfHxTnQd6/lD4RGe1GJxTKKI4pz",
-	"iPY6Y+KIwV8VYZBGe4JVEEc8WUKO5cviupQvcMEIXUQ3N3GES/IbXIento9Xm/WsIlkanNQ+XW1OWqQQ",
-	"nNI8XG1Gjml6VlwFJ22erzavAJwHJzUPV50xLzMsYGDWesAqM9/IwbwsKAdFbS93duQ/SUEFUKHorywz",
-	"kmBBCrr9b15Q+Vsz3/8ymEd70f9sNyS8rZ/y7TeMFUyvkQJPGCnlJNFe9AqnSG4RuIhu4ujlzvO7X3O/",
-	"EkugwsyKQI+Ti7+4+8XfFuyMpClQveLLu1/xQyHQvKhoqlf85e5XPCjoPCOJwujf74OKZsAugFlM3lgq",
-	"V2S8/8fsIywIF+xaSVRWlMAE0TSOL/m+EphSsKXylw6p/DFDegD6Da7Ru9doXjD05uAjwi0iiuIuO8Vy",
-	"brmwPmB/Wv0MXS6BARJLULMys1NEOMqKBAtIA1PPIGEg6s3719CD3BNM377+oTvr8XUJqJg3G+1NBLTK",
-	"o73Pco/RaeyRX41E+qyfxl00eA/oArSZtzj7N2hCeyUvkvfF4g31YjqDC8jGCOx9sXivxt3EUQ6c44UH",
-	"BO+LBTIPkSVrD/y4gLL/8kxAiQhVCFdXHypZobDDQMrsFIlCPcyKBQJ1FB9uSA5c4NyzwLF9JLHUnWhe",
-	"sByLaC9KsYBncpZoFEP1Ug1IYgPNUwv2mcCi4h8BG3bugF4jxfyVwhxXmYj2Pp/GHsiCHtkFB1crIKaX",
-	"iCMiIOdj6GyTRE3TEWYMXw/i+NDg95KIZX/9GCUVY0BFdo0YlAUThC5QQTPNX0oMmTdWpAyxxALNMcl8",
-	"fN/BjN28xMLB0clBUWn52pHFRycoKRhwtTV1FK3JuORAqHixKxFMKMkl+z6vFydUwALU/XjAQKJkv9Ew",
-	"+7hOzBgxQplaTUVCzoLUS1p6TKHQOCIeUf0ulWJoToBZynfXcKeuKuKVqjnm52Mk1axyiPk5oYvXIDDJ",
-	"uHxfq1/dfX3AOQR21OdrC9QO5JaA5lWWXSMD3pGJOoSiTqs2Z1cwZ40ddJ02CD4GnO8fvTO3ynr43T96",
-	"h87henXUmgVeqbVxlv0+j/Y+D+NE7veESxo9jSNaZRk+y0Dru5Npxex3Cpmc+27bj/gSXeCsgv6EvQky",
-	"zMUJB8++3mMukIQMEkvCayBeYo4qrkSCF4jtMz8IZQeP66NFPdCQoCHMNiW+Jvz8EAQjCe/TYAoXJPHs",
-	"57X6HVlK7wJhTjLg11xAfuxVbd7Wz5F8F/0NthZbMYIr8TJGV3P+k1dmSKl7VBCf6D2Uz1ApH1owpUSd",
-	"2cP4AmevroU9YIuv5DPES5yA1BzO1CiXTgkVP7+MfBJbEk1gVkmA60zavYSa88cWMT1QuxtpndWiekb+",
-	"A4evPBgl/Bxx8h/oXl5yz4fk1eAdtuODyBt68Qkbb0qaErkOzo465OVu4Q29IKygOVCBLjAjks98d2mf",
-	"7N/Qi/QTMO61AMwDSxdAL1LEKkqlImHUw+DccaQNob5wLlIPXavBSD3zgKsPoqBSpFcd43CzkKudvGVF",
-	"/i7HC3ANsZTIuXNCsdBnyXFZygm1WRYSU645F0eLpAwN/PXgyBnI6pUDo4ECw1n9xk1sYXv9wXhV5Klv",
-	"4qigMOFOcrd5Ew+PdXc6Ora7Twlfd4IeUXBgkiv3k0Sy6r+4jxpnegwyg9C/Zr9/UDT+68HRPZiKEotT",
-	"TUXPcXzWYBdOPbCUmPPLgnku4SPzRJoeFW9ED2uoaeMQqOc+9UxecWD+G/jEPJm+VT9Q6xXiBi4+qAZ1",
-	"hB545eUO6SepER0xmJMrD5zV70qxkSJPv4Eu2oJRGwgFC+lSzjqzau5dR/9+y3XK4UMou41Y6PDelMgA",
-	"ujev0hnfA12IpUcdVL8PbzF0MZsNt1eIPXjxwVAKlfeEC0hn5hLqe84ygj3X5b78ud6xcUR79fyMABXa",
-	"h51CyUA7u4wGO6au67e985ZVbQkPCdLaYr6J5VXkqCBDbznKyo3k3qAhhC6X0LrG0SXJMgRXJWEw2RiC",
-	"tgox6Bt1hqpLPC/Y9fiBDu049Y7AKRajblhDE4d2eDd6Moa8AcWGC8wGzEsPVDFH5qXJUOVC0uS0Q87U",
-	"2F7UZeyIdjSasyJHl0uSLBHhrZ0bg2dcRLvRHDcKVXOQCzaHARwiaJG4pVsLiDaZKda3blCPk0oeqodH",
-	"e42lcFYtojgidF5EcXSJmbrklN7ou9kO8ZU03rWl50E54Bzl6qHxxDnOyLY46nhEh+VJz0dq1ljFTeo4",
-	"YU+o72YYXEReRPI1bez/jUNS0JQjTmgCCMoiWf7UUdYDFp6S7n6PUY6vpCHUdkuYWBukdjvG2FiQC6BI",
-	"TswucNYsRav8zHO7uIhow8FuSdLRoSOEuv5V+WQdq+757v/54PABLgf9krf1zXXOr6Y71esOXJFZcflF",
-	"wZSC+KIX8F2ZWXFZg0AU9U6WgOzLzYbOiiIDrGQ8rkRxhCsOLbf6HGccPCHPIsdS8cyya1TKl9rSCM8F",
-	"aFxIdBaVf0VorOeRu0gNu+WdAknFwGesyN8RzjJk3DVJkecVtfFcJSl6d4xzitVEuUX7oDZjgeYi4vnf",
-	"fXJHIjgjF16PhhEDW6u6NcI3hiHRIY/u5nx7DVMYP0THO5FVXACbBnUz2KvhFXlOfJEO9budoGDJErhg",
-	"yrsQ9DO/tdZL5/RK6smp2re1Cs1Mdb7pV2aV4mZYZRVevzNtpWkubqodM30TqvGzDnGoRKp1ybYycFbX",
-	"3mmR4zS4HwOMQDyrBzTgteOsoO5BW5AL+Lp4reWpGN74mmYgmtnFO7zqX0X7LN5RLjBNvHLHemCIGdMY",
-	"k6P4M4HGCejTYVqlNkz0Sw5zUZf/bd6VcvL3Dx07IqDedgffDTn2GajNtAHkNWerJYUVSdpZ4RFMOFlC",
-	"qoLFHi6VdrAEhx6lg/YckbRDbXVAOuAbaoLOT3LwSQ6uIAdhgCbHROCkLIm2o8dDsE/ia4L40vLJlSTj",
-	"AqwnqRoitDLLCYJ2s/pSa1LynjUsLQ9FiQdHJ0P8Vo9DdZrIxIuzflNbcoEg474KD7ZX0k6JVSOZrlvP",
-	"Fx6l9ZmahJfV1YGkrI6AJeBVAiTA5eSVygwq9TidDjVl7pTwc+4LWguV7mNxqTOIcLJUseLtvIkhT+Vn",
-	"N3buzXmS8D8eDThTTWDrIEu/dRIOPn9w5rZ++bVD0C1iD1BmC7X9DXq8Zg6ALO4sT85qidV3jlW8I++a",
-	"CA9OpQmVMkykpFZMTykkQv9R0SXgTCw9IaA4unomp3l2gVWUhsv5mo18NDM3v7xu1mh+PHBXa34+adZt",
-	"He9gielic1bcaFbN6tdAhwzMBPIUH4FX+VDsou04Gb62N+Q6eWAPwU0cfXOhnLTIMfFc8q8wB6QfOunT",
-	"tQON4fmcJIhw40gjZ9mkJCmgF93cxg5A3JxFJbaUrKYXadsDtdlIzqZCK486gNGNQBhaDVlsT8HHB+CO",
-	"e4h1PkL2ewqkPgVS1w6kmrO/Lxb+MhIdC2yHNhGmKcoIhZ5Vp370ziOfDNWiPFC9iNpwGw6B6pw5AeN8",
-	"C2VlhtxqTXLSvVf4PBRU1f7dahwDvTak+XghTtt4YVUiKgap3Cvvi5hJtmcX0R77MzNb64H89mv2l+uA",
-	"Ua0du3BwYHboSO1pucH2jVF53FrEm+pw6CYHTBUIYafIh747ZFryb1JW0iw+SgKlREPOj3lWYNFPHdAy",
-	"U9nTIV9DqvK8g8noYU+DfNFfSqFSx4O+hUHfxeBWBzwig5P6d3k44gMJT/ljJryskIbiXN8OUTe4cFDt",
-	"0JFLrI5smFlNZ6V0KOOUV1m1qn7HlwNVRxL/IGIZrHlpBQNC0mmarinlz03Pe1LPL099DDj3WF2q/4NH",
-	"MTdlStZfIeTbvqo9/tp6PrpT/LEEsYTmdavjGVdJZ0rHrTKevRDaTdOYYVwH9c3Q0y5NKweTR22A5Z7a",
-	"Qvapti7oBfzhS+MM9XjLMzeUKpkU1JQvz8IRxuMlODGV5hUn5Nhh9wnKhRuw/+gVqL7sDmOTSaXD+D8n",
-	"KR1PF+TYBemhAw+OLOUpKdCTWZAbB12nVEz+bI9ZcX8CwzTpYd4eER0+XtJ70/s3vkC/JxFCvkTweROn",
-	"Z3qoXJFRDV3hpe3skFJNviym8ZXTYWkMmlLA2ryKeZWZHhSSlXUG8KDXdA3v5sid1vihWmdvXDoPdLGt",
-	"XxGyrp9RImZW4ku6MrAUSm93B67h4yyrs8x3EbU1ObNNwpEejwqm+2Q0zmd0du3RshwVj0uorMtFXbgM",
-	"GFRr+SV91FiV6Ro0r9GoX13TSeQ6OJu+ahP8mAaZLru6x3AZrEupLfy0RF6bG+Ja1LoCWaXK9KXyCgJN",
-	"DfWqklO7zqg9aKcXr51gG2sx07i7JmxgpduF1e12RjfY6s/TCrYPZTA4NG4tWgVtbdJeYmKSCWxqQ7jO",
-	"Z1O8NY3g69Qsv7uvRXtvSQYnZVZgDxWWDLg3FciVcXOSKfmGMwUGZF6ydTUqI8wr1irm0ZtOWOYE7dTc",
-	"fFlUWYrOAFVqn6on0iho7N57B/5omu1tPqa6TuyzSM6ByWN6HHj1M8fSCC+/zh2mMHaQe9RQlVODkiUk",
-	"5yq4iKkqB4crSCoBFrm1/G5SQILiSFkx3rWUqr2hVTbs1HDwEyKkT7uPg5TWwf+GoaWP3QOUwq8PTPOC",
-	"JRNqt1xpc7ksMts4rhEMaiJFOqyiiMECszQDXsM6LITmtjOHBwjyZ9tYAHOE0RnmfV4M0+Lc1/VjCDX9",
-	"NiFmFtd867o9zC5usc/vTwpwAeVorz2bQi7HDq1nV5mkDll8zASU3mhcL2rbemOwXWJ7R7ZvYj/3mY0q",
-	"XPtsUeVy302KnVx9FeVLdTj6J+aerg3yV8t5algd3nFW6nPL6sJATrURKTDcvSS8a18zEVf8nSgLIqhx",
-	"3JcJKfept7JGHSRcqoZiNaWsWAxp61iJuJ5JHtFrOXlO+5Xu/HEGmAF7a60+fbgvthpZ8Zc6lBrWrL4U",
-	"QjHafpoT2ppQNYZeAk7VcNMa+v+fqYHPjttVziZYIedR/xub4+jds99cGDTvz6oSS/n7fMpe7ODwduyI",
-	"XYW5qbO1yMBOJlGh+hKobgFCqpzRm91XEqFOKcZetLP1fGtHNXoqgeKSRHvRi62drR0VxRNLhb9tjZ5n",
-	"Cj2algvui5jrOiaMKFx2C8wl7anwzbs02ouOCi4cquCmczdw8apIrzfWQblTJt8JAxrnV6sL+O4GO3J7",
-	"Goj62nP3WoNC6rgss2unUbhvtXr723JQ04J6eKwc5HKrciD6qPnz6Y00cfFCpeG3CUHxe5s4tr+2OvLf",
-	"aCLJQHgbGMrfEabDtKKHudSy32n67342IOAHbYZstz8ZIE/XoYCXI8mY+jy3Q5JphT429uWDILQkz87h",
-	"WkFjASJQj4ezTMeuzRXBe4j7FYSWr5q9WzBerUv6RGWsvu36qli/h7qDPMRAVIxC6jnUAzOf907ooNCi",
-	"S+oiEwSzez6/YHaQdicy2cXUg4jk7gY8weVW1sEjk8irEYXL0ttf7VdNJknmYVoxgllTy37ztZQVxbF9",
-	"cZokbiHnW5fEK3M3FonHBNPa/hi6juTLG8bW5sVDz3KZJCF2RgjFBHd+EEKRHK/LHINX+D/VY+3s8V3c",
-	"+nk0BdDG4NUlFTV8V4OuQvI2LVKYoHXoYZ5NfzAPNqNrTAusq+Y/qi3t+hqHPtC9XSpd47lDR/KpISK1",
-	"se2vulXATRAzv4LQ9dOmEZ4fMR9sw4HVJI7pU3ATr1J/q2zmvypQ2UzGZG61M6jRPZZoc3pLchqjHVPz",
-	"N5le6lrrRym9ppFWUE1VRdj24yPFXCqsuqy8r6RugqTu6ArrVZXf9L825tdtDG4tBFRukJriW7i5pouV",
-	"Vnb3sKy3jV7ctiM98eIm9HUoIVDBokSDdlSLAs1JZoMMTR6m+h4C+lM1i/4HPkv+rHZ2dn/GZfmPkhXp",
-	"n9FPW+gNTpZKvcA01X0nOcorLtAZoJOP7xHQpEgh3QoIpLpucugzdaf3e511uuPc7l7rI08R484UYty5",
-	"x/vQcQJ/PpUXzdpKWLuuYMQYtxWdqtazEyTuCzyXyO/ILq/Rfr9GeWvZvkR0S3bD1vgPQlQt8bnt9PAK",
-	"i1G3047Oep0mTA+bbktDMvWgyHP8jIMcJFGTtZt1oXevVbx1Aa2dRHEEV2WmOmeayJ9PRJpJvpCUD37c",
-	"MxzJzPHVO/3w+c5OR5jFUUXJXxWYAYrO71Th8xY/3U6k6vKHvGmA9IOywte6xH3Qs/UbybJG9AZcWjWa",
-	"Zk7Z/GoqZlNwP9Gt1RF05yTLvg2t764uz6Cl2VycZ9dI2WxhGXZHCNy4RFjHCuRNC8MfhiyCPL9tE4+D",
-	"ZGOJxmTJTqCZ93rk2nQTe5OepPQWnn4JXH9VpUlwqZFNKMpJlhFTJBWwJlSuld+1YasAhptq94wl01C/",
-	"qYsb2mVgVxnRjR6bXTXtwHbkhbxaX697YEWF9XUYUVPWEzdKbhxTTF2GbLp6TuDJoFJ6C7as6xA1SzZJ",
-	"cpjVfYXtpyJipy1drIbqJj1NfeMd8qdvWlD1oS57TTga0HS9g6225dP7CPt3Kv3X9Ve4jHwP2vR3yvel",
-	"bTPpd32oLpSd7hEDno6a63X3yvvWwXUXi5YOrjx9Cab65lNtN+8S8y93fpky9pdvjEoYzBnwJQykEn7U",
-	"Q1psCVcCaKo+GSq4aY6ge4dOJKOP9bq3JaX13G+d7/pWesOe9AvzpCOGLRwa5escSoGw6p7aSG/VT+FK",
-	"S+UXP0sda+RjKp0k9YnBio4Y1ZC9J8vkEVCw5P0h8pXP15B0+sUHos+ha7bTZPjxuoiNWL4318l3KqOd",
-	"Bs5+Ep+BcLtAd9s3b6Fjf4dUdGUFlRP4IHn3k21b6ABnmTKWl4RLlWxZpCivMkHKDEz9e3EB7JIRYUrh",
-	"j4/fx7qZvJqw4vp1QLabi9Nkjjc6vhylP1suCpQD5pUpSLVHs5J6ayITH9eNsR/+lmk14u7W5svDNRdH",
-	"gw8XXqYCK3gN9fvervMNL7PL043cRtyQZt0F2Mz+o+noAnA+MYvba34fmwf3GYBW1Sy3jDvrA91ffKNb",
-	"3jSExlZOhPzNQdX2V12iO81/4sb1nHIwPxaP1cTrek9M5fCT6+T7cp04HdZu5TcRTTe2O3aavJgy9sWj",
-	"EcijDL6d46tBJhfOl219DB/8oO0EMXCIr54kwaOXBHGgd7IoJBMyAhfQohL9/WOdYhHIOmOq+VE4m8K2",
-	"v2la5n3h/Z55XxQyvjDVNe9+E2fbH9J+klUbl1U6D22S7miHekVO87AjZnyUWfeUDTHi5EYlp/ets5q8",
-	"vVvrrRZej193bfY6uexwILnRpZS78F55e0JN8mHtbnwPISeW7vNxifWnakphQwuPLp1rEyTTEjNSLbKt",
-	"zabWJQaISY+oyenYbZm2qqZTvzo9fNRqcbiJ6sRNXg2b4vXBIsQwm8vX7gQxdycu2h1d1q5E7HXpDFYj",
-	"fpe8HgeDJFrkYTrxcvg2iOZbvGO+g3tjW3+qfPur6Yx5MxC6UEap21NsEtHpDx2/qhtvrk+B8eho297T",
-	"c/Xs+iWMRu3S+a7Zd4vZ7aaha9hx0m6fFqpMHUPzzDZXvRdk92tcaQpXTVcyE6w6sx10gymj+psInUbu",
-	"vvTMYsF/n885BHI0V07QDDhY7Ee8pkmx5qtld+pFaHdlXtGLYOXsowwp+flxqrNgDQ5Vjf+2vy4xXw6X",
-	"iWNq+vqijNBz5SLDSGCmm/9KtOpvOVsax9egn/GJ3Pu27lR4S55VZFxi1QTBUPFSTxt2nI10RpzkqXh+",
-	"N/TtdH4O6AYuXkxT5sL+qGjeYOk7SIu8O/642F2lunmwEO/T7vdc19y76t7qzTYbPbtGBQVUMJQXTNfE",
-	"K0hMqhs0XyhdL5e4+Xhrpw0qF9eqkaK8Ez239UHFeMEk5HmtYapyyDkr8gCwKFyJY7ft5TRo9Ysz1AFN",
-	"VKBiVH0/qNSfLFy9MGPo2n9+l+HKpyr1B8gTudhtu/xv6839tPsQ/txPu4/X2jYw+K4q10euwXux0h1K",
-	"ewx2+h0Tum2tP53MH5eb4LaEpSZkFxaR6pMiqiU039vexiXZgt2zLVyWkTPD1ya62ATXvnb6C7R/VJFQ",
-	"9+9Wj1T3gW25dnN6898AAAD//yuTxj3LtgAA",
+	"H4sIAAAAAAAC/+y9aXPctrIw/FdQc2/VTaqoxbLjeuOq+0GW7RPfYzkqSXbu++T4SSCyZwZHHIIHAEfS",
+	"cem/P9VYSJAEl1m02FHlQ+Qh1kZ3o9Hr10nMFznPIFNy8urrJKeCLkCB0P+icQxSnvNLyN6/wR9YNnk1",
+	"yamaT6JJRhcwedVoE00E/KtgApLJKyUKiCYynsOCYmd1k2MHqQTLZpPb22hCc/Z3uOke2n1ebdSLgqVJ",
+	"56Du62pjxnOIL3POMvVRDxMcutFotRkynkDnou3H1UaUNEsu+HXnoNX3FceN55AUafdqvQarjazorGNI",
+	"/LLiWEAXnSu0H1cdcZGnVEHPqGWD1UZe8rRYdI9bfl5l1FtsLHOeSdC0/GJ/H/8X80xBpvBPmucpi6li",
+	"PNv7p+QZ/laN958CppNXk//YqxjEnvkq994KwYWZIwEZC5bjIJNXk9c0IbhEkGpyG01e7D+7+zkPCzWH",
+	"TNlRCZh2OPnzu5/8HRcXLEkgMzO+uPsZP3JFprzIEjPjz3c/4xHPpimLzYke3MOE55yTBc1uHCpJnPmn",
+	"+8DfMxBLEA6Hbh19aQI6/O3sFGZMKnGD/8wFz0EoZqiLXslDfRHihZXgLw0k/e2MmAbk73BD3r8hUy7I",
+	"26NTQmvoO4mahBzh2Dgxz8LDmm/kag4CiJqDHlXYlRImScpjqiDpGPoMYgGqXHx4DtPI38H45ZsfmqOe",
+	"3+RA+LRaaGsgyIrF5NXvuMbJlyjANSte+Lv5GjWPIbhBH6DVuPzin2BQ/DUKCB/47G0WPOkUlpAOIdgH",
+	"Pvug291GkwVISWcBEHzgM2I/EofWAfhJBXm785mCnLBMH7gWaUguuD4dAXgHJURx/THlMwJ6K6GzYQuQ",
+	"ii4CE5y7T3hKzYGmXCyomryaJFTBDo4yGTyhcqoKJJGF5hcH9jNFVSFPgVpyboDeHIr9VwJTWqRq8ur3",
+	"L1EAsmBaNsEh9QxEmCmiCVOwkEPHWUeJEqcnVAh603vGx/Z8r5iat+ePSFwIAZlKkdflXCiWzQjPUkNf",
+	"mg3ZHitihppTRaaUpZAMnoxbPJ7C0cmnQxHPmYJYFQJqcJ7QRfLyxaR1PZx8ItTr4/DFSUMRYUoSK2zi",
+	"gRSZ2SI32IuyrfQARBdQG89nBXYBVCxevggwBb3+I16Y+6G9zJgLkBq0Zia9JB+dWaaeHyCCsowtcM5n",
+	"5RwsUzADLVkcCUCUOqxePm1cjW0bNUBZ5vlEFI5CdCfD/cZQWDRhgavmfQKZYlMGwp2EP4c/dFGw4K2w",
+	"oPJyiCSqWY6pvGTZ7A0oylJ9VWf2idQQXPBgwytq8yUH1Abk5kCmRZreEAvegYEaiK53m5mnmeuh9xp5",
+	"x/WlOuBzoIvDk/f2VlzvfA9P3pNLuFn9aO0Er/XcNE1/nU5e/d5/JrjeTxJx9Es0yYo0pRcpmJfCaFyx",
+	"6x2DJpchaeGUXpElTQtoD9gaIKVSfZIQWNcHKhVByBA1Z7IE4hWVpJCQ+KvzgVjf84Ngdud2Q7hoGloU",
+	"tIhZx8Q3TF4egxIslm0cTGDJ4sB63ujficP0JhCmLAV5IxUszoOi2bvyO8G+5AfYne1GBK7Vi4hcT+WP",
+	"QZ6BXPeEsxDrPcZvRCtGHJgSJi9DwyiuaPr6RoFsD3OO34jMaQwo+VzoVj6esky9fFGN6nFsRJqOUREB",
+	"1xm0eYlW+4/cwbRA7S+ktld31Gfs33D8OnCiTF4Syf4NzcsL13zMXvfeYfshiLzNlp+p1fIlCcN5aHrS",
+	"QC9/CW+zJRM8W0CmyJIKhnQWukvbaP82WyafQcjgC8Z+cHgB2TJBCSFDQYhl/WNHE/OQazNnngTwWjcm",
+	"+lsAXG0QdQp1ZtYhCrcT+dLVO8EX7xd0Bv5DMmE49oJlVJm9LGie44DmWdnFpvznaDSZxXlXw78dnXgN",
+	"RTlzR2vIQNC07HEbOdjefLRaLtz1bTThGYy4k/xl3kb9bf2VDrZtrhPh6w/QQgoJAqnyMI6RVP9HhrDx",
+	"zLQhthH5n7NfP2oc/9vRyT08dfEUxz51A9sJvWabcGqBJadSXnERuIRP7Bd8OhWyYj2iwqatQ6AcOyTc",
+	"FxJE+Ab+ZL+MX2oYqOUMUQWXEFQ7ZYQWePFyh+QzSkQnAqbsOgBn/bsWbJDlmR5kWWeM5oHARZcs5c1z",
+	"VkyD85jfN5wn79+EfncyBx3ZGpJYQLfG1TLjB8hmah4QB/Xv/UvsupjtguszRIFzCcEQmcoHJhUkZ/YS",
+	"amv+UkYD1+Uh/tx8Cgfl/JRBpozuP4FcgFHWWQl2SFw3vYPj5kX5Eu5jpOWLGZWhNRGkr5cnrNwi9XY+",
+	"hFAvWbvGyRVLUwLXORMw+jEEdRGiV7frNdWX+IKLm+ENHbt2uo+iCVWDamSLE8euedPmNnR4PYKNVFQo",
+	"WAWqVBLbaTRUpaIKRm7yTLdtWcGGtuhak6ngC3I1Z/GcMFlbuX3wDLNo37rm2y5LCvLB5hGAhwQ1FHd4",
+	"6wBRRzNN+k6NG1Cy4aZa5+iusQQuitkkmrBsyifR5IoKfclpuTF0sx3Ta3y8m5de4MiBLshCf7SKMk+Z",
+	"WmdHDY1uPz9p6XjtHKuoeT0l8qcsdDP0ToIXEXYzj/0fJMQ8SySRLIuBQM7j+Y8NYb3jhae5e1hjtKDX",
+	"+BCqqyWsaQkStxz72JixJWQEBxZLmlZTZcXiInC7+AdRh4NbEuLRsceEmvph/LLOq+7Zwf8XgsNHuOrV",
+	"S26qm2vsXw/3xczbc0Wm/OoPDdMM1B9mgtCVmfKrEgSKlyuZA3GdqwVdcJ4C1TyeFoqf0ELW1dVTmkoI",
+	"GIv5gqLgiVrEHDvVuRGdKjBngcfJi/CMUL2eB+4i3Uxr31I4tyMGJG0UWmvsHPk7U/8lCXa0+MGkMYs6",
+	"Kvkh40QJOp2ymKi54MXMqNBzwa9vIpLx0iZEY8WWTN0QmiUENeGFtkoUWeI2Oxcg5zxNftwl73FGBI1+",
+	"g0uSMImP/cQsKuOKSFC7vcj5cj/8oF77Xs1AXXFxObLnR9MaDdfM3HQQl9aM+nsPfyc0TYnVeMV8sSgy",
+	"50ygmW3rmvYQYextKAj1BUJHQp6RhBJFZzNIrDUvphm5ACOsl7aRP13zV4rO/iQW/h0sucQ0Sw/Pfgqx",
+	"f6SzlC2DiiWLZ7ura5eMx4rW+wUI/bP+KonWmXmmOTMzgsL0d0Bw7bTVSHHCM49eFULO3EyjbHkWRT5X",
+	"KzTPp+v3pveLpnWvWwyp872j0vtrHa5b+Y5FpMjYvwogOQgP93KqFAjs9n9/pzv/Ptz5P/s7P3+p/tz9",
+	"Y+fL1/3o5cHtf67Dtc+sz1aAe8dqhNjdGObQdMJ3CF8saBZQLxyZD9oeyLIOJuxcyRIiQBYLiGrtmDQs",
+	"PCF0Rlnm9bOzErhmSobNKyH9z5HgGb5NBEj9KEbp5PxI0wYlApkF9i9XFRFgag6C4GhI5KLIDhVZFFIh",
+	"3koIEqdu1CHXo0UQ8XuHT6erTTNeVmsyhuf7+72coeIEDYHXnklCLm5qhxXVjkAvc8oyJueakzG82IIs",
+	"xchpk1fPX+7vexzmWYdGX4T1QLXJRZFJQqVZkd1xuXwcA3GokI3LrU1uf2hK29/52VDZ82cjqMwSjqWz",
+	"PmPi9sxKPmUbFrfObIb91hiRArrYlAtFE9lh38AXWX3ysAj88oWPJ8/2D14MYUoIPuU6NKB4EoBRnBZS",
+	"gRj3yLWNg2yGLxZMhZkfK61hXMRzkEpoC0BYl9P2yhjjg5EZG0SHcfmdU1k2MEE/dXCI+hNd+5OMtbiZ",
+	"LmeFFuFhlVlk2WfcTOPs2l2QWFTG1b7rDbHE2WFrztqrq+wyvqBJ53osMDqcWFpAA1layzx3mibkOgxc",
+	"slTtaMej4TltQ3LmJm+QZ3gWY6h4n0lFszgoKTuzC7NtKg3y4PlZ76gRx2d8y7R0OtIY2U+WTc7iXPS1",
+	"Zb+96cjjKeWyG+ddoWObgOpE23F41d5K1uN4nLFQBDgdxVtbe7gFqBSV3wgO08q8TfBN2sC2UvLuMAhV",
+	"nnKPjLE+8cHHzQehByeHWOCo52DduhNA2Cf2NYJ9Gf7kc5JhBtbiVBUSOp7leT41gyASp0eWkyigbtSY",
+	"eHTyqY/eynak9A0deXGWPY36tsOz6FDrLeozGUvEqu5Lvi0v5BOVlXsqd7KGOBDnxQmIGDLVAfBKcZib",
+	"dnQ2dmw0u8iQp5rSPsruLI3bM43n2kFsb1E5jo2lZ99hLuiojfA/H/QyywyCrXNYptenbo+zj97Yzhi/",
+	"tt9ZDdk7MLN2tO0FBkxlHoDc2TmaPCs5VtsiVsgGv6vcOmhyg0MJypBTa6LPMoiV+UeRzYGmah7w+4gm",
+	"1zs4zM6SatcMieNVCzm1I1e/vKnmqH488merfv5UzVvb3tGcZrPtPQsHXWlXvwYaaGAHwF2cGqVMtzWm",
+	"bi3pv7a3ZC/5hqwfaDE0lkJtstYjGh2jp3VsaYzWMYc8rJIeMeibc2pJ+IKygOTzmkog5qMXCOeg5LCE",
+	"SWtSZBfpKHdx9AdoWFMbAPGjNzRS6AsMnVhrhqTt+rRsy8nk/lw5ook9g/HQbKJ6zoWSxr/MMj7CVEQk",
+	"ZMqZD+DgYsfOs2MOeseMNQeaGCscHFz8YZtYO/Qfpsm/ChA3pEx/sBVvlKY7yQhD0UBYSeX3U9mLtOtP",
+	"BflxdoBVDFLjlM7NyAW72Tcfz6wZth0qApKnGG4b6waaO+M9xmIy51KVEXw6zteISTUzYQ0p7RhMkkvI",
+	"VZ03a56Oi5Q6vFeSOV1qnnoBRKC0iUzAuw5gJhAPRZGC5qv1I8K1dcg/rZUniTFv7oGK90zH1cyUv3Cp",
+	"yqjDykj5bH+/Ldt6OwzQ8wmuRuinWCmkVR00NHUQYkpjaH6trkKP6XfrfKp1Pg+9p6l+lwYgqL9YNh5a",
+	"kaw1WHtRL1sWXg9dO7RkT16eD3D53oNT6SO83Z88Vp88Vtf2WLV7f8fF5anNwxKIjiqyxqsjGmFnUhzR",
+	"/7Jiu33x05WJdsiQvwHqbvCAwp1UTzqQW3pJIdwDQSAmEP/MYUDoen5TXiO4NOkH8OPiNZrYKzHh2X8p",
+	"ok+RKH5FRSKtz0Nm0wkQXy073jbTo3M/N6kLEFXNAleUY9rTNRUYvkmxCS4PsyuBqAVllK/Ccu0v9gvJ",
+	"WZZVDmeVTBSMrc/7pCg9gJuRCCPKIpEMR/7mk6haq7e195mWO0Nxvhq5D0u/2REAP+HCdbiNJvrxhD1X",
+	"OTMcwq0pgC1rv+jMS650ajQfrVPwEPDqoHAbCy6mDdmuh0i5ysCDE65zjjd/S8NkXhVaGMCWWr5Jr+iN",
+	"rJ4UkdFh5YIriFXlJ6U7+W7W7UfGFo+8d685xAylM906InwJQrDE6vjsIqqz2QB7et8uHgf9wGfhTDkm",
+	"XKAe/aCfjCnLoAU//WNwHPzSl27ngVLi6AV/qcGhg81NGaRJb+B2F6Ov4hfvPYnRQ0FVr79afuSgV4e0",
+	"HM41VH/qi0K7WSW4VtkWjlehk760QimfBab/sI05By9kPXfkw8GD2bEntI1LH+B6DL4kapMEo6GO/fih",
+	"sQyh24T6sW08HZcfIM4LNKKdxB3ZhvpMpdOU+577LrrISPva+tZlmUx0KojOfBXddknsGM62orNLdFoi",
+	"ey2dvUvtsZ/2Dhpe5fGAxbR7SBs8choITrORIqWWQkAMbFld1Z6hZfUJz0dMqPXXG03214z4WyEOz3tW",
+	"eyRbYZqHyB6V+KToo5B/uh5HrIcchUPgfi1UR9QbJE7pnIBULNNqJRkZVwgLNkloRt6fLF+4J0hEjt6/",
+	"OdWxRVb9tkvcaP4wRNFLIIgXkABCGaU8K+BlTMfVGD33GOVpUPOcQHYT3NwbM8F29vZJAtnf1f/t7aME",
+	"i9Pq2K36bvHlTAVo9T818Nhsd9nYe7UyduD7sXrOjehaf6L4ErEn1q/wcul+uHhuGXlxkbLYy4YWp9gn",
+	"GKIcEOvbWL7ZcwU7dquSXv700/OfVnL212NGblUesaLlqRAxBLawjkJ9Qa8HI3wbkTOlltGEOdTDmmKK",
+	"Ch5tnMJAhcQQbDhopsv/aBX9cQNsYTWkv8kQKD/lidXN1gG68XIWgVm/kWi1nrx+I/TkdrC17LvjwtvK",
+	"aNRmYFtXtr4y31Yos5Z7vlGpEEwRgUWubqoNuQ/GsxmSsKMAtjrtj5DzBxtv8YbrMeNis5XGrWfDHzSg",
+	"9ABYdTlIbRaNN45rrBZPN/gIr5UAsDRZ7dA/jQ6/gQZpBm4+/MMmk+3IwlESoXbgmkSTS5amfZfbmTMv",
+	"rZTsw3qfu3l6r08/7DiQiiuU/OjwQvK0UEDwc5P3VPZWFzZXhlUHsyx5hQaGUNW0HTzpcsjIrN87Q5C/",
+	"MTXvTF9ZMzd0aRHGmYQEiye3zZVV4+OaMP4ycF3oEiMBoNuMo05nbcMfWwBl8o2zPDWH+G0OOmjXdSes",
+	"rlWtD+k5Sw775nStpqp5MWzlDI3Qsl/q4crUpBZY/q4dZJ/S5Hb69v7ls9xa7AlmWt5S1qPK9HnWb8Os",
+	"IiVC1tImuY+4N/0wvNPg7RGypTtTqk7xoG/zUcrBJ1XPkKongAeBM3KY98kKPXVkgoV1AWsIufiz26YW",
+	"l9ZOyG57D7COEC2ZtZn1W2+zsK8adHmrQchfbbyPgI4AHdSk63OpTaK5GnYe+Yr1ingNQVM/BWy05LRI",
+	"bQIdJGWTzKvXL2+N5/7oF1xt76u/4LZ8sa2f3HFdTzY8mLOcXmUrA0sf6WZ34BpedFYdNiDJ2WUySUx7",
+	"VFLqzESVe6N7gnWKeBKhsi4VNeHSYxpYy/MthI2F1u6sd4ym65rGXN+FrirdN8JTrtRtVuTqb8MnsCam",
+	"1s6nxvLq1BCVrNZnyDoAts2VV2BoummQJ9B4DhjflYZcOz7QGxAmUl/rAlIlayMSqSCXka00owOYWAre",
+	"d5ZNeelBf3FTfQHh3xH9LMgDwpmC/KhacoeFe1xJHz2gMbfL0vy+tfo9laF9xAJWui9FWctocIG14ke1",
+	"oMC+SEuPap1CQp+Z0UhcUWaDHl0IZncS0m1xi3EkXIaQhx0NaoiEFSk+5SmnAbrKBchgyLLPtaeI6NpD",
+	"S4OB2E5Om6JpJsioCxGQBD+J1FO86LHlnBdpojP26XVqJ8NB0Li1tzZ8IvgSMpqFqntU35pHYYg1skIR",
+	"MoM5lXOQ2hpmnXPKKJCkcuRlWV5UvEL3bEfVX1AJY6m/WiLGBjTEuTHZjdbXmlvhT6cbW0Haagg5ql0z",
+	"JaMp+zf8QmVARYe/1qRRDcOIMEVirgNo8MvVnKdA4jllWQvWgQkFxILGlyD61nUJIoO0r4VG+KNFEvwo",
+	"FZ3BeMfQ9vme4QBBX2JFheqeFvLNZoU8NGmdg7W1nyMTZ+QVhTVTbHY9SpelSNAlsXhSSBPhm1JF/VCD",
+	"uBAZenSgbCBoi590xgJsHNe0TvwRx50g2ww4DJTfPF1M9/TrSPk+QTQDEvFu0IGPpcFDcQLXEBfGVFvj",
+	"tpXdr1Ng86mgdYkLtaVZtqz29c6nC5E+H9wJKjWvgQGMqjVfExnXwaAtw9sArgVqw1qDatikFOjpzHmZ",
+	"4y+KXMCUC6jBWvtE1n7QPEMn1415fqMFGBtNyVTr3p+6mkWB/eLPruQKlYQSZEol4Urr9LhG8HFn1/Lm",
+	"6Kv+6fJD6W22ScsNvtLlE75ywoHQFchChyqC1KMP9FDMVnPsblxjmLaipLXD07/pjFT4WNKOUfb3isVO",
+	"Ap7h5rG7BiHpjsdrUJMWTK/VsGRVSdZUGKm7jCEx5GBHMi6i9v169OvJ/z8aDB5gx91RORXGHVXxOonV",
+	"HtxMVS49MV9csMwl9S4xJdJ/npfZwA3umuqvMwhLiFzEIzLe+88gI4HatZUvFj2QvoPQmi5gRkWSgiwh",
+	"1f06Wp831AEV3F6oVlofTrWLq9lRfE1508JkV7HBOu9VnIBYgNoei7DjNROxn3766FBXBxw6Z0qpuICk",
+	"xTWiyZVgCn7N0ptS2Vw9LAZVKqZpz/3lqMjAiwubpsGsy5kU1tFOjXi63J3Qto2brIWdW7jSoskVXMw5",
+	"v1wJmL/ZPrehaKNepWBHQtJ+fQ4CxKo4UM8soKgpNLo51jx4y+j1aGVJJYC4CUJnl8+pDLATg8/6oz9O",
+	"x8Ng1jmC/uiP4FzpWlyJKQnptAu9jCWh18LgttpEqPJZOcICriA/7y371w2Ipj5sXj1q9ZD2wFxuybY8",
+	"9RkEm7o0DAEOjChWdxkEc/0FVWdI2jkVfskNLtgMn9ZEcK6mAbVYj2IrYdMpCMjikDH2hKq5JKYJul6W",
+	"yNuYMSIpWzCPO0+ZkIo829/fX5XgT/WAb8pVhYjfW3NHbM+Rl9XS1vJ1e0AHMBlEEqeQO6w7efcXN59l",
+	"eN9oQHXBL8ZEfTi1PjBYgrjxtKDV1eUOdk3dfeDW77TnGBp+/6byrrULYkratxauSsCCY6BRrWZFTgWT",
+	"4YQitfwa47NlGANC21VxqQlHA2TB5IKqeF5G3o9MwthJiKflLJ1NPlfTd7Y5rtbV2eadXXCTk1SKt+qk",
+	"/LS1XtaNFsY3MC/McwI+g1a2qiSlxvvER0kAmxnK8aO+l/dGEvC3IjPeNsH8WyWGNAv54gqCxZwE2Nef",
+	"4kSymbnUrDzjSr6RC57c6FWSX44Pj3bOfjk8+Omlbk517QQma1nl/nfHrmTnrGxiEspN2mTSloTDFqTT",
+	"DwRTajocoeTk17PzcoVha4Z+D7rc4/0XKU76pQOgb5cQcj7ehn36Doyd7/RVKGsmTzd/M7vs3Zs2BwPZ",
+	"NzRErWg6rRbjH7aWZt9nS5qypBSM6odt7IJhdUtF6syNAYmRhVcxfTe2YmdsrTPRXgubo6NW/pjEv5Vl",
+	"baP4m+p5YYXPsbbEsW+MziVi0FdH7mi/UI5Z4GrBwloM73x2QG6iJy2M+lYZON4Gjmp53zd7VdvysaBh",
+	"K+55Dob1T6/75TCHI1T203VNldX99Q2bWTtaM4Qef3ez5YVm7XpStuhQZTdVU514NArygQdS00rbprGN",
+	"TPpOi39P1t2wqr1mBe3aPOTtvVMxq69xuHJJHyKiWP9L+LzMSZdHMPaYI+f3/eprgI6VfXD344YdwTaP",
+	"zKb73tStF2IgF5UKekf/pvkGvsagLA6z4IkW8k3k0g/WAyXC3yEi/CoDEemC8CjYpCy7/DFEJ5fMxGO6",
+	"214nVdWCvX5ATaKJm2fFd0tzs4d24K7vp+WEXS2Oy4XcRmU014DKg+py9HqT/kmcBRn1WUhXY+4/m+y8",
+	"XQ1DDLq2HYpZsUCi9Ex3mnuvQBxrWIxqRNNt9tHNyrQffUq1NSwi+s7blimkx2LbdMTUxpFyc8Y/K2dW",
+	"hxkZf+KWGatr32tlDO2ozWpqthK6pEw7WJulVJ6ifiBoYJ3uGbiOLrosxtpErs7A2GNb2TsphKnX21Bp",
+	"2oiTyBfI8f3kBVljS7iOARJJmNolv/bsqKcYd9TDozdUiuqvTdZwHPYdP3a6OX/lRvPk/P+YJBnqyYik",
+	"S0iazKTFPiBbBkJhsiUTPEOeQZZUMI0olXIA53DpJ1imra9AE5+ex4bO+KRjxo6IZVEYKedTJZFzrbm9",
+	"AHUF/htM+s4Y5AfLG8qkGYqKGagfw89ZdworsxSDZmzagApqRNC7jNWC3z02YlYT1nbWAWEs4LXt7Iki",
+	"2zNf5d4/iv395zFL9P/hR8JFHVwJExArLm5COx9G3UXpD29uZTOtkyoCj/AhrD6ns41egX717I3efYrO",
+	"1grYUXTWj8DYYKPQCrswbccwymPFCS23vI6CQS8p7KVYratxTIcSdWVbeLK7Tdn711QzUFw7TMyptBn6",
+	"aalEIUHTzeAm3br8bZhsJZ1ukfcVCYRLNUtZozwwXOkc/aXQtnKN4K4CwaNJxeYcWIdaNP84CSY8ONk8",
+	"z8HYEsc9lQdXSogeXlkwBGBLRZC7au7fVW6HeuHkehi3sTIUgqkbTNuxMFjk5eI9LMw5XwAVIN65vfgF",
+	"WCYmk8pCk4duVq1urpRWCBwmC5bVBmS4vVIZb0598r87uuHOuR3XjmKzF+A4+q+hMU7e7/wdbkL9z4qc",
+	"ouLh2Zi1uMbdy3EtDjQPGDtajaG4wW513rEpxxEUUyl+e3vwGlmDV3Hx1WR/99nuPs7Nc8hoziavJs8x",
+	"r5pN4qHPb88voaN/yXlI9XWkMYFQksFVLavxRA9vJPP3CRI2l8rDCjkx2AZSvebJjY3jV9Y6oau+GCPf",
+	"3j+tVcG8GgbLpMGVN0szL4g1ywiQOc9smPTB/rOtzX5kCaO5gp7E2E7dWsUwpxoxXuw/65qtXP4eNrqN",
+	"Jj/t7w+3xUY+teqI4hA2//4FQ4gVnUlTyN5HBE3vdeTY+0qr7b5/c2uQJIWQz90b/TuhWT+umGY+thz6",
+	"U2hEtbWaZGdgdNVkr7ZAHSDdwIAXA9nLzX42O6QX+y/GtH3xIAeas51LuNHQCD5BtDOa9vnAd5QVNmTr",
+	"4P4GyvBXQ941GO+vRGUjFQil3HQbSiveuGK9wyMCVCEySAKbemDiC94JjSN0x6VVjcOM2d9fmDF7h3Yn",
+	"PNk/qQdhyc0FBLLN1NIQPTKOvBpS+CS999XIByM5cz+uWMZssOXQjrs6O3Ydx3Hi2uF865x4ZerWTlBt",
+	"HxL9bhw6rhPsvOXT2j57aL2BR3GI/QFEsRqMvwiiIMWbasadV/gv+rMJSQhd3Ob7ZAygT52rEpUefFeD",
+	"rj7kvYwnMELqMM0Ci/5oP2xH1hiXaQfnnNx+2UjiMBu6t0ul+Xhu4BF+tUikF7b3Ff9nb4zgyfwNlN6D",
+	"ThvSeTAf9Sgrcxwz+eQ2WqXMtn4z60Ku1ZO5qtgdecc9lHnry4boNIQ7tszkaHwpS6o/Su41DrU6xVTt",
+	"NOC5+FFXPb4tpG4Dpe7oCmsVj7+1d9igbGPP1kFAa1OtI8Xjv7nGs5Vautd+Xm/d1Gtl7Frsxc/w18CE",
+	"jtIzpsaz8w+esrTM2+2GIj/A7myX/GNSSBD/TS9itJ4dvKR5/t+54Mk/Jj/ukrdYtwDFCzSDL41v9KKQ",
+	"OroT/Xohi3liSw4EGFJZ79DnR9vmPyteZwj4suDepvda+/A0Mu6PQcb9e7wPPSXw71/wollbCKsnGh54",
+	"jNvGwerFbYbnI/kdvcvLY7/fR3lt2jZH9KvEdr/G/yJIVWOfe4sqoXY3G7WNvDSY45ipy9Y9wFN1OYQd",
+	"CdhIu4e7SFV7bFjPVHEyg9pKJtEErvOUJ1D6UYRYpB3kD5bISRMloxCXW6miSzQpMvavAmwDE3xylwJf",
+	"MBv6ZizVRI04RPjrksLX0ljbq9n6O1YHp17q/pBKqzymM69S82oiZrmasWqtBqPD+gTfhtR3V5dn50uz",
+	"ujgvbghLWmfo87A7OsCtc4R1XoGyqqf8l0GLTprf05os7Tc0cB1qRUHVuF3MYwQ2HXmTPSBirVLos1ry",
+	"+taxWmUqDwTfvaSe6Uxb1ZYbSLNLDpsXsw4dzWgu51wpvLuzhFwC5NI1jLQ8RokpFFPzHjJZzG1nHKiQ",
+	"5hHZ8yi4E9S8y0eGj48P8txoLqB9H3vn/RBvj1UY9Yv9n8e0/fnbZep7X6t/oPfeGPtlmF+NFvo8Ujqq",
+	"zb0JYUWDjev7XEF+bODrt2McfVR4hYtV3GSdDF8Lp6ZBDb1sCEE1VsedYOV6vA5c5Rc6oyzzMhaVQ0Qt",
+	"WYUK0CnZd4lWFdsCwrGuFBoRlqRAbISMnsFWXdZZY3Rfu7WEUGlyM1yB8Jx4wwxvjZunTi4WXg9ANdu/",
+	"wE5NZb3Hrykrj/rpuro3tjLl4rKbbbxDWkUya7GFTHHtmcWyBHLIklolKkPrZSoo16kmTO4SHNyazDQM",
+	"PJFSR0PpwC/MLV3ztRxL2jj6I5Qm7fpwdS5+42EIUkN/DFUihkASEapICijm86x09J/qI8RW9mZ4otY7",
+	"pVavKndeBK/5PKWxueYt0eQ8ZXGrgnPOhZK9VbYbNFYESMxV0n60VBYoSz7KK+sO1jCosXDuXv4pueN+",
+	"JET1DRGKK4nTqZF1INYNRynQPpiWm8iDgXyseKmqZhlCXJTJZVMF95caLZaRBUtTZsPDOwz1mh+HvYZc",
+	"EFYZ/70fKhDRFa1e1aDsW2XHqrRYX1tVGZmMKSH7gtRDi7wHLbc+9bW0jRqznqgRqXHI5usT5KI04Y6g",
+	"yU577wZkWdb8NCTp1QMQqsotpkAsaRrV8jRg06s5i+deLdE7pM/QsJAltUFHbQ2yZL2Nrbbk+zQduKra",
+	"2zAb3IOh+jule1O5vvN1eYKfey1Z4aed7nfv5m1n9fDxBTVWMc3Mzae1LE+PmpWxRMBUgMvo2KW+1E1q",
+	"ZAnXCjIsFKj1BKYQOScpW8JINDot532YB0wjNZnNAhSwDNgvDTZc6Uqc8KUrX1KEgMe9de3ya8OVn7/c",
+	"3x9g0q0E+CP9gBts1ED2noz+jwCDJS+ETZTeEfB0Cph9wF6gWsddZamymviGks1o0WxjJomAOKVsUSV3",
+	"uqBpynlGEliyGCIiuS3WYvJ4XOBDArXlCSkyW3bCDddMII+MTFCmM0fR+JIUOS6KWa390cknEuvkTEyS",
+	"KbuuluAGMO2M1t7VYK3SVFW2BMtE9XSGYe6SkjL1quyArqQGuoqhbaC936oqjdCgDSn98ShChO+O69Fq",
+	"LsolmtiyB9JdVIAaFpz0EbTp/UkVeHc8x6W/6bL4FYt1pCvT8RGSxrdkxyoWDVHxCcNXxnCEfFKkMMI5",
+	"zjVFU1GtTtpKLnJn5YTfiIOcW/B23rkVvL9X5zgHL0ItjUZGICFcaC+HBtZEhOs61YIIXLQuEnNxQyiJ",
+	"Bc/QeCJA2nq2Yxjr9tDrLp3cKpx6EP5anz7AZ90RPnb3tm+R0e59dX+OS7YR4h6jXdVKcjgr57xbd5tq",
+	"b6togHx8e3JP68QhL8txB+8Fc1XbhpVe3X/sllIUMmO4zpkAcu00GV7QIatSIVpuuEuOaJqa+hlMkgWo",
+	"OU/IokgVy62LmSRYzV7XuDEl7c/PP0QEMCBWD1hI0x0IMnvIlKcqp7IyAmArl+2TLIDKQkBta06VM9ZV",
+	"5dz0exRqqM5s1XaRnmapOg8fXrYkWKeeypzqZFVTXyPzq13ll62oqySo2krd6H81ylZAFyMzqAXtc+f2",
+	"w30Gf+Ocm8Z8mw3dn9DbTC3ad4z+eVH8zTuqva+mlvs4A6sfU+sl9Q2f4rkeeF3zqlnWk231O7OtIlJs",
+	"w7CK6HE/VtXnY9o+fzQMeZDA9xb0upfINQ5ZT50QwbvM1Cao3mHkODZwTK+fOMGj5wRRIIGMYLGp1qkE",
+	"gyXUsETngLHpDToyviDB92UycFUUYp5ZSfAPP12DS4igD+MPQVWwwMJd+nId02ufdz3xqm3zKmuzGyM7",
+	"uqZBllN9bLCZEGZa3tJNiKHamH7e8YdKWOT2ubnc6uD1+GXXaq2jU/72JBbyMeUu1KG1yrMrxWkcbH0N",
+	"XZpQU04E1VI0jiG/4+CLB0aZGpvZ++r+HJ8TuAOZTIsSnc79krWrSjpl1/HaRddpa9rFbV4N26L13gTA",
+	"3WSO3e7kYO6OXdTr8qydBbiJGN2ZgL9LWo86PRoMy6PZyMvh20Cab/GO+Q7ujT29N7n31RbYuu0xXehH",
+	"qV+7fRTS6YOVr8vyZOtj4LBty24idPUchDmMOdp5M3TzezzZPYxzFKrzdfJWfw6VpUXEz8ivR+9NIWyi",
+	"qEAnRJPlwPxk3b9TThNITGa7PxMeX4LQv/3Z+9DpQBazoPtCmZbG4JzO3IPJQA4Ss9mI+AUbTY3GalL9",
+	"b3hlfrajm986VAumft+28she7ygq6qyv1JxcsIyGakUOvLbK3bdR47HoAu7J6BSm2LHqhG3QMIbT7Ukl",
+	"gC46CflMfw4RMvZGapYgliB2JGSKwBK3TopMsdSvsWvqNdq6u3+mfPana4qYR60GNeUzApkSDKR2ZzbF",
+	"YTHdwZ8mFbbt5TrJegVlVch1OAOGI5pdPhh3eJ8lcF3VALYG8RLMzYhQc2TdEaF8Jn+dTiV0hIWuHBPa",
+	"obJNYQlpbYrelNJ89kF3GMOEFFyrPX3WOxV+dnO1fpbjMuyYkZxDQoXET7xkC7wEixhDRrMYOlmJM6ZU",
+	"TVsxE2FhcAQRn1TTP5hUuH837xhvayuq2Wvs2jugJ3zfHN/tfdOL6/UD6KqKMoTaZ+5m+ybuphIFtdje",
+	"SGf/nd5WWyL09cj7kbpUPTyFLkGw6c3gbSRAFmlpH9dJV3VPe6Dbu6I+m/V8b9fTZw9YG11Qy9pAT1fU",
+	"WorcAFz9cM+YxnMTmYo7cQnhBOdqKiuOXWaZ45mNQhV2eB2xKoniV1Qk5vlVeSg0Jpa+hVjPqUNoeQZB",
+	"ArMT0AxjdglVaGJiC9hdSzX4ALR2x9psu6OgM26HOrIG5fl9JZS7G0JcJR7vxcGYtgc/f2s3nCbePtvs",
+	"+2xJU2ZsgI7YEyeq0RsQsnmbGYVMBtfKNrOELq1PlRsPH8+Qy/G23iO91g3pr1Grkso5yOqi1vtR3Ftl",
+	"ZFw4qq+tkHxR3xSbkowrYmTPkNA4p3I+GVdhpuFzch/3rwZyeehj7l9/8x6KGHD9pSTJaKg2xDDx7BKs",
+	"30OoSa2amC5Exjy3dGW7mawLFzB12aJTJvUjShsBy4wViJyIjamuveby4Jh0DN657Y6UPTenwHtygPZw",
+	"OfmAENvME/qvi9I9V8eU6VBEZGf99VtpRoocjWwkZdmlLU6hqMDiiOD07p4CAKGsv41Vvb/Dtr8YtrrR",
+	"5aDZdU7VvMWtu71q2xdKmXXXbmHYXvbsbuQ7hMsnDfkuxwH/XK7mIHSOG/ujZkv2lL6DaN17pw/TfCgl",
+	"ArYK6wPGYv85nclvhyef09mmUSkWSBq+T7hW4treV0VnvX6Wp7DgNqxA0VnkPZGZJBknKc9mIBDclCG4",
+	"LyA2aRemrs94YR2x8lx3uMvnMi5pnBtnA6EQEEm5q7+WkBpMSX4oJZtlDiJ4DVCLG63nHcWkGlbcxLZM",
+	"EgtO7qHUD7ngC65MXg6eppiv7MdScWTfhCxro9RJoR4BPt2d6uWczgyw7ztbWI0FD7BcqlfYJJEnASDI",
+	"lJcHq9Qd7y2R+/nge6443tK/vDOLrRZ6YZS5XJAFvmylstEjoyr6KmMRXDNJk7KGr7poEk2kuknxB7QY",
+	"BlRIR4WQXCDkZel/qgsVY9qFDmChckxj12rQaud21xu0MYOFyEgOguR0Bmvlde8zij67y2Dmp/rxD5BF",
+	"YnlQDwjcNNbr88FDRHt9Pni8vvgWBt9VTfmBa3ANH/6YGtlQH9SFUVtoz84Ef/j6ny5x0AVPbv5j7wou",
+	"5pxf7hUi7YkEwBxDkFSZbeseo3i7TClLZYTKevxuR9WVVbXe/s7R9zcz41t0R9woAsk4rxp0i4EtQet6",
+	"QrxwRHSER8OPIT7ijs9AQ2QlBjI6POO7JG+eFosxWT5tw0Ywd1vUtePdh/bJzLWZ4snt/1u8/N3aRwR1",
+	"5yAkkwh7u2PjjWfjZxborGEeGt3vGM1KvNO9kzyY7kjvN/2lP2vAO8IA7GFyXn5jyYUrlPSYy95X88f4",
+	"vJYW5PhoZUoSfIJG/gf9hEUTaB15aXbTmXTYjG4R+LNdz8oXn9vICmHmHvrcdwrLbxh9on5fTNOw5wK6",
+	"kxPevweOM3RVffeJET0WomfBmDFzcoVIJ68mc6Vy+Wpvj+ZsFw4udmmeT7z+X6sMNVWClvJHXzNQ/qiz",
+	"6fj/1iewoyve1hvmbOcSbmq/lQLEl9v/NwBbobeSEksBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
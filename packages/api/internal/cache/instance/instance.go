@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/e2b-dev/infra/packages/db/types"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -52,7 +53,11 @@ func NewSandbox(
 	autoPause bool,
 	envdAccessToken *string,
 	allowInternetAccess *bool,
+	network *types.SandboxNetworkConfig,
 	baseTemplateID string,
+	idleTimeout time.Duration,
+	memoryLimitMB int64,
+	lastActivity time.Time,
 ) Sandbox {
 	return Sandbox{
 		SandboxID:  sandboxID,
@@ -75,9 +80,13 @@ func NewSandbox(
 		EnvdVersion:         envdVersion,
 		EnvdAccessToken:     envdAccessToken,
 		AllowInternetAccess: allowInternetAccess,
+		Network:             network,
 		NodeID:              nodeID,
 		ClusterID:           clusterID,
 		AutoPause:           autoPause,
+		IdleTimeout:         idleTimeout,
+		MemoryLimitMB:       memoryLimitMB,
+		LastActivity:        lastActivity,
 		State:               StateRunning,
 		BaseTemplateID:      baseTemplateID,
 	}
@@ -105,9 +114,16 @@ type Sandbox struct {
 	EnvdVersion         string
 	EnvdAccessToken     *string
 	AllowInternetAccess *bool
+	Network             *types.SandboxNetworkConfig
 	NodeID              string
 	ClusterID           uuid.UUID
 	AutoPause           bool
+	// IdleTimeout pauses the sandbox when there was no activity for the duration, zero disables the idle pausing.
+	IdleTimeout time.Duration
+	// MemoryLimitMB is the memory available to the sandbox, the rest of RamMB is held by the balloon. Zero means all of RamMB.
+	MemoryLimitMB int64
+	// LastActivity is reported by the node, it's updated on every node sync.
+	LastActivity time.Time
 
 	State State
 }
@@ -137,6 +153,20 @@ func (s Sandbox) IsExpired() bool {
 	return time.Now().After(s.EndTime)
 }
 
+// MemoryMB returns the memory available to the sandbox.
+func (s Sandbox) MemoryMB() int64 {
+	if s.MemoryLimitMB > 0 {
+		return s.MemoryLimitMB
+	}
+
+	return s.RamMB
+}
+
+// IsIdle returns true if the sandbox has the idle pausing enabled and there was no activity for the idle timeout.
+func (s Sandbox) IsIdle() bool {
+	return s.IdleTimeout > 0 && time.Since(s.LastActivity) > s.IdleTimeout
+}
+
 func (i *memorySandbox) SetExpired() {
 	i.mu.Lock()
 	defer i.mu.Unlock()
@@ -187,3 +217,33 @@ func (i *memorySandbox) extendEndTime(newEndTime time.Time, allowShorter bool) b
 
 	return true
 }
+
+func (i *memorySandbox) setLastActivity(lastActivity time.Time) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if lastActivity.After(i._data.LastActivity) {
+		i._data.LastActivity = lastActivity
+	}
+}
+
+func (i *memorySandbox) setMemoryLimit(memoryLimitMB int64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i._data.MemoryLimitMB = memoryLimitMB
+}
+
+func (i *memorySandbox) setIngress(ingress *types.SandboxIngressConfig) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// Copy the network config, the previous one can be still used by the callers of Data()
+	network := types.SandboxNetworkConfig{}
+	if i._data.Network != nil {
+		network = *i._data.Network
+	}
+
+	network.Ingress = ingress
+	i._data.Network = &network
+}
@@ -483,3 +483,34 @@ func TestConcurrency_StressTest(t *testing.T) {
 	// Should have completed many operations without panic
 	assert.Greater(t, finalOps, uint64(100), "Should complete many operations")
 }
+
+func TestItemsToEvict_Idle(t *testing.T) {
+	ctx := t.Context()
+	store := NewStore(nil, nil)
+
+	idle := createTestSandbox()._data
+	idle.SandboxID = "idle-sandbox"
+	idle.IdleTimeout = time.Minute
+	idle.LastActivity = time.Now().Add(-2 * time.Minute)
+	store.Add(ctx, idle, true)
+
+	active := createTestSandbox()._data
+	active.SandboxID = "active-sandbox"
+	active.IdleTimeout = time.Minute
+	active.LastActivity = time.Now().Add(-2 * time.Minute)
+	store.Add(ctx, active, true)
+
+	disabled := createTestSandbox()._data
+	disabled.SandboxID = "disabled-sandbox"
+	disabled.LastActivity = time.Now().Add(-2 * time.Minute)
+	store.Add(ctx, disabled, true)
+
+	// The activity reported by the node sync is newer
+	reported := active
+	reported.LastActivity = time.Now()
+	store.Sync(ctx, []Sandbox{reported}, "")
+
+	items := store.ItemsToEvict()
+	require.Len(t, items, 1)
+	assert.Equal(t, "idle-sandbox", items[0].SandboxID)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -109,7 +110,7 @@ func (ms *MemoryStore) ItemsToEvict() []Sandbox {
 	items := make([]Sandbox, 0)
 	for _, item := range ms.items.Items() {
 		data := item.Data()
-		if !data.IsExpired() {
+		if !data.IsExpired() && !data.IsIdle() {
 			continue
 		}
 
@@ -156,6 +157,28 @@ func (ms *MemoryStore) ExtendEndTime(sandboxID string, newEndTime time.Time, all
 	return item.extendEndTime(newEndTime, allowShorter), nil
 }
 
+func (ms *MemoryStore) SetIngress(sandboxID string, ingress *types.SandboxIngressConfig) error {
+	item, ok := ms.items.Get(sandboxID)
+	if !ok {
+		return fmt.Errorf("sandbox \"%s\" doesn't exist", sandboxID)
+	}
+
+	item.setIngress(ingress)
+
+	return nil
+}
+
+func (ms *MemoryStore) SetMemoryLimit(sandboxID string, memoryLimitMB int64) error {
+	item, ok := ms.items.Get(sandboxID)
+	if !ok {
+		return fmt.Errorf("sandbox \"%s\" doesn't exist", sandboxID)
+	}
+
+	item.setMemoryLimit(memoryLimitMB)
+
+	return nil
+}
+
 func (ms *MemoryStore) StartRemoving(ctx context.Context, sandboxID string, stateAction StateAction) (alreadyDone bool, callback func(error), err error) {
 	sbx, err := ms.get(sandboxID)
 	if err != nil {
@@ -39,9 +39,11 @@ func (ms *MemoryStore) Sync(ctx context.Context, sandboxes []Sandbox, nodeID str
 		}
 	}
 
-	// Add sandboxes that are not in the cache with the default TTL
+	// Add sandboxes that are not in the cache with the default TTL, update the activity of the ones that are
 	for _, sandbox := range sandboxes {
-		if ms.Exists(sandbox.SandboxID) {
+		if item, ok := ms.items.Get(sandbox.SandboxID); ok {
+			item.setLastActivity(sandbox.LastActivity)
+
 			continue
 		}
 
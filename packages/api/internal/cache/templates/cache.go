@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -74,7 +75,8 @@ func NewTemplateCache(db *sqlcdb.Client) *TemplateCache {
 	}
 }
 
-func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uuid.UUID, clusterID uuid.UUID, public bool) (*api.Template, *queries.EnvBuild, *api.APIError) {
+// Get returns the template and its build, the tagged build if the tag is set, otherwise the latest successful build.
+func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, tag string, teamID uuid.UUID, clusterID uuid.UUID, public bool) (*api.Template, *queries.EnvBuild, *api.APIError) {
 	var item *ttlcache.Item[string, *TemplateInfo]
 	var templateInfo *TemplateInfo
 
@@ -82,13 +84,17 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 
 	templateID, found := c.aliasCache.Get(aliasOrEnvID)
 	if found == true {
-		item = c.cache.Get(templateID)
+		item = c.cache.Get(cacheKey(templateID, tag))
 	}
 
 	if item == nil {
-		result, err := c.db.GetTemplateWithBuild(ctx, aliasOrEnvID)
+		result, err := c.getTemplateWithBuild(ctx, aliasOrEnvID, tag)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
+				if tag != "" {
+					return nil, nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s' with tag '%s' not found", aliasOrEnvID, tag), Err: err}
+				}
+
 				return nil, nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s' not found", aliasOrEnvID), Err: err}
 			}
 
@@ -125,7 +131,7 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 			build:     build,
 		}
 
-		c.cache.Set(template.ID, templateInfo, templateInfoExpiration)
+		c.cache.Set(cacheKey(template.ID, tag), templateInfo, templateInfoExpiration)
 	} else {
 		templateInfo = item.Value()
 		build = templateInfo.build
@@ -142,9 +148,39 @@ func (c *TemplateCache) Get(ctx context.Context, aliasOrEnvID string, teamID uui
 	return templateInfo.template, build, nil
 }
 
-// Invalidate invalidates the cache for the given templateID
+func (c *TemplateCache) getTemplateWithBuild(ctx context.Context, aliasOrEnvID string, tag string) (queries.GetTemplateWithBuildRow, error) {
+	if tag == "" {
+		return c.db.GetTemplateWithBuild(ctx, aliasOrEnvID)
+	}
+
+	result, err := c.db.GetTemplateWithBuildByTag(ctx, queries.GetTemplateWithBuildByTagParams{
+		AliasOrEnvID: aliasOrEnvID,
+		Tag:          tag,
+	})
+	if err != nil {
+		return queries.GetTemplateWithBuildRow{}, err
+	}
+
+	return queries.GetTemplateWithBuildRow(result), nil
+}
+
+// Invalidate invalidates the cache for the given templateID, including its tagged builds
 func (c *TemplateCache) Invalidate(templateID string) {
-	c.cache.Delete(templateID)
+	for _, key := range c.cache.Keys() {
+		if key == templateID || strings.HasPrefix(key, templateID+tagKeySeparator) {
+			c.cache.Delete(key)
+		}
+	}
+}
+
+const tagKeySeparator = ":"
+
+func cacheKey(templateID string, tag string) string {
+	if tag == "" {
+		return templateID
+	}
+
+	return templateID + tagKeySeparator + tag
 }
 
 type TemplateBuildInfo struct {
@@ -153,8 +189,9 @@ type TemplateBuildInfo struct {
 	BuildStatus envbuild.Status
 	Reason      types.BuildReason
 
-	ClusterID uuid.UUID
-	NodeID    string
+	ClusterID       uuid.UUID
+	NodeID          string
+	CPUArchitecture string
 }
 
 type TemplateBuildInfoNotFoundError struct{}
@@ -206,8 +243,9 @@ func (c *TemplatesBuildCache) SetStatus(buildID uuid.UUID, status envbuild.Statu
 			BuildStatus: status,
 			Reason:      reason,
 
-			ClusterID: item.ClusterID,
-			NodeID:    item.NodeID,
+			ClusterID:       item.ClusterID,
+			NodeID:          item.NodeID,
+			CPUArchitecture: item.CPUArchitecture,
 		},
 		templateInfoExpiration,
 	)
@@ -238,8 +276,9 @@ func (c *TemplatesBuildCache) Get(ctx context.Context, buildID uuid.UUID, templa
 				BuildStatus: envbuild.Status(result.EnvBuild.Status),
 				Reason:      result.EnvBuild.Reason,
 
-				ClusterID: utils.WithClusterFallback(result.Env.ClusterID),
-				NodeID:    result.EnvBuild.ClusterNodeID,
+				ClusterID:       utils.WithClusterFallback(result.Env.ClusterID),
+				NodeID:          result.EnvBuild.ClusterNodeID,
+				CPUArchitecture: result.EnvBuild.CpuArchitecture,
 			},
 			templateInfoExpiration,
 		)
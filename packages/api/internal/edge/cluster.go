@@ -128,7 +128,8 @@ func (c *Cluster) GetByServiceInstanceID(serviceInstanceID string) (*ClusterInst
 	return nil, false
 }
 
-func (c *Cluster) GetAvailableTemplateBuilder(ctx context.Context) (*ClusterInstance, error) {
+// GetAvailableTemplateBuilder returns a random healthy builder, an empty architecture matches builders of any architecture.
+func (c *Cluster) GetAvailableTemplateBuilder(ctx context.Context, cpuArchitecture string) (*ClusterInstance, error) {
 	_, span := tracer.Start(ctx, "template-builder-get-available-instance")
 	span.SetAttributes(telemetry.WithClusterID(c.ID))
 	defer span.End()
@@ -152,6 +153,10 @@ func (c *Cluster) GetAvailableTemplateBuilder(ctx context.Context) (*ClusterInst
 			continue
 		}
 
+		if cpuArchitecture != "" && instance.GetCPUArchitecture() != cpuArchitecture {
+			continue
+		}
+
 		return instance, nil
 	}
 
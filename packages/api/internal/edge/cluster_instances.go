@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	infogrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
 	api "github.com/e2b-dev/infra/packages/shared/pkg/http/edge"
 	l "github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -26,9 +27,10 @@ type ClusterInstance struct {
 	ServiceVersion       string
 	ServiceVersionCommit string
 
-	roles  []infogrpc.ServiceInfoRole
-	status infogrpc.ServiceInfoStatus
-	mutex  sync.RWMutex
+	roles           []infogrpc.ServiceInfoRole
+	status          infogrpc.ServiceInfoStatus
+	cpuArchitecture string
+	mutex           sync.RWMutex
 }
 
 const (
@@ -58,6 +60,7 @@ func (c *Cluster) syncInstance(ctx context.Context, instance *ClusterInstance) {
 
 	instance.status = info.ServiceStatus
 	instance.roles = info.ServiceRoles
+	instance.cpuArchitecture = consts.CPUArchitectureOrDefault(info.ServiceCpuArchitecture)
 }
 
 func (n *ClusterInstance) GetStatus() infogrpc.ServiceInfoStatus {
@@ -66,6 +69,12 @@ func (n *ClusterInstance) GetStatus() infogrpc.ServiceInfoStatus {
 	return n.status
 }
 
+func (n *ClusterInstance) GetCPUArchitecture() string {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.cpuArchitecture
+}
+
 func (n *ClusterInstance) hasRole(r infogrpc.ServiceInfoRole) bool {
 	n.mutex.RLock()
 	defer n.mutex.RUnlock()
@@ -140,8 +149,9 @@ func (d clusterSynchronizationStore) PoolInsert(ctx context.Context, item api.Cl
 		ServiceVersionCommit: item.ServiceVersionCommit,
 
 		// initial values before first sync
-		status: infogrpc.ServiceInfoStatus_Unhealthy,
-		roles:  make([]infogrpc.ServiceInfoRole, 0),
+		status:          infogrpc.ServiceInfoStatus_Unhealthy,
+		roles:           make([]infogrpc.ServiceInfoRole, 0),
+		cpuArchitecture: consts.DefaultCPUArchitecture,
 
 		mutex: sync.RWMutex{},
 	}
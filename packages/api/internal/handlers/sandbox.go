@@ -13,6 +13,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -33,8 +34,12 @@ func (a *APIStore) startSandbox(
 	nodeID *string,
 	baseTemplateID string,
 	autoPause bool,
+	idleTimeout time.Duration,
+	memoryLimitMB int64,
 	envdAccessToken *string,
 	allowInternetAccess *bool,
+	network *types.SandboxNetworkConfig,
+	volumes []queries.Volume,
 ) (*api.Sandbox, *api.APIError) {
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
@@ -57,8 +62,12 @@ func (a *APIStore) startSandbox(
 		nodeID,
 		baseTemplateID,
 		autoPause,
+		idleTimeout,
+		memoryLimitMB,
 		envdAccessToken,
 		allowInternetAccess,
+		network,
+		volumes,
 	)
 	if instanceErr != nil {
 		telemetry.ReportError(ctx, "error when creating instance", instanceErr.Err)
@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	checkpoints, err := a.sqlcDB.GetSnapshotCheckpoints(ctx, queries.GetSnapshotCheckpointsParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error getting sandbox checkpoints", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting sandbox checkpoints")
+
+		return
+	}
+
+	result := make([]api.SandboxCheckpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		result = append(result, api.SandboxCheckpoint{
+			Name:      checkpoint.Name,
+			CreatedAt: checkpoint.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.String("checkpoint.name", body.Name),
+	)
+
+	_, err = a.sqlcDB.GetSnapshotCheckpoint(ctx, queries.GetSnapshotCheckpointParams{SandboxID: sandboxID, TeamID: teamID, Name: body.Name})
+	switch {
+	case err == nil:
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", body.Name, sandboxID))
+		return
+	case !errors.Is(err, sql.ErrNoRows):
+		telemetry.ReportCriticalError(ctx, "error getting sandbox checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox checkpoint")
+		return
+	}
+
+	withVolumes, err := a.hasVolumes(ctx, teamID, sandboxID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error checking sandbox volumes", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox checkpoint")
+		return
+	}
+
+	if withVolumes {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox '%s' with mounted volumes can't be checkpointed", sandboxID))
+		return
+	}
+
+	buildID := uuid.Nil
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, true)
+	if err == nil && sbx.TeamID == teamID {
+		switch sbx.State {
+		case instance.StateRunning:
+			var checkpointErr *api.APIError
+			buildID, checkpointErr = a.orchestrator.CheckpointSandbox(ctx, sbx, teamInfo)
+			if checkpointErr != nil {
+				zap.L().Error("Failed to checkpoint sandbox", logger.WithSandboxID(sandboxID), zap.Error(checkpointErr.Err))
+				a.sendAPIStoreError(c, checkpointErr.Code, checkpointErr.ClientMsg)
+
+				return
+			}
+		case instance.StatePausing:
+			zap.L().Debug("Waiting for sandbox to pause", logger.WithSandboxID(sandboxID))
+			err = a.orchestrator.WaitForStateChange(ctx, sandboxID)
+			if err != nil {
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error waiting for sandbox to pause")
+				return
+			}
+		case instance.StateKilling:
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is being killed", sandboxID))
+			return
+		}
+	}
+
+	// The sandbox is paused, the last snapshot is used for the checkpoint
+	if buildID == uuid.Nil {
+		lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamID})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("sandbox \"%s\" doesn't exist or you don't have access to it", sandboxID))
+				return
+			}
+
+			zap.L().Error("Error getting last snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox checkpoint")
+			return
+		}
+
+		buildID = lastSnapshot.EnvBuild.ID
+	}
+
+	checkpoint, err := a.sqlcDB.CreateSnapshotCheckpoint(ctx, queries.CreateSnapshotCheckpointParams{
+		BuildID:   buildID,
+		Name:      body.Name,
+		SandboxID: sandboxID,
+		TeamID:    teamID,
+	})
+	if err != nil {
+		// The insert is skipped when the checkpoint with the same name was created in the meantime
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", body.Name, sandboxID))
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error creating sandbox checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox checkpoint")
+		return
+	}
+
+	c.JSON(http.StatusCreated, api.SandboxCheckpoint{
+		Name:      checkpoint.Name,
+		CreatedAt: checkpoint.CreatedAt,
+	})
+}
+
+func (a *APIStore) DeleteSandboxesSandboxIDCheckpointsCheckpointName(c *gin.Context, sandboxID api.SandboxID, checkpointName api.CheckpointName) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	deleted, err := a.sqlcDB.DeleteSnapshotCheckpoint(ctx, queries.DeleteSnapshotCheckpointParams{SandboxID: sandboxID, TeamID: teamID, Name: checkpointName})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error deleting sandbox checkpoint", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting sandbox checkpoint")
+
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' for sandbox '%s' not found", checkpointName, sandboxID))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpointsCheckpointNameRestore(c *gin.Context, sandboxID api.SandboxID, checkpointName api.CheckpointName) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	timeout := instance.SandboxTimeoutDefault
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.String("checkpoint.name", checkpointName),
+	)
+
+	checkpoint, err := a.sqlcDB.GetSnapshotCheckpoint(ctx, queries.GetSnapshotCheckpointParams{SandboxID: sandboxID, TeamID: teamID, Name: checkpointName})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' for sandbox '%s' not found", checkpointName, sandboxID))
+			return
+		}
+
+		zap.L().Error("Error getting sandbox checkpoint", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error restoring sandbox checkpoint")
+		return
+	}
+
+	// The running sandbox is replaced by the one restored from the checkpoint, the snapshots are kept
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, true)
+	if err == nil && sbx.TeamID == teamID {
+		switch sbx.State {
+		case instance.StateRunning:
+			err = a.orchestrator.RemoveSandbox(ctx, sbx, instance.StateActionKill)
+			if err != nil {
+				zap.L().Error("Error killing sandbox before restore", logger.WithSandboxID(sandboxID), zap.Error(err))
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error restoring sandbox checkpoint")
+				return
+			}
+		default:
+			zap.L().Debug("Waiting for sandbox to stop", logger.WithSandboxID(sandboxID))
+			err = a.orchestrator.WaitForStateChange(ctx, sandboxID)
+			if err != nil {
+				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error waiting for sandbox to stop")
+				return
+			}
+		}
+	}
+
+	snap := checkpoint.Snapshot
+	build := checkpoint.EnvBuild
+	// The network and the limits are restored as they were when the checkpoint was created, the later changes are dropped with the memory state
+	config := checkpoint.SnapshotCheckpoint
+
+	autoPause := snap.AutoPause
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	alias := ""
+	if len(checkpoint.Aliases) > 0 {
+		alias = checkpoint.Aliases[0]
+	}
+
+	var envdAccessToken *string = nil
+	if snap.EnvSecure {
+		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
+		if tokenErr != nil {
+			zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithTemplateID(build.EnvID), logger.WithBuildID(build.ID.String()))
+			a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
+			return
+		}
+
+		envdAccessToken = &accessToken
+	}
+
+	sbxResp, createErr := a.startSandbox(
+		ctx,
+		snap.SandboxID,
+		timeout,
+		nil,
+		snap.Metadata,
+		alias,
+		teamInfo,
+		build,
+		&c.Request.Header,
+		true,
+		&build.ClusterNodeID,
+		snap.BaseEnvID,
+		autoPause,
+		time.Duration(config.IdleTimeout)*time.Second,
+		config.MemoryLimitMb,
+		envdAccessToken,
+		config.AllowInternetAccess,
+		config.Network,
+		nil,
+	)
+	if createErr != nil {
+		zap.L().Error("Failed to restore sandbox checkpoint", zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &sbxResp)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,7 +17,9 @@ import (
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/middleware/otel/metrics"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
@@ -28,6 +31,8 @@ const (
 	InstanceIDPrefix            = "i"
 	metricTemplateAlias         = metrics.MetricPrefix + "template.alias"
 	minEnvdVersionForSecureFlag = "0.2.0" // Minimum version of envd that supports secure flag
+	minEnvdVersionForVolumes    = "0.3.5" // Minimum version of envd that mounts the volumes
+	minEnvdVersionForDNS        = "0.3.4" // Minimum version of envd that applies the DNS config
 )
 
 // mostUsedTemplates is a map of the most used template aliases.
@@ -62,7 +67,8 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	telemetry.ReportEvent(ctx, "Parsed body")
 
-	cleanedAliasOrEnvID, err := id.CleanEnvID(body.TemplateID)
+	// The template can reference a tagged build, e.g. "my-template:stable"
+	cleanedAliasOrEnvID, tag, err := id.ParseTemplateTag(body.TemplateID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid environment ID: %s", err))
 
@@ -78,7 +84,7 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	// Check if team has access to the environment
 	clusterID := utils.WithClusterFallback(teamInfo.Team.ClusterID)
-	env, build, checkErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, clusterID, true)
+	env, build, checkErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, tag, teamInfo.Team.ID, clusterID, true)
 	if checkErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when getting template", checkErr.Err)
 		a.sendAPIStoreError(c, checkErr.Code, checkErr.ClientMsg)
@@ -135,6 +141,11 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		autoPause = *body.AutoPause
 	}
 
+	var idleTimeout time.Duration
+	if body.IdleTimeout != nil {
+		idleTimeout = time.Duration(*body.IdleTimeout) * time.Second
+	}
+
 	var envdAccessToken *string = nil
 	if body.Secure != nil && *body.Secure == true {
 		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
@@ -149,6 +160,42 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	allowInternetAccess := body.AllowInternetAccess
 
+	network, err := sandbox.NetworkConfigFromAPI(body.Network)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network config: %s", err))
+		return
+	}
+
+	// The older envd ignores the DNS config, the sandbox would start with the template resolver config
+	if body.Network != nil && body.Network.Dns != nil {
+		if dnsErr := checkEnvdSupport(build.EnvdVersion, minEnvdVersionForDNS, "DNS config"); dnsErr != nil {
+			a.sendAPIStoreError(c, dnsErr.Code, dnsErr.ClientMsg)
+			return
+		}
+	}
+
+	var volumes []queries.Volume
+	if body.VolumeMounts != nil && len(*body.VolumeMounts) > 0 {
+		if volumesErr := checkEnvdSupport(build.EnvdVersion, minEnvdVersionForVolumes, "volume mounts"); volumesErr != nil {
+			a.sendAPIStoreError(c, volumesErr.Code, volumesErr.ClientMsg)
+			return
+		}
+
+		err = sandbox.ValidateVolumeMounts(*body.VolumeMounts)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid volume mounts: %s", err))
+			return
+		}
+
+		var attachErr *api.APIError
+		volumes, attachErr = a.attachVolumes(ctx, teamInfo.Team.ID, sandboxID, *body.VolumeMounts)
+		if attachErr != nil {
+			telemetry.ReportError(ctx, "error when attaching volumes", attachErr.Err)
+			a.sendAPIStoreError(c, attachErr.Code, attachErr.ClientMsg)
+			return
+		}
+	}
+
 	sbx, createErr := a.startSandbox(
 		ctx,
 		sandboxID,
@@ -163,10 +210,18 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		nil,
 		env.TemplateID,
 		autoPause,
+		idleTimeout,
+		0,
 		envdAccessToken,
 		allowInternetAccess,
+		network,
+		volumes,
 	)
 	if createErr != nil {
+		if len(volumes) > 0 {
+			a.detachVolumes(context.WithoutCancel(ctx), teamInfo.Team.ID, sandboxID)
+		}
+
 		zap.L().Error("Failed to create sandbox", zap.Error(createErr.Err))
 		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
 		return
@@ -213,6 +268,35 @@ func (a *APIStore) getEnvdAccessToken(envdVersion *string, sandboxID string) (st
 	return key, nil
 }
 
+// checkEnvdSupport checks that the envd of the template build supports the feature.
+func checkEnvdSupport(envdVersion *string, minVersion string, feature string) *api.APIError {
+	if envdVersion == nil {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("you need to re-build template to allow %s", feature),
+			Err:       fmt.Errorf("envd version is required for %s", feature),
+		}
+	}
+
+	ok, err := sharedUtils.IsGTEVersion(*envdVersion, minVersion)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "error during envd version check",
+			Err:       err,
+		}
+	}
+	if !ok {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("current template build does not support %s, you need to re-build template to allow it", feature),
+			Err:       fmt.Errorf("envd version is not supported for %s", feature),
+		}
+	}
+
+	return nil
+}
+
 func setTemplateNameMetric(c *gin.Context, aliases []string) {
 	for _, alias := range aliases {
 		if _, exists := mostUsedTemplates[alias]; exists {
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const defaultForkCount = 1
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	count := defaultForkCount
+	if body.Count != nil {
+		count = int(*body.Count)
+	}
+
+	timeout := instance.SandboxTimeoutDefault
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	var metadata map[string]string
+	if body.Metadata != nil {
+		metadata = *body.Metadata
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, true)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("sandbox \"%s\" doesn't exist or you don't have access to it", sandboxID))
+		return
+	}
+
+	if sbx.TeamID != teamInfo.Team.ID {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("sandbox \"%s\" doesn't exist or you don't have access to it", sandboxID))
+		return
+	}
+
+	if sbx.State != instance.StateRunning {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+		return
+	}
+
+	withVolumes, err := a.hasVolumes(ctx, teamInfo.Team.ID, sandboxID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error checking sandbox volumes", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error forking sandbox")
+		return
+	}
+
+	if withVolumes {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox '%s' with mounted volumes can't be forked", sandboxID))
+		return
+	}
+
+	forkIDs := make([]string, 0, count)
+	for range count {
+		forkIDs = append(forkIDs, InstanceIDPrefix+id.Generate())
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.Int("fork.count", count),
+	)
+
+	forks, forkErr := a.orchestrator.ForkSandbox(ctx, sbx, teamInfo, forkIDs, metadata, timeout)
+	if forkErr != nil {
+		zap.L().Error("Failed to fork sandbox", logger.WithSandboxID(sandboxID), zap.Error(forkErr.Err))
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	for _, fork := range forks.Sandboxes {
+		a.posthog.CreateAnalyticsTeamEvent(teamInfo.Team.ID.String(), "forked_instance",
+			a.posthog.GetPackageToPosthogProperties(&c.Request.Header).
+				Set("environment", sbx.TemplateID).
+				Set("instance_id", fork.SandboxID).
+				Set("forked_instance_id", sandboxID),
+		)
+	}
+
+	c.JSON(http.StatusCreated, forks)
+}
@@ -72,7 +72,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			SandboxID:       sbx.SandboxID,
 			StartedAt:       sbx.StartTime,
 			CpuCount:        api.CPUCount(sbx.VCpu),
-			MemoryMB:        api.MemoryMB(sbx.RamMB),
+			MemoryMB:        api.MemoryMB(sbx.MemoryMB()),
 			DiskSizeMB:      api.DiskSizeMB(sbx.TotalDiskSizeMB),
 			EndAt:           sbx.EndTime,
 			State:           state,
@@ -100,6 +100,9 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 	}
 
 	memoryMB := int32(lastSnapshot.EnvBuild.RamMb)
+	if lastSnapshot.Snapshot.MemoryLimitMb > 0 {
+		memoryMB = int32(lastSnapshot.Snapshot.MemoryLimitMb)
+	}
 	cpuCount := int32(lastSnapshot.EnvBuild.Vcpu)
 
 	diskSize := int32(0)
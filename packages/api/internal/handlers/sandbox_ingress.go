@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PutSandboxesSandboxIDIngress(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	telemetry.SetAttributes(ctx, telemetry.WithSandboxID(sandboxID))
+
+	body, err := utils.ParseBody[api.PutSandboxesSandboxIDIngressJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil || sbx.TeamID != teamID {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+		return
+	}
+
+	// The traffic access token is kept, so the clients using it don't lose access
+	trafficAccessToken := ""
+	if sbx.Network != nil && sbx.Network.Ingress != nil {
+		trafficAccessToken = sbx.Network.Ingress.TrafficAccessToken
+	}
+
+	ingress, err := sandbox.IngressConfigFromAPI(body, trafficAccessToken)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid ingress config: %s", err))
+		return
+	}
+
+	err = a.orchestrator.UpdateSandboxIngress(ctx, sbx, ingress)
+	if err != nil {
+		zap.L().Error("Error updating sandbox ingress", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error updating sandbox ingress")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, sandbox.IngressToAPI(ingress))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -65,6 +66,17 @@ func (a *APIStore) deleteSnapshot(ctx context.Context, sandboxID string, teamID
 
 	a.templateCache.Invalidate(env.ID)
 
+	// The paused sandbox no longer holds its volumes
+	err = a.sqlcDB.DetachSandboxVolumes(ctx, queries.DetachSandboxVolumesParams{SandboxID: &sandboxID, TeamID: teamID})
+	if err != nil {
+		return fmt.Errorf("error detaching sandbox volumes: %w", err)
+	}
+
+	err = a.sqlcDB.DeleteSandboxSchedules(ctx, queries.DeleteSandboxSchedulesParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		return fmt.Errorf("error deleting sandbox schedules: %w", err)
+	}
+
 	return nil
 }
 
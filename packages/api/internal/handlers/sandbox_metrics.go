@@ -81,6 +81,8 @@ func (a *APIStore) GetSandboxesSandboxIDMetrics(c *gin.Context, sandboxID string
 			MemUsed:       int64(m.MemUsed),
 			DiskTotal:     int64(m.DiskTotal),
 			DiskUsed:      int64(m.DiskUsed),
+			NetworkRx:     int64(m.NetworkRx),
+			NetworkTx:     int64(m.NetworkTx),
 		}
 	}
 
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/team"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PatchSandboxesSandboxIDResources(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	sandboxID = utils.ShortID(sandboxID)
+
+	telemetry.SetAttributes(ctx, telemetry.WithSandboxID(sandboxID))
+
+	body, err := utils.ParseBody[api.PatchSandboxesSandboxIDResourcesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, false)
+	if err != nil || sbx.TeamID != teamInfo.Team.ID {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' is not running", sandboxID))
+		return
+	}
+
+	_, memoryMB, limitErr := team.LimitResources(teamInfo.Tier, nil, &body.MemoryMB)
+	if limitErr != nil {
+		a.sendAPIStoreError(c, limitErr.Code, limitErr.ClientMsg)
+		return
+	}
+
+	// The balloon can only take the memory from the sandbox, the VM memory is fixed by the template
+	if memoryMB > sbx.RamMB {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Memory can't be higher than the memory of the sandbox template (%d MiB)", sbx.RamMB))
+		return
+	}
+
+	memoryLimitMB := memoryMB
+	if memoryMB == sbx.RamMB {
+		memoryLimitMB = 0
+	}
+
+	apiErr := a.orchestrator.UpdateSandboxMemory(ctx, sbx, memoryLimitMB)
+	if apiErr != nil {
+		zap.L().Error("Error resizing sandbox memory", logger.WithSandboxID(sandboxID), zap.Error(apiErr.Err))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SandboxResources{
+		CpuCount:    api.CPUCount(sbx.VCpu),
+		MemoryMB:    api.MemoryMB(memoryMB),
+		MaxMemoryMB: int32(sbx.RamMB),
+	})
+}
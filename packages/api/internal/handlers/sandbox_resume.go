@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -54,7 +55,33 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		}
 	}
 
+	var idleTimeout *time.Duration
+	if body.IdleTimeout != nil {
+		override := time.Duration(*body.IdleTimeout) * time.Second
+		idleTimeout = &override
+	}
+
 	sandboxID = utils.ShortID(sandboxID)
+	sbx, resumeErr := a.resumeSandbox(ctx, teamInfo, sandboxID, timeout, body.AutoPause, idleTimeout, &c.Request.Header)
+	if resumeErr != nil {
+		a.sendAPIStoreError(c, resumeErr.Code, resumeErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &sbx)
+}
+
+// resumeSandbox starts the sandbox from its last snapshot, the auto pause and idle timeout of the snapshot are kept when no override is passed.
+func (a *APIStore) resumeSandbox(
+	ctx context.Context,
+	teamInfo authcache.AuthTeamInfo,
+	sandboxID string,
+	timeout time.Duration,
+	autoPauseOverride *bool,
+	idleTimeoutOverride *time.Duration,
+	requestHeader *http.Header,
+) (*api.Sandbox, *api.APIError) {
 	sandboxData, err := a.orchestrator.GetSandbox(sandboxID, true)
 	if err == nil {
 		switch sandboxData.State {
@@ -62,15 +89,19 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 			zap.L().Debug("Waiting for sandbox to pause", logger.WithSandboxID(sandboxID))
 			err = a.orchestrator.WaitForStateChange(ctx, sandboxID)
 			if err != nil {
-				a.sendAPIStoreError(c, http.StatusInternalServerError, "Error waiting for sandbox to pause")
-				return
+				return nil, &api.APIError{
+					Code:      http.StatusInternalServerError,
+					ClientMsg: "Error waiting for sandbox to pause",
+					Err:       fmt.Errorf("error waiting for sandbox to pause: %w", err),
+				}
 			}
 		case instance.StateKilling:
-			a.sendAPIStoreError(c, http.StatusNotFound, "Sandbox can't be resumed, no snapshot found")
-			return
+			return nil, &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: "Sandbox can't be resumed, no snapshot found",
+				Err:       fmt.Errorf("sandbox '%s' is being killed", sandboxID),
+			}
 		case instance.StateRunning:
-			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is already running", sandboxID))
-
 			zap.L().Debug("Sandbox is already running",
 				logger.WithSandboxID(sandboxID),
 				zap.Time("end_time", sandboxData.EndTime),
@@ -78,11 +109,18 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 				zap.String("node_id", sandboxData.NodeID),
 			)
 
-			return
+			return nil, &api.APIError{
+				Code:      http.StatusConflict,
+				ClientMsg: fmt.Sprintf("Sandbox %s is already running", sandboxID),
+				Err:       fmt.Errorf("sandbox '%s' is already running", sandboxID),
+			}
 		default:
 			zap.L().Error("Sandbox is in an unknown state", logger.WithSandboxID(sandboxID), zap.String("state", string(sandboxData.State)))
-			a.sendAPIStoreError(c, http.StatusInternalServerError, "Sandbox is in an unknown state")
-			return
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Sandbox is in an unknown state",
+				Err:       fmt.Errorf("sandbox '%s' is in an unknown state '%s'", sandboxID, sandboxData.State),
+			}
 		}
 	}
 
@@ -90,19 +128,31 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			zap.L().Debug("Snapshot not found", logger.WithSandboxID(sandboxID))
-			a.sendAPIStoreError(c, http.StatusNotFound, "Sandbox can't be resumed, no snapshot found")
-			return
+			return nil, &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: "Sandbox can't be resumed, no snapshot found",
+				Err:       err,
+			}
 		}
 
 		zap.L().Error("Error getting last snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
-		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting snapshot")
-		return
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting snapshot",
+			Err:       fmt.Errorf("error getting last snapshot: %w", err),
+		}
 	}
 
 	autoPause := lastSnapshot.Snapshot.AutoPause
-	if body.AutoPause != nil {
-		autoPause = *body.AutoPause
+	if autoPauseOverride != nil {
+		autoPause = *autoPauseOverride
 	}
+
+	idleTimeout := time.Duration(lastSnapshot.Snapshot.IdleTimeout) * time.Second
+	if idleTimeoutOverride != nil {
+		idleTimeout = *idleTimeoutOverride
+	}
+
 	snap := lastSnapshot.Snapshot
 	build := lastSnapshot.EnvBuild
 
@@ -124,13 +174,23 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
 		if tokenErr != nil {
 			zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithTemplateID(build.EnvID), logger.WithBuildID(build.ID.String()))
-			a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
-			return
+			return nil, tokenErr
 		}
 
 		envdAccessToken = &accessToken
 	}
 
+	// The volumes stay attached while the sandbox is paused
+	volumes, err := a.sqlcDB.GetSandboxVolumes(ctx, queries.GetSandboxVolumesParams{SandboxID: &snap.SandboxID, TeamID: teamInfo.Team.ID})
+	if err != nil {
+		zap.L().Error("Error getting sandbox volumes", logger.WithSandboxID(snap.SandboxID), zap.Error(err))
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error resuming sandbox",
+			Err:       fmt.Errorf("error getting sandbox volumes: %w", err),
+		}
+	}
+
 	sbx, createErr := a.startSandbox(
 		ctx,
 		snap.SandboxID,
@@ -140,21 +200,23 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		alias,
 		teamInfo,
 		build,
-		&c.Request.Header,
+		requestHeader,
 		true,
 		nodeID,
 		snap.BaseEnvID,
 		autoPause,
+		idleTimeout,
+		snap.MemoryLimitMb,
 		envdAccessToken,
 		snap.AllowInternetAccess,
+		snap.Network,
+		volumes,
 	)
 
 	if createErr != nil {
 		zap.L().Error("Failed to resume sandbox", zap.Error(createErr.Err))
-		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
-
-		return
+		return nil, createErr
 	}
 
-	c.JSON(http.StatusCreated, &sbx)
+	return sbx, nil
 }
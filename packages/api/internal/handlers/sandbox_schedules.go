@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/scheduler"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const defaultScheduleTimeout = 300 * time.Second
+
+func (a *APIStore) GetSandboxesSandboxIDSchedules(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	schedules, err := a.sqlcDB.GetSandboxSchedules(ctx, queries.GetSandboxSchedulesParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error getting sandbox schedules", err, telemetry.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting sandbox schedules")
+
+		return
+	}
+
+	result := make([]api.SandboxSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		result = append(result, scheduleToAPI(schedule))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDSchedules(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDSchedulesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.String("schedule.action", string(body.Action)),
+	)
+
+	var nextRunAt time.Time
+	switch {
+	case body.Cron != nil && body.RunAt != nil:
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Only one of cron and runAt can be set")
+		return
+	case body.Cron != nil:
+		nextRunAt, err = scheduler.NextRun(*body.Cron, time.Now())
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid cron: %s", err))
+			return
+		}
+	case body.RunAt != nil:
+		nextRunAt = *body.RunAt
+	default:
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Either cron or runAt must be set")
+		return
+	}
+
+	if body.Command != nil {
+		if body.Action != api.Resume {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Command can be run only after the resume")
+			return
+		}
+
+		if strings.TrimSpace(*body.Command) == "" {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Command cannot be empty")
+			return
+		}
+	}
+
+	if body.User != nil && body.Command == nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "User can be set only with the command")
+		return
+	}
+
+	timeout := defaultScheduleTimeout
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+	}
+
+	if timeout > scheduler.MaxTimeout || timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Timeout of the scheduled run is too long")
+		return
+	}
+
+	exists, err := a.sandboxExists(ctx, teamID, sandboxID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error checking sandbox", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox schedule")
+		return
+	}
+
+	if !exists {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("sandbox \"%s\" doesn't exist or you don't have access to it", sandboxID))
+		return
+	}
+
+	schedule, err := a.sqlcDB.CreateSandboxSchedule(ctx, queries.CreateSandboxScheduleParams{
+		TeamID:    teamID,
+		SandboxID: sandboxID,
+		Action:    string(body.Action),
+		Cron:      body.Cron,
+		Command:   body.Command,
+		ExecUser:  body.User,
+		Timeout:   int32(timeout.Seconds()),
+		NextRunAt: nextRunAt,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating sandbox schedule", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating sandbox schedule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, scheduleToAPI(schedule))
+}
+
+func (a *APIStore) DeleteSandboxesSandboxIDSchedulesScheduleID(c *gin.Context, sandboxID api.SandboxID, scheduleID api.ScheduleID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	id, err := uuid.Parse(scheduleID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Schedule '%s' not found", scheduleID))
+		return
+	}
+
+	deleted, err := a.sqlcDB.DeleteSandboxSchedule(ctx, queries.DeleteSandboxScheduleParams{ID: id, SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error deleting sandbox schedule", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting sandbox schedule")
+		return
+	}
+
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Schedule '%s' not found", scheduleID))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// sandboxExists checks if the sandbox of the team is running or paused.
+func (a *APIStore) sandboxExists(ctx context.Context, teamID uuid.UUID, sandboxID string) (bool, error) {
+	sbx, err := a.orchestrator.GetSandbox(sandboxID, true)
+	if err == nil && sbx.TeamID == teamID {
+		return true, nil
+	}
+
+	_, err = a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error getting last snapshot: %w", err)
+	}
+
+	return true, nil
+}
+
+func scheduleToAPI(schedule queries.SandboxSchedule) api.SandboxSchedule {
+	return api.SandboxSchedule{
+		ScheduleID: schedule.ID.String(),
+		Action:     api.SandboxScheduleAction(schedule.Action),
+		Cron:       schedule.Cron,
+		Command:    schedule.Command,
+		User:       schedule.ExecUser,
+		Timeout:    schedule.Timeout,
+		NextRunAt:  schedule.NextRunAt,
+		LastRunAt:  schedule.LastRunAt,
+		LastError:  schedule.LastError,
+		CreatedAt:  schedule.CreatedAt,
+	}
+}
+
+// sandboxScheduleActions runs the scheduled actions the same way as the API requests do.
+type sandboxScheduleActions struct {
+	api *APIStore
+}
+
+var _ scheduler.Actions = (*sandboxScheduleActions)(nil)
+
+func (s *sandboxScheduleActions) runningSandbox(team authcache.AuthTeamInfo, sandboxID string) (instance.Sandbox, bool) {
+	sbx, err := s.api.orchestrator.GetSandbox(sandboxID, true)
+	if err != nil || sbx.TeamID != team.Team.ID || sbx.State != instance.StateRunning {
+		return instance.Sandbox{}, false
+	}
+
+	return sbx, true
+}
+
+func (s *sandboxScheduleActions) Resume(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string, timeout time.Duration) (bool, error) {
+	if _, ok := s.runningSandbox(team, sandboxID); ok {
+		return false, nil
+	}
+
+	// The resumed sandbox is paused when the command takes longer than the timeout
+	autoPause := true
+	_, apiErr := s.api.resumeSandbox(ctx, team, sandboxID, timeout, &autoPause, nil, &http.Header{})
+	if apiErr != nil {
+		if apiErr.Code == http.StatusConflict {
+			return false, nil
+		}
+
+		return false, apiErr.Err
+	}
+
+	return true, nil
+}
+
+func (s *sandboxScheduleActions) Exec(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string, command string, user string, timeout time.Duration) error {
+	sbx, ok := s.runningSandbox(team, sandboxID)
+	if !ok {
+		return fmt.Errorf("sandbox '%s' is not running", sandboxID)
+	}
+
+	res, err := s.api.orchestrator.ExecSandbox(ctx, sbx, command, user, timeout)
+	if err != nil {
+		return err
+	}
+
+	if res.GetExitCode() != 0 {
+		return fmt.Errorf("command exited with code %d: %s", res.GetExitCode(), res.GetOutput())
+	}
+
+	return nil
+}
+
+func (s *sandboxScheduleActions) Pause(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string) error {
+	sbx, ok := s.runningSandbox(team, sandboxID)
+	if !ok {
+		return nil
+	}
+
+	err := s.api.orchestrator.RemoveSandbox(ctx, sbx, instance.StateActionPause)
+	if err != nil && !errors.Is(err, orchestrator.ErrSandboxNotFound) {
+		return fmt.Errorf("error pausing sandbox: %w", err)
+	}
+
+	return nil
+}
+
+func (s *sandboxScheduleActions) Kill(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string) error {
+	if sbx, ok := s.runningSandbox(team, sandboxID); ok {
+		err := s.api.orchestrator.RemoveSandbox(ctx, sbx, instance.StateActionKill)
+		if err != nil && !errors.Is(err, orchestrator.ErrSandboxNotFound) {
+			return fmt.Errorf("error killing sandbox: %w", err)
+		}
+	}
+
+	err := s.api.deleteSnapshot(ctx, sandboxID, team.Team.ID, team.Team.ClusterID)
+	if err != nil && !errors.Is(err, db.EnvNotFoundError{}) {
+		return fmt.Errorf("error deleting sandbox snapshot: %w", err)
+	}
+
+	return nil
+}
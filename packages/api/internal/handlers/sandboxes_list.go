@@ -229,6 +229,11 @@ func snapshotsToPaginatedSandboxes(snapshots []queries.GetSnapshotsWithCursorRow
 			zap.L().Error("disk size is not set for the sandbox", logger.WithSandboxID(snapshot.SandboxID))
 		}
 
+		memoryMB := int32(build.RamMb)
+		if snapshot.MemoryLimitMb > 0 {
+			memoryMB = int32(snapshot.MemoryLimitMb)
+		}
+
 		envdVersion := ""
 		if build.EnvdVersion != nil {
 			envdVersion = *build.EnvdVersion
@@ -244,7 +249,7 @@ func snapshotsToPaginatedSandboxes(snapshots []queries.GetSnapshotsWithCursorRow
 				SandboxID:   snapshot.SandboxID,
 				StartedAt:   snapshot.SandboxStartedAt.Time,
 				CpuCount:    int32(build.Vcpu),
-				MemoryMB:    int32(build.RamMb),
+				MemoryMB:    memoryMB,
 				DiskSizeMB:  diskSize,
 				EndAt:       snapshot.CreatedAt.Time,
 				State:       api.Paused,
@@ -283,7 +288,7 @@ func instanceInfoToPaginatedSandboxes(runningSandboxes []instance.Sandbox) []uti
 				SandboxID:   info.SandboxID,
 				StartedAt:   info.StartTime,
 				CpuCount:    api.CPUCount(info.VCpu),
-				MemoryMB:    api.MemoryMB(info.RamMB),
+				MemoryMB:    api.MemoryMB(info.MemoryMB()),
 				DiskSizeMB:  api.DiskSizeMB(info.TotalDiskSizeMB),
 				EndAt:       info.EndTime,
 				State:       state,
@@ -72,6 +72,8 @@ func (a *APIStore) getSandboxesMetrics(
 			MemUsed:       int64(m.MemUsed),
 			DiskTotal:     int64(m.DiskTotal),
 			DiskUsed:      int64(m.DiskUsed),
+			NetworkRx:     int64(m.NetworkRx),
+			NetworkTx:     int64(m.NetworkTx),
 		}
 	}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/edge"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/scheduler"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
@@ -180,6 +181,8 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client, config cfg.Config)
 		featureFlags:             featureFlags,
 	}
 
+	go scheduler.New(sqlcDB, &sandboxScheduleActions{api: a}).Start(ctx)
+
 	// Wait till there's at least one, otherwise we can't create sandboxes yet
 	go func() {
 		ticker := time.NewTicker(5 * time.Millisecond)
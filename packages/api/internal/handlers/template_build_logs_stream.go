@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	buildLogsStreamInterval = time.Second
+	// buildLogsStreamKeepAlive is the interval of the keep-alive comments, so the idle stream isn't closed by proxies
+	buildLogsStreamKeepAlive = 15 * time.Second
+)
+
+// GetTemplatesTemplateIDBuildsBuildIDLogsStream streams the template build logs as server-sent events until the build finishes
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDLogsStream(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.GetTemplatesTemplateIDBuildsBuildIDLogsStreamParams) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, templatecache.TemplateBuildInfoNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return
+	}
+
+	// The stream is open for the whole build, which takes longer than the server write timeout
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		zap.L().Warn("failed to disable write deadline for build logs stream", zap.Error(err), logger.WithBuildID(buildID))
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Header("Content-Type", "text/event-stream")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	offset := int32(0)
+	if params.LogsOffset != nil {
+		offset = *params.LogsOffset
+	}
+	level := apiToLogLevel(params.Level)
+
+	ticker := time.NewTicker(buildLogsStreamInterval)
+	defer ticker.Stop()
+
+	lastEvent := time.Now()
+	for {
+		// The status is checked before the logs, so the logs are complete when the build is finished
+		buildInfo, err = a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+		if err != nil {
+			telemetry.ReportError(ctx, "error when getting build info for logs stream", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+			return
+		}
+
+		finished := buildInfo.BuildStatus == envbuild.StatusFailed || buildInfo.BuildStatus == envbuild.StatusUploaded
+
+		// The builder is known only after the build starts
+		if buildInfo.BuildStatus != envbuild.StatusWaiting {
+			cli, err := a.templateManager.GetClusterBuildClient(utils.WithClusterFallback(team.ClusterID), buildInfo.NodeID)
+			if err != nil {
+				telemetry.ReportError(ctx, "error when getting build client for logs stream", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+				return
+			}
+
+			entries := cli.GetLogs(ctx, templateID, buildID, offset, level)
+			for _, entry := range entries {
+				c.SSEvent("log", getAPILogEntry(entry))
+			}
+
+			if len(entries) > 0 {
+				offset += int32(len(entries))
+				lastEvent = time.Now()
+			}
+		}
+
+		if finished {
+			c.SSEvent("status", api.TemplateBuild{
+				TemplateID: templateID,
+				BuildID:    buildID,
+				Status:     getCorrespondingTemplateBuildStatus(buildInfo.BuildStatus),
+				Reason:     getAPIReason(buildInfo.Reason),
+				Logs:       make([]string, 0),
+				LogEntries: make([]api.BuildLogEntry, 0),
+			})
+			c.Writer.Flush()
+
+			return
+		}
+
+		if time.Since(lastEvent) >= buildLogsStreamKeepAlive {
+			_, err = c.Writer.WriteString(": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+
+			lastEvent = time.Now()
+		}
+
+		c.Writer.Flush()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
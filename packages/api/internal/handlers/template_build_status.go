@@ -13,6 +13,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logs"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -106,6 +107,14 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 		result.Reason.LogEntries = sharedUtils.ToPtr(filterStepLogs(logEntries, *result.Reason.Step, api.LogLevelWarn))
 	}
 
+	// The cache results are kept only by the builder, they are not available after the build info expires there
+	status, err := a.templateManager.GetStatus(ctx, buildUUID, templateID, utils.WithClusterFallback(team.ClusterID), buildInfo.NodeID)
+	if err != nil {
+		telemetry.ReportEvent(ctx, "soft error when getting cache results for template build", telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+	} else if len(status.GetCacheResults()) > 0 {
+		result.CacheResults = sharedUtils.ToPtr(getAPICacheResults(status.GetCacheResults()))
+	}
+
 	c.JSON(http.StatusOK, result)
 }
 
@@ -156,6 +165,22 @@ func getAPILogEntry(entry logs.LogEntry) api.BuildLogEntry {
 	}
 }
 
+func getAPICacheResults(results []*templatemanagergrpc.TemplateBuildStepCacheResult) []api.TemplateBuildStepCacheResult {
+	apiResults := make([]api.TemplateBuildStepCacheResult, 0, len(results))
+	for _, r := range results {
+		apiResults = append(apiResults, api.TemplateBuildStepCacheResult{
+			Phase:      r.GetPhase(),
+			StepNumber: r.StepNumber,
+			StepType:   r.GetStepType(),
+			Stage:      r.Stage,
+			Hash:       r.GetHash(),
+			Cached:     r.GetCached(),
+		})
+	}
+
+	return apiResults
+}
+
 func apiToLogLevel(level *api.LogLevel) *logs.LogLevel {
 	if level == nil {
 		return nil
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDBuildsBuildIDExport streams the template build as an OCI image tarball
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDExport(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.GetTemplatesTemplateIDBuildsBuildIDExportParams) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, templatecache.TemplateBuildInfoNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return
+	}
+
+	if buildInfo.BuildStatus != envbuild.StatusUploaded {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Build '%s' is not ready, only finished builds can be exported", buildUUID))
+		return
+	}
+
+	// The export of large templates takes longer than the server write timeout
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		zap.L().Warn("failed to disable write deadline for template export", zap.Error(err), logger.WithBuildID(buildID))
+	}
+
+	stream, err := a.templateManager.ExportBuild(ctx, utils.WithClusterFallback(team.ClusterID), templateID, buildUUID, buildInfo.CPUArchitecture, params.Tag)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when starting template export", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when exporting template build")
+		return
+	}
+
+	// The errors of the export are returned with the first chunk, the response status can still be changed
+	chunk, recvErr := stream.Recv()
+	if recvErr != nil && !errors.Is(recvErr, io.EOF) {
+		telemetry.ReportCriticalError(ctx, "error when exporting template build", recvErr, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when exporting template build")
+		return
+	}
+
+	c.Header("Content-Type", "application/x-tar")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.tar", templateID, buildID)))
+	c.Status(http.StatusOK)
+
+	err = recvErr
+	for err == nil {
+		_, err = c.Writer.Write(chunk.GetData())
+		if err != nil {
+			break
+		}
+		c.Writer.Flush()
+
+		chunk, err = stream.Recv()
+	}
+
+	if !errors.Is(err, io.EOF) {
+		// The headers are already sent, the client gets a truncated tarball
+		zap.L().Error("error when streaming exported template build", zap.Error(err), logger.WithTemplateID(templateID), logger.WithBuildID(buildID))
+		telemetry.ReportError(ctx, "error when streaming exported template build", err)
+	}
+}
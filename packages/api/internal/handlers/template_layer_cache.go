@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDCache lists the cached build layers of the template
+func (a *APIStore) GetTemplatesTemplateIDCache(c *gin.Context, templateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	templateDB, ok := a.getTeamTemplate(c, templateID)
+	if !ok {
+		return
+	}
+
+	layers, err := a.templateManager.ListCachedLayers(ctx, utils.WithClusterFallback(templateDB.ClusterID), templateDB.TeamID, templateID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when listing cached layers", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when listing cached layers")
+		return
+	}
+
+	result := make([]api.TemplateCachedLayer, 0, len(layers))
+	for _, layer := range layers {
+		result = append(result, api.TemplateCachedLayer{
+			Hash:      layer.GetHash(),
+			BuildID:   layer.GetBuildID(),
+			Step:      layer.GetStep(),
+			CreatedAt: layer.GetCreatedAt().AsTime(),
+			SizeBytes: layer.GetSizeBytes(),
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteTemplatesTemplateIDCache invalidates the cached build layers of the template
+func (a *APIStore) DeleteTemplatesTemplateIDCache(c *gin.Context, templateID api.TemplateID, params api.DeleteTemplatesTemplateIDCacheParams) {
+	ctx := c.Request.Context()
+
+	templateDB, ok := a.getTeamTemplate(c, templateID)
+	if !ok {
+		return
+	}
+
+	var hashes []string
+	if params.Hash != nil {
+		hashes = *params.Hash
+	}
+
+	invalidated, err := a.templateManager.InvalidateCachedLayers(ctx, utils.WithClusterFallback(templateDB.ClusterID), templateDB.TeamID, templateID, hashes)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when invalidating cached layers", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when invalidating cached layers")
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "invalidated cached layers", telemetry.WithTemplateID(templateID))
+
+	c.JSON(http.StatusOK, api.TemplateCacheInvalidation{
+		Hashes: invalidated,
+	})
+}
+
+// getTeamTemplate returns the template if the team has access to it, otherwise the error response is sent.
+func (a *APIStore) getTeamTemplate(c *gin.Context, templateID api.TemplateID) (queries.Env, bool) {
+	ctx := c.Request.Context()
+
+	templateDB, err := a.sqlcDB.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Template '%s' not found", templateID))
+		telemetry.ReportError(ctx, "error when getting template", err, telemetry.WithTemplateID(templateID))
+		return queries.Env{}, false
+	}
+
+	dbTeamID := templateDB.TeamID.String()
+	team, _, apiErr := a.GetTeamAndTier(c, &dbTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return queries.Env{}, false
+	}
+
+	if team.ID != templateDB.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to the template", fmt.Errorf("user doesn't have access to template '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Template '%s' not found", templateID))
+		return queries.Env{}, false
+	}
+
+	return templateDB, true
+}
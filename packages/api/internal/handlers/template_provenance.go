@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDBuildsBuildIDProvenance returns the provenance of the finished template build
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDProvenance(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	team, _, buildUUID, ok := a.getTeamUploadedBuild(c, templateID, buildID)
+	if !ok {
+		return
+	}
+
+	data, apiErr := a.templateManager.GetBuildProvenance(ctx, utils.WithClusterFallback(team.ClusterID), templateID, buildUUID)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when getting template build provenance", apiErr.Err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var provenance api.TemplateBuildProvenance
+	err := json.Unmarshal(data, &provenance)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when parsing template build provenance", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build provenance")
+		return
+	}
+
+	c.JSON(http.StatusOK, provenance)
+}
+
+// getTeamUploadedBuild returns the team and its tier of the finished build if the team has access to it, otherwise the error response is sent.
+func (a *APIStore) getTeamUploadedBuild(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) (*queries.Team, *queries.Tier, uuid.UUID, bool) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return nil, nil, uuid.Nil, false
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if err != nil {
+		if errors.Is(err, templatecache.TemplateBuildInfoNotFoundError{}) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return nil, nil, uuid.Nil, false
+		}
+
+		telemetry.ReportError(ctx, "error when getting template", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template")
+		return nil, nil, uuid.Nil, false
+	}
+
+	infoTeamID := buildInfo.TeamID.String()
+	team, tier, apiErr := a.GetTeamAndTier(c, &infoTeamID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
+		return nil, nil, uuid.Nil, false
+	}
+
+	if team.ID != buildInfo.TeamID {
+		telemetry.ReportError(ctx, "user doesn't have access to env", fmt.Errorf("user doesn't have access to env '%s'", templateID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("You don't have access to this sandbox template (%s)", templateID))
+		return nil, nil, uuid.Nil, false
+	}
+
+	if buildInfo.BuildStatus != envbuild.StatusUploaded {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Build '%s' is not ready, only finished builds have provenance", buildUUID))
+		return nil, nil, uuid.Nil, false
+	}
+
+	return team, tier, buildUUID, true
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -8,11 +9,13 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/edge"
 	"github.com/e2b-dev/infra/packages/api/internal/template"
 	apiutils "github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/dberrors"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 // PostV2Templates triggers a new template build
@@ -62,8 +65,19 @@ func (a *APIStore) PostV2Templates(c *gin.Context) {
 	}
 	span.End()
 
-	builderNodeID, err := a.templateManager.GetAvailableBuildClient(ctx, apiutils.WithClusterFallback(team.ClusterID))
+	cpuArchitecture := string(utils.FromPtr(body.CpuArchitecture))
+	if cpuArchitecture == "" {
+		cpuArchitecture = string(api.Amd64)
+	}
+
+	builderNodeID, err := a.templateManager.GetAvailableBuildClient(ctx, apiutils.WithClusterFallback(team.ClusterID), cpuArchitecture)
 	if err != nil {
+		if errors.Is(err, edge.ErrAvailableTemplateBuilderNotFound) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("No template builder with the %s architecture is available", cpuArchitecture))
+			telemetry.ReportError(ctx, "no template builder with the architecture available", err, telemetry.WithTemplateID(templateID))
+			return
+		}
+
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting available build client")
 		telemetry.ReportCriticalError(ctx, "error when getting available build client", err, telemetry.WithTemplateID(templateID))
 		return
@@ -79,6 +93,8 @@ func (a *APIStore) PostV2Templates(c *gin.Context) {
 		Alias:         &body.Alias,
 		CpuCount:      body.CpuCount,
 		MemoryMB:      body.MemoryMB,
+
+		CPUArchitecture: cpuArchitecture,
 	}
 
 	template, apiError := template.RegisterBuild(ctx, a.templateBuildsCache, a.db, buildReq)
@@ -158,6 +158,11 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		nil, // fromImageRegistry not supported in v1 handler
 		&forceRebuild,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		build.CpuArchitecture,
 		apiutils.WithClusterFallback(team.ClusterID),
 		build.ClusterNodeID,
 	)
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,16 +15,21 @@ import (
 	"github.com/posthog/posthog-go"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/team"
+	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/template/dockerfile"
 	apiutils "github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 type dockerfileStore struct {
-	FromImage    *string             `json:"from_image"`
-	FromTemplate *string             `json:"from_template"`
-	Steps        *[]api.TemplateStep `json:"steps"`
+	FromImage    *string                   `json:"from_image"`
+	FromTemplate *string                   `json:"from_template"`
+	Steps        *[]api.TemplateStep       `json:"steps"`
+	Stages       *[]api.TemplateBuildStage `json:"stages,omitempty"`
 }
 
 // PostV2TemplatesTemplateIDBuildsBuildID triggers a new build
@@ -35,6 +44,50 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		return
 	}
 
+	if body.Dockerfile != nil {
+		if body.FromImage != nil || body.FromTemplate != nil || (body.Steps != nil && len(*body.Steps) > 0) || (body.Stages != nil && len(*body.Stages) > 0) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Dockerfile can't be combined with fromImage, fromTemplate, steps or stages")
+
+			return
+		}
+
+		template, err := dockerfile.Parse(*body.Dockerfile, utils.FromPtr(body.BuildArgs), body.ContextHash)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid Dockerfile: %s", err))
+			telemetry.ReportError(ctx, "invalid dockerfile", err)
+
+			return
+		}
+
+		body.FromImage = &template.FromImage
+		body.Steps = &template.Steps
+		body.Stages = &template.Stages
+		if body.StartCmd == nil {
+			body.StartCmd = template.StartCmd
+		}
+	}
+
+	err = validateStages(utils.FromPtr(body.Stages), utils.FromPtr(body.Steps))
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid stages: %s", err))
+
+		return
+	}
+
+	err = validateMounts(utils.FromPtr(body.Stages), utils.FromPtr(body.Steps))
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid mounts: %s", err))
+
+		return
+	}
+
+	err = validateWebhook(body.Webhook)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid webhook: %s", err))
+
+		return
+	}
+
 	buildUUID, err := uuid.Parse(buildID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", buildID))
@@ -58,7 +111,7 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 	}
 
 	dbTeamID := templateBuildDB.Env.TeamID.String()
-	team, _, apiErr := a.GetTeamAndTier(c, &dbTeamID)
+	team, tier, apiErr := a.GetTeamAndTier(c, &dbTeamID)
 	if apiErr != nil {
 		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
 		telemetry.ReportCriticalError(ctx, "error when getting team and tier", apiErr.Err)
@@ -78,6 +131,13 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		telemetry.WithTemplateID(templateID),
 	)
 
+	apiErr = limitBuildResources(tier, body)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
 	// Check and cancel concurrent builds
 	if err := a.CheckAndCancelConcurrentBuilds(ctx, templateID, buildUUID, apiutils.WithClusterFallback(team.ClusterID)); err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error during template build request")
@@ -98,6 +158,7 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		FromImage:    body.FromImage,
 		FromTemplate: body.FromTemplate,
 		Steps:        body.Steps,
+		Stages:       body.Stages,
 	})
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when processing steps: %s", err))
@@ -117,6 +178,19 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		return
 	}
 
+	if body.Webhook != nil {
+		err = a.sqlcDB.UpsertEnvBuildWebhook(ctx, queries.UpsertEnvBuildWebhookParams{
+			BuildID: buildUUID,
+			Url:     body.Webhook.Url,
+			Secret:  body.Webhook.Secret,
+		})
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error when saving build webhook", err)
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when saving build webhook: %s", err))
+			return
+		}
+	}
+
 	// Call the Template Manager to build the environment
 	buildErr := a.templateManager.CreateTemplate(
 		ctx,
@@ -134,7 +208,12 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 		body.FromTemplate,
 		body.FromImageRegistry,
 		body.Force,
+		body.BuildCpuCount,
+		body.BuildMemoryMB,
 		body.Steps,
+		body.Stages,
+		utils.FromPtr(body.Secrets),
+		build.CpuArchitecture,
 		apiutils.WithClusterFallback(team.ClusterID),
 		build.ClusterNodeID,
 	)
@@ -153,3 +232,157 @@ func (a *APIStore) PostV2TemplatesTemplateIDBuildsBuildID(c *gin.Context, templa
 
 	c.Status(http.StatusAccepted)
 }
+
+// validateStages checks the stage names are unique and the steps copy files only from the stages built before them.
+func validateStages(stages []api.TemplateBuildStage, steps []api.TemplateStep) error {
+	built := make(map[string]bool, len(stages))
+
+	validateSteps := func(steps []api.TemplateStep) error {
+		for _, step := range steps {
+			if step.FromStage == nil {
+				continue
+			}
+
+			if !strings.EqualFold(step.Type, "COPY") {
+				return fmt.Errorf("fromStage can be used only with the COPY step, got %s", step.Type)
+			}
+
+			if !built[*step.FromStage] {
+				return fmt.Errorf("stage %s must be defined before it's used", *step.FromStage)
+			}
+		}
+
+		return nil
+	}
+
+	for _, stage := range stages {
+		if stage.Name == "" {
+			return fmt.Errorf("stage name can't be empty")
+		}
+
+		if built[stage.Name] {
+			return fmt.Errorf("duplicate stage name %s", stage.Name)
+		}
+
+		err := validateSteps(utils.FromPtr(stage.Steps))
+		if err != nil {
+			return fmt.Errorf("stage %s: %w", stage.Name, err)
+		}
+
+		built[stage.Name] = true
+	}
+
+	return validateSteps(steps)
+}
+
+// validateMounts checks the mounts are used only by the RUN steps and have the options their type requires.
+func validateMounts(stages []api.TemplateBuildStage, steps []api.TemplateStep) error {
+	for _, stage := range stages {
+		steps = append(steps, utils.FromPtr(stage.Steps)...)
+	}
+
+	for _, step := range steps {
+		for _, mount := range utils.FromPtr(step.Mounts) {
+			if !strings.EqualFold(step.Type, "RUN") {
+				return fmt.Errorf("mounts can be used only with the RUN step, got %s", step.Type)
+			}
+
+			switch mount.Type {
+			case api.Secret:
+				if utils.FromPtr(mount.Id) == "" {
+					return fmt.Errorf("secret mount requires an id")
+				}
+			case api.Cache:
+				if utils.FromPtr(mount.Target) == "" {
+					return fmt.Errorf("cache mount requires a target")
+				}
+
+				if mount.Env != nil || mount.Required != nil {
+					return fmt.Errorf("cache mount %s doesn't support the env and required options", *mount.Target)
+				}
+			default:
+				return fmt.Errorf("unknown mount type %s", mount.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+// limitBuildResources checks the build and the step resources are within the team limits and the step timeouts are used only by the RUN steps.
+func limitBuildResources(tier *queries.Tier, body api.TemplateBuildStartV2) *api.APIError {
+	if body.BuildCpuCount != nil || body.BuildMemoryMB != nil {
+		_, _, apiErr := team.LimitResources(tier, body.BuildCpuCount, body.BuildMemoryMB)
+		if apiErr != nil {
+			return apiErr
+		}
+	}
+
+	steps := utils.FromPtr(body.Steps)
+	for _, stage := range utils.FromPtr(body.Stages) {
+		steps = append(steps, utils.FromPtr(stage.Steps)...)
+	}
+
+	maxTimeout := time.Duration(tier.MaxLengthHours) * time.Hour
+	for _, step := range steps {
+		if step.CpuCount != nil || step.MemoryMB != nil {
+			_, _, apiErr := team.LimitResources(tier, step.CpuCount, step.MemoryMB)
+			if apiErr != nil {
+				return apiErr
+			}
+		}
+
+		if step.Timeout == nil {
+			continue
+		}
+
+		if !strings.EqualFold(step.Type, "RUN") {
+			return &api.APIError{
+				Err:       fmt.Errorf("timeout can be used only with the RUN step, got %s", step.Type),
+				ClientMsg: fmt.Sprintf("Step timeout can be used only with the RUN step, got %s", step.Type),
+				Code:      http.StatusBadRequest,
+			}
+		}
+
+		if *step.Timeout <= 0 || time.Duration(*step.Timeout)*time.Second > maxTimeout {
+			return &api.APIError{
+				Err:       fmt.Errorf("step timeout %d is out of range", *step.Timeout),
+				ClientMsg: fmt.Sprintf("Step timeout must be between 1 second and %d hours", tier.MaxLengthHours),
+				Code:      http.StatusBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateWebhook checks that the webhook URL can be called by the API
+func validateWebhook(webhook *api.TemplateBuildWebhook) error {
+	if webhook == nil {
+		return nil
+	}
+
+	u, err := url.Parse(webhook.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be http or https, got '%s'", u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return errors.New("url host is required")
+	}
+
+	if strings.EqualFold(u.Hostname(), "localhost") {
+		return errors.New("url host must be public")
+	}
+
+	// The resolved addresses are checked when the webhook is sent
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !template_manager.IsWebhookAddrAllowed(addr) {
+		return errors.New("url host must be public")
+	}
+
+	return nil
+}
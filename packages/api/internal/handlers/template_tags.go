@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/dberrors"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDTags lists the tags of the template builds
+func (a *APIStore) GetTemplatesTemplateIDTags(c *gin.Context, templateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	templateDB, ok := a.getTeamTemplate(c, templateID)
+	if !ok {
+		return
+	}
+
+	tags, err := a.sqlcDB.GetEnvBuildTags(ctx, templateDB.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting template tags", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template tags")
+		return
+	}
+
+	result := make([]api.TemplateTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, getAPITemplateTag(tag))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// PutTemplatesTemplateIDTagsTag assigns the tag to a build, an existing tag is moved without rebuilding the template
+func (a *APIStore) PutTemplatesTemplateIDTagsTag(c *gin.Context, templateID api.TemplateID, tag api.Tag) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PutTemplatesTemplateIDTagsTagJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+		return
+	}
+
+	cleanedTag, err := id.CleanTag(tag)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid tag: %s", err))
+		return
+	}
+
+	if cleanedTag == id.DefaultTag {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Tag '%s' is reserved for the latest build of the template", id.DefaultTag))
+		return
+	}
+
+	buildUUID, err := uuid.Parse(body.BuildID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+		return
+	}
+
+	templateDB, ok := a.getTeamTemplate(c, templateID)
+	if !ok {
+		return
+	}
+
+	build, err := a.sqlcDB.GetTemplateBuildWithTemplate(ctx, queries.GetTemplateBuildWithTemplateParams{
+		TemplateID: templateDB.ID,
+		BuildID:    buildUUID,
+	})
+	if err != nil {
+		if dberrors.IsNotFoundError(err) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error when getting template build", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build")
+		return
+	}
+
+	if build.EnvBuild.Status != string(envbuild.StatusUploaded) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Build '%s' is not ready, only finished builds can be tagged", buildUUID))
+		return
+	}
+
+	buildTag, err := a.sqlcDB.UpsertEnvBuildTag(ctx, queries.UpsertEnvBuildTagParams{
+		EnvID:   templateDB.ID,
+		Tag:     cleanedTag,
+		BuildID: buildUUID,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when assigning template tag", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when assigning template tag")
+		return
+	}
+
+	// The sandboxes created from the tag have to use the new build
+	a.templateCache.Invalidate(templateDB.ID)
+
+	telemetry.ReportEvent(ctx, "assigned template tag", telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildUUID.String()), attribute.String("tag", cleanedTag))
+
+	c.JSON(http.StatusOK, getAPITemplateTag(buildTag))
+}
+
+// DeleteTemplatesTemplateIDTagsTag removes the tag, the build is no longer retained because of it
+func (a *APIStore) DeleteTemplatesTemplateIDTagsTag(c *gin.Context, templateID api.TemplateID, tag api.Tag) {
+	ctx := c.Request.Context()
+
+	templateDB, ok := a.getTeamTemplate(c, templateID)
+	if !ok {
+		return
+	}
+
+	cleanedTag, err := id.CleanTag(tag)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid tag: %s", err))
+		return
+	}
+
+	rows, err := a.sqlcDB.DeleteEnvBuildTag(ctx, queries.DeleteEnvBuildTagParams{
+		EnvID: templateDB.ID,
+		Tag:   cleanedTag,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when deleting template tag", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting template tag")
+		return
+	}
+
+	if rows == 0 {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Tag '%s' not found", cleanedTag))
+		return
+	}
+
+	a.templateCache.Invalidate(templateDB.ID)
+
+	c.Status(http.StatusNoContent)
+}
+
+func getAPITemplateTag(tag queries.EnvBuildTag) api.TemplateTag {
+	return api.TemplateTag{
+		Tag:       tag.Tag,
+		BuildID:   tag.BuildID.String(),
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatemanager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// PostTemplatesTemplateIDBuildsBuildIDVerify rebuilds the finished template build and compares the rootfs with the original one
+func (a *APIStore) PostTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	body := api.TemplateBuildVerify{}
+	if c.Request.ContentLength != 0 {
+		var err error
+		body, err = utils.ParseBody[api.PostTemplatesTemplateIDBuildsBuildIDVerifyJSONRequestBody](ctx, c)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+			telemetry.ReportError(ctx, "invalid request body", err)
+			return
+		}
+	}
+
+	team, tier, buildUUID, ok := a.getTeamUploadedBuild(c, templateID, buildID)
+	if !ok {
+		return
+	}
+
+	// The rebuild is a full build, it's limited like the other builds of the team
+	if len(a.templateBuildsCache.GetRunningBuildsForTeam(team.ID)) >= int(tier.ConcurrentTemplateBuilds) {
+		telemetry.ReportError(ctx, "team has reached max concurrent template builds", nil, telemetry.WithTeamID(team.ID.String()))
+		a.sendAPIStoreError(c, http.StatusTooManyRequests, fmt.Sprintf(
+			"you have reached the maximum number of concurrent template builds (%d). Please wait for existing builds to complete or contact support if you need more concurrent builds.",
+			tier.ConcurrentTemplateBuilds))
+		return
+	}
+
+	build, err := a.sqlcDB.GetTemplateBuildWithTemplate(ctx, queries.GetTemplateBuildWithTemplateParams{
+		TemplateID: templateID,
+		BuildID:    buildUUID,
+	})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting template build", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build")
+		return
+	}
+
+	// Only the v2 builds store the definition the build can be repeated from
+	var definition dockerfileStore
+	if build.EnvBuild.Dockerfile == nil || json.Unmarshal([]byte(*build.EnvBuild.Dockerfile), &definition) != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Build '%s' can't be verified, only the builds started with the v2 API can be verified", buildUUID))
+		return
+	}
+
+	apiErr := a.templateManager.VerifyBuild(
+		ctx,
+		team.ID,
+		utils.WithClusterFallback(team.ClusterID),
+		templateID,
+		build.EnvBuild,
+		templatemanager.BuildDefinition{
+			FromImage:    definition.FromImage,
+			FromTemplate: definition.FromTemplate,
+			Steps:        definition.Steps,
+			Stages:       definition.Stages,
+		},
+		body.FromImageRegistry,
+		sharedUtils.FromPtr(body.Secrets),
+	)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when verifying template build", apiErr.Err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "started template build verification", telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+
+	c.Status(http.StatusAccepted)
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDVerify returns the result of the last verification of the template build
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDVerify(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	team, _, buildUUID, ok := a.getTeamUploadedBuild(c, templateID, buildID)
+	if !ok {
+		return
+	}
+
+	data, apiErr := a.templateManager.GetBuildVerification(ctx, utils.WithClusterFallback(team.ClusterID), templateID, buildUUID)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when getting template build verification", apiErr.Err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	var verification api.TemplateBuildVerification
+	err := json.Unmarshal(data, &verification)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when parsing template build verification", err, telemetry.WithTemplateID(templateID), telemetry.WithBuildID(buildID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build verification")
+		return
+	}
+
+	c.JSON(http.StatusOK, verification)
+}
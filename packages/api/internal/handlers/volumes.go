@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) GetVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	volumes, err := a.sqlcDB.GetTeamVolumes(ctx, teamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error getting team volumes", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting volumes")
+
+		return
+	}
+
+	result := make([]api.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		result = append(result, sandbox.VolumeToAPI(volume))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostVolumes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.PostVolumesJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx, attribute.String("volume.name", body.Name))
+
+	volume, err := a.sqlcDB.CreateVolume(ctx, queries.CreateVolumeParams{
+		TeamID: teamID,
+		Name:   body.Name,
+		SizeMb: body.SizeMB,
+	})
+	if err != nil {
+		// The insert is skipped when the volume with the same name already exists
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' already exists", body.Name))
+			return
+		}
+
+		telemetry.ReportCriticalError(ctx, "error creating volume", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating volume")
+		return
+	}
+
+	c.JSON(http.StatusCreated, sandbox.VolumeToAPI(volume))
+}
+
+func (a *APIStore) GetVolumesVolumeID(c *gin.Context, volumeID api.VolumeID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	volume, apiErr := a.getVolume(ctx, teamID, volumeID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	c.JSON(http.StatusOK, sandbox.VolumeToAPI(volume))
+}
+
+func (a *APIStore) DeleteVolumesVolumeID(c *gin.Context, volumeID api.VolumeID) {
+	ctx := c.Request.Context()
+
+	team := a.GetTeamInfo(c).Team
+
+	volume, apiErr := a.getVolume(ctx, team.ID, volumeID)
+	if apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+		return
+	}
+
+	deleted, err := a.sqlcDB.DeleteVolume(ctx, queries.DeleteVolumeParams{ID: volume.ID, TeamID: team.ID})
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error deleting volume", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting volume")
+		return
+	}
+
+	// The volume is deleted only when it isn't mounted
+	if deleted == 0 {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Volume '%s' is mounted to a sandbox", volumeID))
+		return
+	}
+
+	err = a.orchestrator.DeleteVolume(ctx, utils.WithClusterFallback(team.ClusterID), volume.ID.String())
+	if err != nil {
+		// The volume is no longer reachable, the data left in the storage is only logged
+		zap.L().Error("Error deleting volume data", zap.String("volume_id", volume.ID.String()), zap.Error(err))
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) getVolume(ctx context.Context, teamID uuid.UUID, volumeID string) (queries.Volume, *api.APIError) {
+	notFound := &api.APIError{
+		Code:      http.StatusNotFound,
+		ClientMsg: fmt.Sprintf("volume \"%s\" doesn't exist or you don't have access to it", volumeID),
+	}
+
+	id, err := uuid.Parse(volumeID)
+	if err != nil {
+		notFound.Err = err
+		return queries.Volume{}, notFound
+	}
+
+	volume, err := a.sqlcDB.GetVolume(ctx, queries.GetVolumeParams{ID: id, TeamID: teamID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			notFound.Err = err
+			return queries.Volume{}, notFound
+		}
+
+		return queries.Volume{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error getting volume",
+			Err:       fmt.Errorf("error getting volume: %w", err),
+		}
+	}
+
+	return volume, nil
+}
+
+// attachVolumes marks the volumes as mounted to the sandbox, a volume held by a sandbox that no longer exists is taken over.
+// The attached volumes are returned in the order of the mounts, on error the volumes attached so far are released.
+func (a *APIStore) attachVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string, mounts []api.SandboxVolumeMount) ([]queries.Volume, *api.APIError) {
+	volumes := make([]queries.Volume, 0, len(mounts))
+
+	for _, mount := range mounts {
+		volume, apiErr := a.attachVolume(ctx, teamID, sandboxID, mount)
+		if apiErr != nil {
+			a.detachVolumes(ctx, teamID, sandboxID)
+
+			return nil, apiErr
+		}
+
+		volumes = append(volumes, volume)
+	}
+
+	return volumes, nil
+}
+
+func (a *APIStore) attachVolume(ctx context.Context, teamID uuid.UUID, sandboxID string, mount api.SandboxVolumeMount) (queries.Volume, *api.APIError) {
+	current, apiErr := a.getVolume(ctx, teamID, mount.VolumeID)
+	if apiErr != nil {
+		return queries.Volume{}, apiErr
+	}
+
+	inUse := &api.APIError{
+		Code:      http.StatusConflict,
+		ClientMsg: fmt.Sprintf("Volume '%s' is mounted to another sandbox", mount.VolumeID),
+		Err:       fmt.Errorf("volume '%s' is in use", mount.VolumeID),
+	}
+
+	if current.SandboxID != nil && a.isVolumeHolderAlive(ctx, teamID, *current.SandboxID) {
+		return queries.Volume{}, inUse
+	}
+
+	mountPath := path.Clean(mount.Path)
+	volume, err := a.sqlcDB.AttachVolume(ctx, queries.AttachVolumeParams{
+		SandboxID: &sandboxID,
+		MountPath: &mountPath,
+		ID:        current.ID,
+		TeamID:    teamID,
+		HeldBy:    current.SandboxID,
+	})
+	if err != nil {
+		// The volume was attached to another sandbox in the meantime
+		if errors.Is(err, sql.ErrNoRows) {
+			return queries.Volume{}, inUse
+		}
+
+		return queries.Volume{}, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error mounting volume",
+			Err:       fmt.Errorf("error attaching volume: %w", err),
+		}
+	}
+
+	return volume, nil
+}
+
+// isVolumeHolderAlive checks if the sandbox holding the volume is running or paused.
+func (a *APIStore) isVolumeHolderAlive(ctx context.Context, teamID uuid.UUID, sandboxID string) bool {
+	if _, err := a.orchestrator.GetSandbox(sandboxID, true); err == nil {
+		return true
+	}
+
+	_, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamID})
+
+	return !errors.Is(err, sql.ErrNoRows)
+}
+
+// hasVolumes checks if any volume is mounted to the sandbox, the snapshots of such sandboxes can't be started as other sandboxes.
+func (a *APIStore) hasVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) (bool, error) {
+	volumes, err := a.sqlcDB.GetSandboxVolumes(ctx, queries.GetSandboxVolumesParams{SandboxID: &sandboxID, TeamID: teamID})
+	if err != nil {
+		return false, fmt.Errorf("error getting sandbox volumes: %w", err)
+	}
+
+	return len(volumes) > 0, nil
+}
+
+func (a *APIStore) detachVolumes(ctx context.Context, teamID uuid.UUID, sandboxID string) {
+	err := a.sqlcDB.DetachSandboxVolumes(ctx, queries.DetachSandboxVolumesParams{SandboxID: &sandboxID, TeamID: teamID})
+	if err != nil {
+		zap.L().Error("Error detaching sandbox volumes", logger.WithSandboxID(sandboxID), zap.Error(err))
+	}
+}
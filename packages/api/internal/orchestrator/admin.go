@@ -34,6 +34,7 @@ func (o *Orchestrator) AdminNodes() []*api.Node {
 			SandboxStartingCount: int(n.PlacementMetrics.InProgressCount()),
 			Version:              meta.Version,
 			Commit:               meta.Commit,
+			CpuArchitecture:      &meta.CPUArchitecture,
 			Metrics:              metrics,
 		}
 	}
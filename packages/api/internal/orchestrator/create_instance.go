@@ -20,6 +20,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -43,8 +44,12 @@ func (o *Orchestrator) CreateSandbox(
 	nodeID *string,
 	baseTemplateID string,
 	autoPause bool,
+	idleTimeout time.Duration,
+	memoryLimitMB int64,
 	envdAuthToken *string,
 	allowInternetAccess *bool,
+	network *types.SandboxNetworkConfig,
+	volumes []queries.Volume,
 ) (*api.Sandbox, *api.APIError) {
 	ctx, childSpan := tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
@@ -115,27 +120,33 @@ func (o *Orchestrator) CreateSandbox(
 
 	sbxRequest := &orchestrator.SandboxCreateRequest{
 		Sandbox: &orchestrator.SandboxConfig{
-			BaseTemplateId:      baseTemplateID,
-			TemplateId:          build.EnvID,
-			Alias:               &alias,
-			TeamId:              team.Team.ID.String(),
-			BuildId:             build.ID.String(),
-			SandboxId:           sandboxID,
-			ExecutionId:         executionID,
-			KernelVersion:       build.KernelVersion,
-			FirecrackerVersion:  build.FirecrackerVersion,
-			EnvdVersion:         *build.EnvdVersion,
-			Metadata:            metadata,
-			EnvVars:             envVars,
-			EnvdAccessToken:     envdAuthToken,
-			MaxSandboxLength:    team.Tier.MaxLengthHours,
-			HugePages:           features.HasHugePages(),
-			RamMb:               build.RamMb,
-			Vcpu:                build.Vcpu,
-			Snapshot:            isResume,
-			AutoPause:           autoPause,
-			AllowInternetAccess: allowInternetAccess,
-			TotalDiskSizeMb:     ut.FromPtr(build.TotalDiskSizeMb),
+			BaseTemplateId:       baseTemplateID,
+			TemplateId:           build.EnvID,
+			Alias:                &alias,
+			TeamId:               team.Team.ID.String(),
+			BuildId:              build.ID.String(),
+			SandboxId:            sandboxID,
+			ExecutionId:          executionID,
+			KernelVersion:        build.KernelVersion,
+			FirecrackerVersion:   build.FirecrackerVersion,
+			CpuArchitecture:      build.CpuArchitecture,
+			EnvdVersion:          *build.EnvdVersion,
+			Metadata:             metadata,
+			EnvVars:              envVars,
+			EnvdAccessToken:      envdAuthToken,
+			MaxSandboxLength:     team.Tier.MaxLengthHours,
+			HugePages:            features.HasHugePages(),
+			RamMb:                build.RamMb,
+			Vcpu:                 build.Vcpu,
+			Snapshot:             isResume,
+			AutoPause:            autoPause,
+			IdleTimeout:          int64(idleTimeout.Seconds()),
+			MemoryLimitMb:        memoryLimitMB,
+			AllowInternetAccess:  allowInternetAccess,
+			Network:              sandbox.NetworkConfigToGRPC(network),
+			NetworkBandwidthMbps: team.Tier.NetworkBandwidthMbps,
+			TotalDiskSizeMb:      ut.FromPtr(build.TotalDiskSizeMb),
+			Volumes:              sandbox.VolumesToGRPC(volumes),
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
@@ -190,6 +201,8 @@ func (o *Orchestrator) CreateSandbox(
 		EnvdVersion:     *build.EnvdVersion,
 		EnvdAccessToken: envdAuthToken,
 		Domain:          sbxDomain,
+
+		TrafficAccessToken: sandbox.TrafficAccessToken(network),
 	}
 
 	// This is to compensate for the time it takes to start the instance
@@ -220,7 +233,11 @@ func (o *Orchestrator) CreateSandbox(
 		autoPause,
 		envdAuthToken,
 		allowInternetAccess,
+		network,
 		baseTemplateID,
+		idleTimeout,
+		memoryLimitMB,
+		startTime,
 	)
 
 	o.sandboxStore.Add(ctx, instanceInfo, true)
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
@@ -101,6 +102,17 @@ func (o *Orchestrator) removeSandboxFromNode(ctx context.Context, sandbox instan
 		if err != nil {
 			return fmt.Errorf("failed to delete sandbox '%s': %w", sandbox.SandboxID, err)
 		}
+
+		// The volumes are persisted by the time the delete returns, so they can be mounted to other sandboxes
+		err = o.sqlcDB.DetachSandboxVolumes(ctx, queries.DetachSandboxVolumesParams{SandboxID: &sandbox.SandboxID, TeamID: sandbox.TeamID})
+		if err != nil {
+			return fmt.Errorf("failed to detach volumes of sandbox '%s': %w", sandbox.SandboxID, err)
+		}
+
+		err = o.sqlcDB.DeleteSandboxSchedules(ctx, queries.DeleteSandboxSchedulesParams{SandboxID: sandbox.SandboxID, TeamID: sandbox.TeamID})
+		if err != nil {
+			return fmt.Errorf("failed to delete schedules of sandbox '%s': %w", sandbox.SandboxID, err)
+		}
 	}
 
 	return nil
@@ -34,7 +34,8 @@ func (e *Evictor) Start(ctx context.Context) {
 			for _, item := range e.store.ItemsToEvict() {
 				go func() {
 					stateAction := instance.StateActionKill
-					if item.AutoPause {
+					// Idle sandboxes are always paused, they can still be used later.
+					if item.AutoPause || (!item.IsExpired() && item.IsIdle()) {
 						stateAction = instance.StateActionPause
 					}
 
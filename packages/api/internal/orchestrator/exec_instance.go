@@ -0,0 +1,37 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ExecSandbox runs the command in the running sandbox and waits until the command exits, the default sandbox user is used when the user is empty.
+func (o *Orchestrator) ExecSandbox(ctx context.Context, sbx instance.Sandbox, command string, user string, timeout time.Duration) (*orchestrator.SandboxExecResponse, error) {
+	ctx, span := tracer.Start(ctx, "exec-sandbox")
+	defer span.End()
+
+	span.SetAttributes(telemetry.WithSandboxID(sbx.SandboxID))
+
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return nil, fmt.Errorf("node '%s' not found", sbx.NodeID)
+	}
+
+	client, ctx := node.GetClient(ctx)
+	res, err := client.Sandbox.Exec(ctx, &orchestrator.SandboxExecRequest{
+		SandboxId:      sbx.SandboxID,
+		Command:        command,
+		User:           user,
+		TimeoutSeconds: int64(timeout.Seconds()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run command in sandbox '%s': %w", sbx.SandboxID, err)
+	}
+
+	return res, nil
+}
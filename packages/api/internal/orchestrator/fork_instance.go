@@ -0,0 +1,360 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkSandbox snapshots the running sandbox and starts the forks from the snapshot on the same node.
+// The original sandbox is resumed from the same snapshot with a new execution ID and keeps its end time.
+// The forks that failed to start are returned with their IDs, the error is returned only when none of them started.
+func (o *Orchestrator) ForkSandbox(
+	ctx context.Context,
+	sbx instance.Sandbox,
+	team authcache.AuthTeamInfo,
+	forkIDs []string,
+	metadata map[string]string,
+	timeout time.Duration,
+) (*api.SandboxForks, *api.APIError) {
+	ctx, span := tracer.Start(ctx, "fork-sandbox")
+	defer span.End()
+
+	_, sandboxes, apiErr := o.forkSandbox(ctx, sbx, team, forkIDs, metadata, timeout)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	started := make(map[string]bool, len(sandboxes))
+	for _, fork := range sandboxes {
+		started[fork.SandboxID] = true
+	}
+
+	failed := make([]string, 0)
+	for _, forkID := range forkIDs {
+		if !started[forkID] {
+			failed = append(failed, forkID)
+		}
+	}
+
+	if len(sandboxes) == 0 && len(failed) > 0 {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox, none of the forks started",
+			Err:       fmt.Errorf("none of the %d forks of sandbox '%s' started", len(failed), sbx.SandboxID),
+		}
+	}
+
+	return &api.SandboxForks{
+		Sandboxes:        sandboxes,
+		FailedSandboxIDs: failed,
+	}, nil
+}
+
+// CheckpointSandbox snapshots the running sandbox without stopping it and returns the ID of the snapshot build.
+func (o *Orchestrator) CheckpointSandbox(ctx context.Context, sbx instance.Sandbox, team authcache.AuthTeamInfo) (uuid.UUID, *api.APIError) {
+	ctx, span := tracer.Start(ctx, "checkpoint-sandbox")
+	defer span.End()
+
+	buildID, _, apiErr := o.forkSandbox(ctx, sbx, team, nil, nil, 0)
+
+	return buildID, apiErr
+}
+
+func (o *Orchestrator) forkSandbox(
+	ctx context.Context,
+	sbx instance.Sandbox,
+	team authcache.AuthTeamInfo,
+	forkIDs []string,
+	metadata map[string]string,
+	timeout time.Duration,
+) (uuid.UUID, []api.Sandbox, *api.APIError) {
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("node '%s' not found", sbx.NodeID),
+		}
+	}
+
+	// The sandbox catalog events support only a single sandbox per request.
+	if !node.IsNomadManaged() {
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Forking is not supported for sandboxes in this cluster",
+			Err:       fmt.Errorf("fork is not supported on cluster node '%s'", node.ID),
+		}
+	}
+
+	for _, forkID := range forkIDs {
+		release, err := o.sandboxStore.Reserve(forkID, team.Team.ID, team.Tier.ConcurrentInstances)
+		if err != nil {
+			var limitErr *instance.SandboxLimitExceededError
+			if errors.As(err, &limitErr) {
+				return uuid.Nil, nil, &api.APIError{
+					Code: http.StatusTooManyRequests,
+					ClientMsg: fmt.Sprintf(
+						"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
+							"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
+					Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
+				}
+			}
+
+			return uuid.Nil, nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: fmt.Sprintf("Failed to fork sandbox: %s", err),
+				Err:       err,
+			}
+		}
+
+		// The started forks are added to the store before the release, the reservations of the failed ones are dropped.
+		defer release()
+	}
+
+	telemetry.ReportEvent(ctx, "Reserved sandbox forks for team")
+
+	features, err := sandbox.NewVersionInfo(sbx.FirecrackerVersion)
+	if err != nil {
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get build information for the sandbox",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", sbx.FirecrackerVersion, err),
+		}
+	}
+
+	// The sandbox is in the pausing state while the snapshot is taken, so it can't be paused or killed in the meantime.
+	alreadyDone, finish, err := o.sandboxStore.StartRemoving(ctx, sbx.SandboxID, instance.StateActionPause)
+	if err != nil || alreadyDone {
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' is being paused or killed", sbx.SandboxID),
+			Err:       fmt.Errorf("failed to start fork of sandbox '%s': %w", sbx.SandboxID, err),
+		}
+	}
+
+	var forkErr error
+	defer func() {
+		finish(forkErr)
+	}()
+
+	envBuild, forkErr := o.dbClient.NewSnapshotBuild(
+		ctx,
+		newSnapshotInfo(sbx, node.Metadata().CPUArchitecture),
+		sbx.TeamID,
+		sbx.NodeID,
+	)
+	if forkErr != nil {
+		telemetry.ReportCriticalError(ctx, "error creating fork snapshot build", forkErr)
+
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("error creating snapshot build: %w", forkErr),
+		}
+	}
+
+	newConfig := func(sandboxID string, metadata map[string]string) *orchestrator.SandboxConfig {
+		return &orchestrator.SandboxConfig{
+			BaseTemplateId:       sbx.BaseTemplateID,
+			TemplateId:           envBuild.EnvID,
+			Alias:                sbx.Alias,
+			TeamId:               sbx.TeamID.String(),
+			BuildId:              envBuild.ID.String(),
+			SandboxId:            sandboxID,
+			ExecutionId:          uuid.New().String(),
+			KernelVersion:        sbx.KernelVersion,
+			FirecrackerVersion:   sbx.FirecrackerVersion,
+			EnvdVersion:          sbx.EnvdVersion,
+			Metadata:             metadata,
+			EnvdAccessToken:      sbx.EnvdAccessToken,
+			MaxSandboxLength:     team.Tier.MaxLengthHours,
+			HugePages:            features.HasHugePages(),
+			RamMb:                sbx.RamMB,
+			Vcpu:                 sbx.VCpu,
+			Snapshot:             true,
+			AutoPause:            sbx.AutoPause,
+			IdleTimeout:          int64(sbx.IdleTimeout.Seconds()),
+			MemoryLimitMb:        sbx.MemoryLimitMB,
+			AllowInternetAccess:  sbx.AllowInternetAccess,
+			Network:              sandbox.NetworkConfigToGRPC(sbx.Network),
+			NetworkBandwidthMbps: team.Tier.NetworkBandwidthMbps,
+			TotalDiskSizeMb:      sbx.TotalDiskSizeMB,
+			CpuArchitecture:      envBuild.CPUArchitecture,
+		}
+	}
+
+	forkMetadata := maps.Clone(sbx.Metadata)
+	if forkMetadata == nil {
+		forkMetadata = make(map[string]string, len(metadata))
+	}
+	maps.Copy(forkMetadata, metadata)
+
+	startTime := time.Now()
+	endTime := startTime.Add(timeout)
+
+	original := &orchestrator.SandboxCreateRequest{
+		Sandbox:   newConfig(sbx.SandboxID, sbx.Metadata),
+		StartTime: timestamppb.New(sbx.StartTime),
+		EndTime:   timestamppb.New(sbx.EndTime),
+	}
+
+	forks := make(map[string]*orchestrator.SandboxCreateRequest, len(forkIDs))
+	forkRequests := make([]*orchestrator.SandboxCreateRequest, 0, len(forkIDs))
+	for _, forkID := range forkIDs {
+		fork := &orchestrator.SandboxCreateRequest{
+			Sandbox:   newConfig(forkID, forkMetadata),
+			StartTime: timestamppb.New(startTime),
+			EndTime:   timestamppb.New(endTime),
+		}
+
+		forks[forkID] = fork
+		forkRequests = append(forkRequests, fork)
+	}
+
+	client, ctx := node.GetClient(ctx)
+	res, forkErr := client.Sandbox.Fork(ctx, &orchestrator.SandboxForkRequest{
+		SandboxId:  sbx.SandboxID,
+		TemplateId: envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+		Original:   original,
+		Forks:      forkRequests,
+	})
+	if forkErr != nil {
+		telemetry.ReportCriticalError(ctx, "error forking sandbox", forkErr)
+
+		// The original sandbox state on the node is unknown, the node sync adds it back if it's still running.
+		o.sandboxStore.Remove(sbx.SandboxID)
+		node.RemoveSandbox(sbx)
+		go o.countersRemove(context.WithoutCancel(ctx), sbx, instance.StateActionPause)
+		go o.analyticsRemove(context.WithoutCancel(ctx), sbx, instance.StateActionPause)
+
+		return uuid.Nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error forking sandbox",
+			Err:       fmt.Errorf("failed to fork sandbox '%s': %w", sbx.SandboxID, forkErr),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Forked sandbox")
+
+	now := time.Now()
+	err = o.sqlcDB.UpdateEnvBuildStatus(ctx, queries.UpdateEnvBuildStatusParams{
+		Status:     string(envbuild.StatusSuccess),
+		FinishedAt: &now,
+		Reason:     types.BuildReason{},
+		BuildID:    envBuild.ID,
+		TemplateID: envBuild.EnvID,
+	})
+	if err != nil {
+		telemetry.ReportError(ctx, "error updating fork snapshot build status", err)
+	}
+
+	// Replace the original sandbox with the resumed one, it has a new execution ID and build.
+	o.sandboxStore.Remove(sbx.SandboxID)
+	node.RemoveSandbox(sbx)
+	go o.countersRemove(context.WithoutCancel(ctx), sbx, instance.StateActionPause)
+
+	resumed := sbx
+	resumed.ExecutionID = original.GetSandbox().GetExecutionId()
+	resumed.BuildID = envBuild.ID
+	resumed.State = instance.StateRunning
+	o.sandboxStore.Add(ctx, resumed, false)
+
+	node.InsertBuild(envBuild.ID.String())
+
+	var sbxDomain *string
+	if team.Team.ClusterID != nil {
+		if cluster, ok := o.clusters.GetClusterById(*team.Team.ClusterID); ok {
+			sbxDomain = cluster.SandboxDomain
+		}
+	}
+
+	sandboxes := make([]api.Sandbox, 0, len(res.GetSandboxIds()))
+	for _, sandboxID := range res.GetSandboxIds() {
+		fork, ok := forks[sandboxID]
+		if !ok {
+			zap.L().Error("orchestrator returned unknown sandbox fork", logger.WithSandboxID(sandboxID))
+
+			continue
+		}
+
+		instanceInfo := instance.NewSandbox(
+			sandboxID,
+			sbx.TemplateID,
+			consts.ClientID,
+			sbx.Alias,
+			fork.GetSandbox().GetExecutionId(),
+			sbx.TeamID,
+			envBuild.ID,
+			forkMetadata,
+			time.Duration(team.Tier.MaxLengthHours)*time.Hour,
+			startTime,
+			endTime,
+			sbx.VCpu,
+			sbx.TotalDiskSizeMB,
+			sbx.RamMB,
+			sbx.KernelVersion,
+			sbx.FirecrackerVersion,
+			sbx.EnvdVersion,
+			node.ID,
+			node.ClusterID,
+			sbx.AutoPause,
+			sbx.EnvdAccessToken,
+			sbx.AllowInternetAccess,
+			sbx.Network,
+			sbx.BaseTemplateID,
+			sbx.IdleTimeout,
+			sbx.MemoryLimitMB,
+			startTime,
+		)
+		o.sandboxStore.Add(ctx, instanceInfo, true)
+
+		sandboxes = append(sandboxes, api.Sandbox{
+			ClientID:        consts.ClientID,
+			SandboxID:       sandboxID,
+			TemplateID:      sbx.TemplateID,
+			Alias:           sbx.Alias,
+			EnvdVersion:     sbx.EnvdVersion,
+			EnvdAccessToken: sbx.EnvdAccessToken,
+			Domain:          sbxDomain,
+
+			TrafficAccessToken: sandbox.TrafficAccessToken(sbx.Network),
+		})
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.Int("fork.requested", len(forkIDs)),
+		attribute.Int("fork.started", len(sandboxes)),
+	)
+
+	if len(sandboxes) < len(forkIDs) {
+		zap.L().Warn("some sandbox forks failed to start",
+			logger.WithSandboxID(sbx.SandboxID),
+			zap.Int("fork.requested", len(forkIDs)),
+			zap.Int("fork.started", len(sandboxes)),
+		)
+	}
+
+	return envBuild.ID, sandboxes, nil
+}
@@ -2,12 +2,15 @@ package nodemanager
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/edge"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	reverseproxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 )
 
 type NodeMetadata struct {
@@ -17,6 +20,9 @@ type NodeMetadata struct {
 
 	Commit  string
 	Version string
+
+	// CPUArchitecture of the node, only the sandboxes of the templates with the same architecture can run on it.
+	CPUArchitecture string
 }
 
 func (n *Node) setMetadata(md NodeMetadata) {
@@ -52,6 +58,31 @@ func (n *Node) GetSandboxCreateCtx(ctx context.Context, req *orchestrator.Sandbo
 
 			ExecutionID:    req.Sandbox.ExecutionId,
 			OrchestratorID: n.Metadata().ServiceInstanceID,
+
+			Ingress: reverseproxy.NewIngressFromConfig(req.Sandbox.GetNetwork().GetIngress()),
+		},
+	)
+
+	return metadata.NewOutgoingContext(ctx, metadata.Join(n.getClientMetadata(), md))
+}
+
+// GetSandboxIngressUpdateCtx stores the sandbox in the catalog again, so the client proxy uses the updated ingress.
+func (n *Node) GetSandboxIngressUpdateCtx(ctx context.Context, sbx instance.Sandbox, ingress *orchestrator.SandboxIngressConfig) context.Context {
+	// Skip local cluster. It should be okay to send it here, but we don't want to do it until we explicitly support it.
+	if n.IsNomadManaged() {
+		return ctx
+	}
+
+	md := edge.SerializeSandboxCatalogCreateEvent(
+		edge.SandboxCatalogCreateEvent{
+			SandboxID:               sbx.SandboxID,
+			SandboxMaxLengthInHours: int64(sbx.MaxInstanceLength / time.Hour),
+			SandboxStartTime:        sbx.StartTime,
+
+			ExecutionID:    sbx.ExecutionID,
+			OrchestratorID: n.Metadata().ServiceInstanceID,
+
+			Ingress: reverseproxy.NewIngressFromConfig(ingress),
 		},
 	)
 
@@ -72,6 +72,12 @@ func newMockGRPCClient() *grpclient.GRPCClient {
 
 type TestOptions func(node *TestNode)
 
+func WithCPUArchitecture(cpuArchitecture string) TestOptions {
+	return func(node *TestNode) {
+		node.meta.CPUArchitecture = cpuArchitecture
+	}
+}
+
 func WithSandboxSleepingClient(baseSandboxCreateTime time.Duration) TestOptions {
 	return func(node *TestNode) {
 		node.client.Sandbox = &mockSandboxClientWithSleep{
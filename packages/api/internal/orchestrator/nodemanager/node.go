@@ -84,6 +84,7 @@ func New(
 		ServiceInstanceID: nodeInfo.ServiceId,
 		Commit:            nodeInfo.ServiceCommit,
 		Version:           nodeInfo.ServiceVersion,
+		CPUArchitecture:   consts.CPUArchitectureOrDefault(nodeInfo.ServiceCpuArchitecture),
 	}
 
 	n := &Node{
@@ -122,6 +123,7 @@ func NewClusterNode(ctx context.Context, client *grpclient.GRPCClient, clusterID
 		ServiceInstanceID: i.ServiceInstanceID,
 		Commit:            i.ServiceVersionCommit,
 		Version:           i.ServiceVersion,
+		CPUArchitecture:   i.GetCPUArchitecture(),
 	}
 
 	n := &Node{
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 )
@@ -71,7 +72,11 @@ func (n *Node) GetSandboxes(ctx context.Context) ([]instance.Sandbox, error) {
 				config.AutoPause,
 				config.EnvdAccessToken,
 				config.AllowInternetAccess,
+				sandbox.NetworkConfigFromGRPC(config.GetNetwork()),
 				config.BaseTemplateId,
+				time.Duration(config.IdleTimeout)*time.Second,
+				config.MemoryLimitMb,
+				sbx.GetLastActivity().AsTime(),
 			),
 		)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 )
 
@@ -37,6 +38,7 @@ func (n *Node) Sync(ctx context.Context, instanceCache *instance.MemoryStore) {
 				ServiceInstanceID: nodeInfo.ServiceId,
 				Commit:            nodeInfo.ServiceCommit,
 				Version:           nodeInfo.ServiceVersion,
+				CPUArchitecture:   consts.CPUArchitectureOrDefault(nodeInfo.ServiceCpuArchitecture),
 			},
 		)
 		// Update host metrics from service info
@@ -11,6 +11,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
@@ -29,25 +30,9 @@ func (o *Orchestrator) pauseSandbox(ctx context.Context, node *nodemanager.Node,
 	ctx, span := tracer.Start(ctx, "pause-sandbox")
 	defer span.End()
 
-	snapshotConfig := &db.SnapshotInfo{
-		BaseTemplateID:      sbx.BaseTemplateID,
-		SandboxID:           sbx.SandboxID,
-		SandboxStartedAt:    sbx.StartTime,
-		VCPU:                sbx.VCpu,
-		RAMMB:               sbx.RamMB,
-		TotalDiskSizeMB:     sbx.TotalDiskSizeMB,
-		Metadata:            sbx.Metadata,
-		KernelVersion:       sbx.KernelVersion,
-		FirecrackerVersion:  sbx.FirecrackerVersion,
-		EnvdVersion:         sbx.EnvdVersion,
-		EnvdSecured:         sbx.EnvdAccessToken != nil,
-		AllowInternetAccess: sbx.AllowInternetAccess,
-		AutoPause:           sbx.AutoPause,
-	}
-
 	envBuild, err := o.dbClient.NewSnapshotBuild(
 		ctx,
-		snapshotConfig,
+		newSnapshotInfo(sbx, node.Metadata().CPUArchitecture),
 		sbx.TeamID,
 		sbx.NodeID,
 	)
@@ -87,6 +72,28 @@ func (o *Orchestrator) pauseSandbox(ctx context.Context, node *nodemanager.Node,
 	return nil
 }
 
+func newSnapshotInfo(sbx instance.Sandbox, cpuArchitecture string) *db.SnapshotInfo {
+	return &db.SnapshotInfo{
+		BaseTemplateID:      sbx.BaseTemplateID,
+		SandboxID:           sbx.SandboxID,
+		SandboxStartedAt:    sbx.StartTime,
+		VCPU:                sbx.VCpu,
+		RAMMB:               sbx.RamMB,
+		TotalDiskSizeMB:     sbx.TotalDiskSizeMB,
+		Metadata:            sbx.Metadata,
+		KernelVersion:       sbx.KernelVersion,
+		FirecrackerVersion:  sbx.FirecrackerVersion,
+		EnvdVersion:         sbx.EnvdVersion,
+		EnvdSecured:         sbx.EnvdAccessToken != nil,
+		AllowInternetAccess: sbx.AllowInternetAccess,
+		Network:             sandbox.NetworkConfigToSnapshot(sbx.Network),
+		AutoPause:           sbx.AutoPause,
+		IdleTimeout:         int64(sbx.IdleTimeout.Seconds()),
+		MemoryLimitMB:       sbx.MemoryLimitMB,
+		CPUArchitecture:     cpuArchitecture,
+	}
+}
+
 func snapshotInstance(ctx context.Context, orch *Orchestrator, node *nodemanager.Node, sbx instance.Sandbox, templateID, buildID string) error {
 	childCtx, childSpan := tracer.Start(ctx, "snapshot-instance")
 	defer childSpan.End()
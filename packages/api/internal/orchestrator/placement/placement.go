@@ -11,6 +11,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator/nodemanager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -32,11 +33,18 @@ func PlaceSandbox(ctx context.Context, algorithm Algorithm, clusterNodes []*node
 	ctx, span := tracer.Start(ctx, "place-sandbox")
 	defer span.End()
 
+	// The sandbox can run only on the nodes with the architecture of its template
+	cpuArchitecture := consts.CPUArchitectureOrDefault(sbxRequest.Sandbox.GetCpuArchitecture())
+	clusterNodes = filterNodesByArchitecture(clusterNodes, cpuArchitecture)
+	if len(clusterNodes) == 0 {
+		return nil, fmt.Errorf("no nodes available with the %s architecture", cpuArchitecture)
+	}
+
 	nodesExcluded := make(map[string]struct{})
 	var err error
 
 	var node *nodemanager.Node
-	if preferredNode != nil {
+	if preferredNode != nil && nodeArchitecture(preferredNode) == cpuArchitecture {
 		node = preferredNode
 	}
 
@@ -103,3 +111,18 @@ func PlaceSandbox(ctx context.Context, algorithm Algorithm, clusterNodes []*node
 
 	return nil, errSandboxCreateFailed
 }
+
+func filterNodesByArchitecture(nodes []*nodemanager.Node, cpuArchitecture string) []*nodemanager.Node {
+	filtered := make([]*nodemanager.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if nodeArchitecture(node) == cpuArchitecture {
+			filtered = append(filtered, node)
+		}
+	}
+
+	return filtered
+}
+
+func nodeArchitecture(node *nodemanager.Node) string {
+	return consts.CPUArchitectureOrDefault(node.Metadata().CPUArchitecture)
+}
@@ -172,3 +172,38 @@ func TestPlaceSandbox_AllNodesExcluded(t *testing.T) {
 	assert.Contains(t, err.Error(), "no nodes available")
 	algorithm.AssertExpectations(t)
 }
+
+func TestPlaceSandbox_FiltersByArchitecture(t *testing.T) {
+	ctx := t.Context()
+
+	amd64Node := nodemanager.NewTestNode("node1", api.NodeStatusReady, 3, 4)
+	arm64Node := nodemanager.NewTestNode("node2", api.NodeStatusReady, 3, 4, nodemanager.WithCPUArchitecture("arm64"))
+	nodes := []*nodemanager.Node{amd64Node, arm64Node}
+
+	sbxRequest := &orchestrator.SandboxCreateRequest{
+		Sandbox: &orchestrator.SandboxConfig{
+			SandboxId:       "test-sandbox",
+			Vcpu:            2,
+			RamMb:           1024,
+			CpuArchitecture: "arm64",
+		},
+	}
+
+	// Only the arm64 node is passed to the algorithm
+	algorithm := &mockAlgorithm{}
+	algorithm.On("chooseNode", mock.Anything, []*nodemanager.Node{arm64Node}, mock.Anything, mock.Anything).
+		Return(arm64Node, nil).Once()
+
+	// The preferred node with a different architecture is ignored
+	resultNode, err := PlaceSandbox(ctx, algorithm, nodes, amd64Node, sbxRequest)
+	require.NoError(t, err)
+	assert.Equal(t, arm64Node, resultNode)
+	algorithm.AssertExpectations(t)
+
+	// The templates without the architecture are amd64
+	sbxRequest.Sandbox.CpuArchitecture = ""
+	resultNode, err = PlaceSandbox(ctx, algorithm, []*nodemanager.Node{arm64Node}, nil, sbxRequest)
+	require.Error(t, err)
+	assert.Nil(t, resultNode)
+	assert.Contains(t, err.Error(), "no nodes available with the amd64 architecture")
+}
@@ -4,14 +4,21 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/types"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -51,3 +58,112 @@ func (o *Orchestrator) UpdateSandbox(
 
 	return nil
 }
+
+func (o *Orchestrator) UpdateSandboxIngress(
+	ctx context.Context,
+	sbx instance.Sandbox,
+	ingress *types.SandboxIngressConfig,
+) error {
+	childCtx, childSpan := tracer.Start(ctx, "update-sandbox-ingress",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	node := o.GetNode(sbx.ClusterID, sbx.NodeID)
+	if node == nil {
+		return fmt.Errorf("node '%s' not found in cluster '%s'", sbx.NodeID, sbx.ClusterID)
+	}
+
+	ingressConfig := sandbox.IngressConfigToGRPC(ingress)
+
+	client, childCtx := node.GetClient(childCtx)
+	_, err := client.Sandbox.Update(
+		node.GetSandboxIngressUpdateCtx(childCtx, sbx, ingressConfig), &orchestrator.SandboxUpdateRequest{
+			SandboxId: sbx.SandboxID,
+			Ingress:   ingressConfig,
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return fmt.Errorf("failed to update sandbox '%s' ingress: %w", sbx.SandboxID, err)
+	}
+
+	// Keep the ingress so it's stored with the snapshot when the sandbox is paused
+	err = o.sandboxStore.SetIngress(sbx.SandboxID, ingress)
+	if err != nil {
+		return fmt.Errorf("failed to store sandbox '%s' ingress: %w", sbx.SandboxID, err)
+	}
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox ingress")
+
+	return nil
+}
+
+// UpdateSandboxMemory limits the memory available to the running sandbox, zero gives back all the memory of the sandbox.
+func (o *Orchestrator) UpdateSandboxMemory(
+	ctx context.Context,
+	sbx instance.Sandbox,
+	memoryLimitMB int64,
+) *api.APIError {
+	childCtx, childSpan := tracer.Start(ctx, "update-sandbox-memory",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	client, childCtx, err := o.GetClient(childCtx, sbx.ClusterID, sbx.NodeID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error resizing sandbox memory",
+			Err:       fmt.Errorf("failed to get client '%s': %w", sbx.NodeID, err),
+		}
+	}
+
+	_, err = client.Sandbox.Update(
+		childCtx, &orchestrator.SandboxUpdateRequest{
+			SandboxId:     sbx.SandboxID,
+			MemoryLimitMb: &memoryLimitMB,
+		},
+	)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return &api.APIError{
+				Code:      http.StatusConflict,
+				ClientMsg: "The sandbox template doesn't support memory resizing, rebuild the template to enable it",
+				Err:       fmt.Errorf("failed to resize sandbox '%s' memory: %w", sbx.SandboxID, utils.UnwrapGRPCError(err)),
+			}
+		case codes.NotFound:
+			return &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Sandbox '%s' is not running", sbx.SandboxID),
+				Err:       fmt.Errorf("failed to resize sandbox '%s' memory: %w", sbx.SandboxID, utils.UnwrapGRPCError(err)),
+			}
+		default:
+			return &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Error resizing sandbox memory",
+				Err:       fmt.Errorf("failed to resize sandbox '%s' memory: %w", sbx.SandboxID, utils.UnwrapGRPCError(err)),
+			}
+		}
+	}
+
+	// Keep the limit so it's stored with the snapshot when the sandbox is paused
+	err = o.sandboxStore.SetMemoryLimit(sbx.SandboxID, memoryLimitMB)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error resizing sandbox memory",
+			Err:       fmt.Errorf("failed to store sandbox '%s' memory limit: %w", sbx.SandboxID, err),
+		}
+	}
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox memory limit")
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// DeleteVolume removes the volume data from the storage, the volumes are shared by all nodes in the cluster so any ready node can do it.
+func (o *Orchestrator) DeleteVolume(ctx context.Context, clusterID uuid.UUID, volumeID string) error {
+	ctx, span := tracer.Start(ctx, "delete-volume")
+	defer span.End()
+
+	var errs []error
+	for _, node := range o.GetClusterNodes(clusterID) {
+		if node.Status() != api.NodeStatusReady {
+			continue
+		}
+
+		client, ctx := node.GetClient(ctx)
+		_, err := client.Sandbox.VolumeDelete(ctx, &orchestrator.VolumeDeleteRequest{VolumeId: volumeID})
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, fmt.Errorf("failed to delete volume on node '%s': %w", node.ID, err))
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("no ready node found in cluster '%s'", clusterID)
+	}
+
+	return errors.Join(errs...)
+}
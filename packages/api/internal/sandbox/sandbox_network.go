@@ -0,0 +1,408 @@
+package sandbox
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+)
+
+const allTraffic = "0.0.0.0/0"
+
+// blockedEgressRanges are always blocked in the sandbox firewall, they can't be allowed by the egress rules.
+var blockedEgressRanges = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+}
+
+// NetworkConfigFromAPI converts the network config from the request, it returns nil when there are no rules.
+func NetworkConfigFromAPI(network *api.SandboxNetworkConfig) (*types.SandboxNetworkConfig, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	config := &types.SandboxNetworkConfig{}
+	if network.AllowOut != nil {
+		config.AllowOut = *network.AllowOut
+	}
+
+	if network.DenyOut != nil {
+		config.DenyOut = *network.DenyOut
+	}
+
+	for _, entry := range config.AllowOut {
+		if err := validateEgressEntry(entry); err != nil {
+			return nil, err
+		}
+
+		if entry == allTraffic {
+			return nil, fmt.Errorf("'%s' can't be used in allowed egress, omit the network config instead", allTraffic)
+		}
+
+		if err := validateAllowedEgressEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entry := range config.DenyOut {
+		if err := validateEgressEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if network.Ingress != nil {
+		ingress, err := IngressConfigFromAPI(*network.Ingress, "")
+		if err != nil {
+			return nil, err
+		}
+
+		config.Ingress = ingress
+	}
+
+	if network.Dns != nil {
+		dns, err := dnsConfigFromAPI(*network.Dns)
+		if err != nil {
+			return nil, err
+		}
+
+		config.DNS = dns
+	}
+
+	if len(config.AllowOut) == 0 && len(config.DenyOut) == 0 && config.Ingress == nil && config.DNS == nil {
+		return nil, nil
+	}
+
+	return config, nil
+}
+
+// dnsConfigFromAPI converts the DNS config from the request, it returns nil when the config is empty.
+func dnsConfigFromAPI(dns api.SandboxDNSConfig) (*types.SandboxDNSConfig, error) {
+	config := &types.SandboxDNSConfig{}
+
+	if dns.Nameservers != nil {
+		for _, nameserver := range *dns.Nameservers {
+			if _, err := netip.ParseAddr(nameserver); err != nil {
+				return nil, fmt.Errorf("invalid nameserver '%s', expected IP address", nameserver)
+			}
+		}
+
+		config.Nameservers = *dns.Nameservers
+	}
+
+	if dns.Searches != nil {
+		for _, search := range *dns.Searches {
+			if err := validateHostname(search); err != nil {
+				return nil, fmt.Errorf("invalid search domain: %w", err)
+			}
+		}
+
+		config.Searches = *dns.Searches
+	}
+
+	if dns.Hosts != nil {
+		for _, host := range *dns.Hosts {
+			if _, err := netip.ParseAddr(host.Ip); err != nil {
+				return nil, fmt.Errorf("invalid host entry IP '%s', expected IP address", host.Ip)
+			}
+
+			if err := validateHostname(host.Hostname); err != nil {
+				return nil, fmt.Errorf("invalid host entry: %w", err)
+			}
+
+			config.Hosts = append(config.Hosts, types.SandboxHostEntry{IP: host.Ip, Hostname: host.Hostname})
+		}
+	}
+
+	if len(config.Nameservers) == 0 && len(config.Searches) == 0 && len(config.Hosts) == 0 {
+		return nil, nil
+	}
+
+	return config, nil
+}
+
+// hostnameLabel is the RFC 1123 label, the hostnames are written to the resolver files in the sandbox
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+func validateHostname(hostname string) error {
+	if hostname == "" {
+		return fmt.Errorf("hostname can't be empty")
+	}
+
+	if len(hostname) > 253 {
+		return fmt.Errorf("hostname '%s' is longer than 253 characters", hostname)
+	}
+
+	for _, label := range strings.Split(hostname, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return fmt.Errorf("invalid hostname '%s'", hostname)
+		}
+	}
+
+	return nil
+}
+
+// IngressConfigFromAPI converts the ingress from the request, a new traffic access token is generated when the current one is empty.
+func IngressConfigFromAPI(ingress api.SandboxIngressConfig, trafficAccessToken string) (*types.SandboxIngressConfig, error) {
+	config := &types.SandboxIngressConfig{
+		DefaultAccess:      string(api.Public),
+		TrafficAccessToken: trafficAccessToken,
+	}
+
+	if ingress.DefaultAccess != nil {
+		config.DefaultAccess = string(*ingress.DefaultAccess)
+	}
+
+	if ingress.Ports != nil {
+		seen := make(map[int32]bool, len(*ingress.Ports))
+		for _, port := range *ingress.Ports {
+			if seen[port.Port] {
+				return nil, fmt.Errorf("port %d is listed multiple times in the ingress", port.Port)
+			}
+			seen[port.Port] = true
+
+			config.Ports = append(config.Ports, types.SandboxPortIngress{Port: uint64(port.Port), Access: string(port.Access)})
+		}
+	}
+
+	if config.TrafficAccessToken == "" {
+		token, err := keys.GenerateKey(keys.TrafficAccessTokenPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate traffic access token: %w", err)
+		}
+
+		config.TrafficAccessToken = token.PrefixedRawValue
+	}
+
+	return config, nil
+}
+
+func IngressToAPI(ingress *types.SandboxIngressConfig) api.SandboxIngress {
+	result := api.SandboxIngress{
+		DefaultAccess: api.Public,
+		Ports:         make([]api.SandboxPortIngress, 0),
+	}
+
+	if ingress == nil {
+		return result
+	}
+
+	result.DefaultAccess = api.SandboxPortAccess(ingress.DefaultAccess)
+	result.TrafficAccessToken = ingress.TrafficAccessToken
+	for _, port := range ingress.Ports {
+		result.Ports = append(result.Ports, api.SandboxPortIngress{Port: int32(port.Port), Access: api.SandboxPortAccess(port.Access)})
+	}
+
+	return result
+}
+
+// TrafficAccessToken returns the token for the ports with the token access, nil when the sandbox doesn't have ingress rules.
+func TrafficAccessToken(network *types.SandboxNetworkConfig) *string {
+	if network == nil || network.Ingress == nil {
+		return nil
+	}
+
+	return &network.Ingress.TrafficAccessToken
+}
+
+func validateEgressEntry(entry string) error {
+	if entry == "" {
+		return fmt.Errorf("egress entry can't be empty")
+	}
+
+	if prefix, err := netip.ParsePrefix(entry); err == nil {
+		if !prefix.Addr().Is4() {
+			return fmt.Errorf("only IPv4 CIDRs are supported, got '%s'", entry)
+		}
+
+		return nil
+	}
+
+	if addr, err := netip.ParseAddr(entry); err == nil {
+		if !addr.Is4() {
+			return fmt.Errorf("only IPv4 addresses are supported, got '%s'", entry)
+		}
+
+		return nil
+	}
+
+	if strings.ContainsAny(entry, "/: ") || !strings.Contains(entry, ".") {
+		return fmt.Errorf("invalid egress entry '%s', expected IPv4 address, CIDR or a domain", entry)
+	}
+
+	return nil
+}
+
+// validateAllowedEgressEntry rejects the allowed addresses overlapping the private and link-local ranges.
+func validateAllowedEgressEntry(entry string) error {
+	prefix, err := netip.ParsePrefix(entry)
+	if err != nil {
+		addr, addrErr := netip.ParseAddr(entry)
+		if addrErr != nil {
+			// Domains are checked when they are resolved on the orchestrator
+			return nil
+		}
+
+		prefix = netip.PrefixFrom(addr, 32)
+	}
+
+	for _, blocked := range blockedEgressRanges {
+		if prefix.Overlaps(blocked) {
+			return fmt.Errorf("allowed egress '%s' overlaps the blocked range '%s'", entry, blocked)
+		}
+	}
+
+	return nil
+}
+
+func NetworkConfigToGRPC(network *types.SandboxNetworkConfig) *orchestrator.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	return &orchestrator.SandboxNetworkConfig{
+		AllowOut: network.AllowOut,
+		DenyOut:  network.DenyOut,
+		Ingress:  IngressConfigToGRPC(network.Ingress),
+		Dns:      dnsConfigToGRPC(network.DNS),
+	}
+}
+
+func dnsConfigToGRPC(dns *types.SandboxDNSConfig) *orchestrator.SandboxDNSConfig {
+	if dns == nil {
+		return nil
+	}
+
+	config := &orchestrator.SandboxDNSConfig{
+		Nameservers: dns.Nameservers,
+		Searches:    dns.Searches,
+	}
+
+	for _, host := range dns.Hosts {
+		config.Hosts = append(config.Hosts, &orchestrator.SandboxHostEntry{Ip: host.IP, Hostname: host.Hostname})
+	}
+
+	return config
+}
+
+func IngressConfigToGRPC(ingress *types.SandboxIngressConfig) *orchestrator.SandboxIngressConfig {
+	if ingress == nil {
+		return nil
+	}
+
+	ports := make(map[uint64]orchestrator.SandboxPortAccess, len(ingress.Ports))
+	for _, port := range ingress.Ports {
+		ports[port.Port] = portAccessToGRPC(port.Access)
+	}
+
+	return &orchestrator.SandboxIngressConfig{
+		DefaultAccess:      portAccessToGRPC(ingress.DefaultAccess),
+		Ports:              ports,
+		TrafficAccessToken: &ingress.TrafficAccessToken,
+	}
+}
+
+func portAccessToGRPC(access string) orchestrator.SandboxPortAccess {
+	switch api.SandboxPortAccess(access) {
+	case api.Token:
+		return orchestrator.SandboxPortAccess_PortToken
+	case api.Closed:
+		return orchestrator.SandboxPortAccess_PortClosed
+	default:
+		return orchestrator.SandboxPortAccess_PortPublic
+	}
+}
+
+func portAccessFromGRPC(access orchestrator.SandboxPortAccess) string {
+	switch access {
+	case orchestrator.SandboxPortAccess_PortToken:
+		return string(api.Token)
+	case orchestrator.SandboxPortAccess_PortClosed:
+		return string(api.Closed)
+	default:
+		return string(api.Public)
+	}
+}
+
+func NetworkConfigFromGRPC(network *orchestrator.SandboxNetworkConfig) *types.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	config := &types.SandboxNetworkConfig{
+		AllowOut: network.GetAllowOut(),
+		DenyOut:  network.GetDenyOut(),
+	}
+
+	if ingress := network.GetIngress(); ingress != nil {
+		config.Ingress = &types.SandboxIngressConfig{
+			DefaultAccess:      portAccessFromGRPC(ingress.GetDefaultAccess()),
+			TrafficAccessToken: ingress.GetTrafficAccessToken(),
+		}
+
+		for port, access := range ingress.GetPorts() {
+			config.Ingress.Ports = append(config.Ingress.Ports, types.SandboxPortIngress{Port: port, Access: portAccessFromGRPC(access)})
+		}
+
+		slices.SortFunc(config.Ingress.Ports, func(a, b types.SandboxPortIngress) int {
+			return cmp.Compare(a.Port, b.Port)
+		})
+	}
+
+	if dns := network.GetDns(); dns != nil {
+		config.DNS = &types.SandboxDNSConfig{
+			Nameservers: dns.GetNameservers(),
+			Searches:    dns.GetSearches(),
+		}
+
+		for _, host := range dns.GetHosts() {
+			config.DNS.Hosts = append(config.DNS.Hosts, types.SandboxHostEntry{IP: host.GetIp(), Hostname: host.GetHostname()})
+		}
+	}
+
+	return config
+}
+
+func NetworkConfigToSnapshot(network *types.SandboxNetworkConfig) *schema.SandboxNetworkConfig {
+	if network == nil {
+		return nil
+	}
+
+	config := &schema.SandboxNetworkConfig{
+		AllowOut: network.AllowOut,
+		DenyOut:  network.DenyOut,
+	}
+
+	if network.Ingress != nil {
+		config.Ingress = &schema.SandboxIngressConfig{
+			DefaultAccess:      network.Ingress.DefaultAccess,
+			TrafficAccessToken: network.Ingress.TrafficAccessToken,
+		}
+
+		for _, port := range network.Ingress.Ports {
+			config.Ingress.Ports = append(config.Ingress.Ports, schema.SandboxPortIngress{Port: port.Port, Access: port.Access})
+		}
+	}
+
+	if network.DNS != nil {
+		config.DNS = &schema.SandboxDNSConfig{
+			Nameservers: network.DNS.Nameservers,
+			Searches:    network.DNS.Searches,
+		}
+
+		for _, host := range network.DNS.Hosts {
+			config.DNS.Hosts = append(config.DNS.Hosts, schema.SandboxHostEntry{IP: host.IP, Hostname: host.Hostname})
+		}
+	}
+
+	return config
+}
@@ -0,0 +1,73 @@
+package sandbox
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// ValidateVolumeMounts checks that each volume and each path is used only once and the paths are absolute.
+func ValidateVolumeMounts(mounts []api.SandboxVolumeMount) error {
+	volumes := make(map[string]struct{}, len(mounts))
+	paths := make(map[string]struct{}, len(mounts))
+
+	for _, mount := range mounts {
+		if _, ok := volumes[mount.VolumeID]; ok {
+			return fmt.Errorf("volume '%s' is mounted more than once", mount.VolumeID)
+		}
+		volumes[mount.VolumeID] = struct{}{}
+
+		if !path.IsAbs(mount.Path) || strings.ContainsAny(mount.Path, " \t\n") {
+			return fmt.Errorf("invalid mount path '%s', the path must be absolute", mount.Path)
+		}
+
+		mountPath := path.Clean(mount.Path)
+		if mountPath == "/" {
+			return fmt.Errorf("volume '%s' can't be mounted to the root", mount.VolumeID)
+		}
+
+		if _, ok := paths[mountPath]; ok {
+			return fmt.Errorf("path '%s' is used by more than one volume", mountPath)
+		}
+		paths[mountPath] = struct{}{}
+	}
+
+	return nil
+}
+
+func VolumesToGRPC(volumes []queries.Volume) []*orchestrator.SandboxVolumeMount {
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	mounts := make([]*orchestrator.SandboxVolumeMount, 0, len(volumes))
+	for _, volume := range volumes {
+		var mountPath string
+		if volume.MountPath != nil {
+			mountPath = *volume.MountPath
+		}
+
+		mounts = append(mounts, &orchestrator.SandboxVolumeMount{
+			VolumeId: volume.ID.String(),
+			Path:     mountPath,
+			SizeMb:   volume.SizeMb,
+		})
+	}
+
+	return mounts
+}
+
+func VolumeToAPI(volume queries.Volume) api.Volume {
+	return api.Volume{
+		VolumeID:  volume.ID.String(),
+		Name:      volume.Name,
+		SizeMB:    volume.SizeMb,
+		SandboxID: volume.SandboxID,
+		MountPath: volume.MountPath,
+		CreatedAt: volume.CreatedAt,
+	}
+}
@@ -0,0 +1,217 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	pollInterval = 10 * time.Second
+	claimBatch   = 20
+	// maxRunning limits the schedules run at the same time by the API instance, the schedules over it are left for the next poll.
+	maxRunning = 100
+
+	// MaxTimeout is the longest time a scheduled run can take, the claimed schedules are leased for a bit longer.
+	MaxTimeout    = time.Hour
+	leaseDuration = MaxTimeout + 5*time.Minute
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/api/internal/scheduler")
+
+// Actions are the sandbox lifecycle operations run by the schedules.
+type Actions interface {
+	// Resume resumes the paused sandbox, it returns false when the sandbox is already running.
+	Resume(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string, timeout time.Duration) (bool, error)
+	Exec(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string, command string, user string, timeout time.Duration) error
+	Pause(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string) error
+	Kill(ctx context.Context, team authcache.AuthTeamInfo, sandboxID string) error
+}
+
+// Scheduler runs the sandbox schedules stored in the database when they are due.
+// The schedules are claimed in the database, so multiple API instances can run the scheduler at the same time.
+type Scheduler struct {
+	db      *sqlcdb.Client
+	actions Actions
+
+	// running is the semaphore of the running schedules
+	running chan struct{}
+}
+
+func New(db *sqlcdb.Client, actions Actions) *Scheduler {
+	return &Scheduler{
+		db:      db,
+		actions: actions,
+		running: make(chan struct{}, maxRunning),
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) runDue(ctx context.Context) {
+	// Only the schedules that can start right away are claimed, the claimed schedules are leased until they finish
+	free := cap(s.running) - len(s.running)
+	if free == 0 {
+		return
+	}
+
+	now := time.Now()
+	schedules, err := s.db.ClaimDueSandboxSchedules(ctx, queries.ClaimDueSandboxSchedulesParams{
+		LeaseUntil: now.Add(leaseDuration),
+		Now:        now,
+		MaxCount:   int32(min(claimBatch, free)),
+	})
+	if err != nil {
+		zap.L().Error("Error claiming due sandbox schedules", zap.Error(err))
+		return
+	}
+
+	for _, schedule := range schedules {
+		// The slots are taken only by this loop, so the slots counted as free before the claim are still free
+		s.running <- struct{}{}
+
+		go func() {
+			defer func() { <-s.running }()
+
+			s.runSchedule(context.WithoutCancel(ctx), schedule)
+		}()
+	}
+}
+
+func (s *Scheduler) runSchedule(ctx context.Context, schedule queries.SandboxSchedule) {
+	ctx, span := tracer.Start(ctx, "run-sandbox-schedule")
+	defer span.End()
+
+	span.SetAttributes(
+		telemetry.WithSandboxID(schedule.SandboxID),
+		telemetry.WithTeamID(schedule.TeamID.String()),
+		attribute.String("schedule.id", schedule.ID.String()),
+		attribute.String("schedule.action", schedule.Action),
+	)
+
+	startedAt := time.Now()
+	runErr := s.run(ctx, schedule)
+	if runErr != nil {
+		telemetry.ReportError(ctx, "error running sandbox schedule", runErr)
+		zap.L().Warn("Sandbox schedule failed", logger.WithSandboxID(schedule.SandboxID), zap.String("schedule_id", schedule.ID.String()), zap.Error(runErr))
+	}
+
+	// One-off schedules are removed after the run
+	if schedule.Cron == nil {
+		_, err := s.db.DeleteSandboxSchedule(ctx, queries.DeleteSandboxScheduleParams{
+			ID:        schedule.ID,
+			SandboxID: schedule.SandboxID,
+			TeamID:    schedule.TeamID,
+		})
+		if err != nil {
+			zap.L().Error("Error deleting finished sandbox schedule", zap.String("schedule_id", schedule.ID.String()), zap.Error(err))
+		}
+
+		return
+	}
+
+	nextRunAt, err := NextRun(*schedule.Cron, time.Now())
+	if err != nil {
+		// The expression was validated on create, it can fail only when there is no future time matching it
+		zap.L().Error("Error computing next sandbox schedule run", zap.String("schedule_id", schedule.ID.String()), zap.Error(err))
+		nextRunAt = time.Now().Add(leaseDuration)
+	}
+
+	var lastError *string
+	if runErr != nil {
+		msg := runErr.Error()
+		lastError = &msg
+	}
+
+	err = s.db.FinishSandboxSchedule(ctx, queries.FinishSandboxScheduleParams{
+		NextRunAt: nextRunAt,
+		LastRunAt: &startedAt,
+		LastError: lastError,
+		ID:        schedule.ID,
+	})
+	if err != nil {
+		zap.L().Error("Error updating sandbox schedule", zap.String("schedule_id", schedule.ID.String()), zap.Error(err))
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, schedule queries.SandboxSchedule) error {
+	row, err := s.db.GetTeamWithTierByTeamID(ctx, schedule.TeamID)
+	if err != nil {
+		return fmt.Errorf("error getting team: %w", err)
+	}
+
+	if row.Team.IsBanned || row.Team.IsBlocked {
+		return errors.New("team is blocked")
+	}
+
+	team := authcache.AuthTeamInfo{Team: &row.Team, Tier: &row.Tier}
+	timeout := time.Duration(schedule.Timeout) * time.Second
+
+	switch api.SandboxScheduleAction(schedule.Action) {
+	case api.Resume:
+		resumed, err := s.actions.Resume(ctx, team, schedule.SandboxID, timeout)
+		if err != nil {
+			return fmt.Errorf("error resuming sandbox: %w", err)
+		}
+
+		if schedule.Command == nil {
+			return nil
+		}
+
+		execErr := s.actions.Exec(ctx, team, schedule.SandboxID, *schedule.Command, sharedUtils.FromPtr(schedule.ExecUser), timeout)
+
+		// The sandbox is paused again only when it was resumed by the schedule
+		if !resumed {
+			return execErr
+		}
+
+		return errors.Join(execErr, s.actions.Pause(ctx, team, schedule.SandboxID))
+	case api.Pause:
+		return s.actions.Pause(ctx, team, schedule.SandboxID)
+	case api.Kill:
+		return s.actions.Kill(ctx, team, schedule.SandboxID)
+	default:
+		return fmt.Errorf("unknown schedule action '%s'", schedule.Action)
+	}
+}
+
+// NextRun returns the first time after the given time matching the cron expression.
+func NextRun(expression string, after time.Time) (time.Time, error) {
+	expr, err := cronexpr.Parse(expression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	next := expr.Next(after.UTC())
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression '%s' has no future run", expression)
+	}
+
+	return next, nil
+}
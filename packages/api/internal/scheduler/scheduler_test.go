@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRun(t *testing.T) {
+	after := time.Date(2025, 10, 4, 12, 30, 0, 0, time.UTC)
+
+	next, err := NextRun("0 3 * * *", after)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 10, 5, 3, 0, 0, 0, time.UTC), next)
+
+	next, err = NextRun("*/15 * * * *", after)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 10, 4, 12, 45, 0, 0, time.UTC), next)
+
+	_, err = NextRun("not a cron", after)
+	require.Error(t, err)
+
+	_, err = NextRun("0 0 1 1 * 2020", after)
+	require.Error(t, err)
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	ut "github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -49,7 +52,12 @@ func (tm *TemplateManager) CreateTemplate(
 	fromTemplate *string,
 	fromImageRegistry *api.FromImageRegistry,
 	force *bool,
+	buildVCpuCount *int32,
+	buildMemoryMB *int32,
 	steps *[]api.TemplateStep,
+	stages *[]api.TemplateBuildStage,
+	secrets map[string]string,
+	cpuArchitecture string,
 	clusterID uuid.UUID,
 	nodeID string,
 ) (e error) {
@@ -119,7 +127,12 @@ func (tm *TemplateManager) CreateTemplate(
 		ReadyCommand:       readyCmd,
 		Force:              force,
 		Steps:              convertTemplateSteps(steps),
+		Stages:             convertTemplateStages(stages),
+		Secrets:            secrets,
+		CpuArchitecture:    cpuArchitecture,
 		FromImageRegistry:  imageRegistry,
+		BuildVCpuCount:     buildVCpuCount,
+		BuildMemoryMB:      buildMemoryMB,
 	}
 
 	err = setTemplateSource(ctx, tm, teamID, template, fromImage, fromTemplate)
@@ -209,6 +222,46 @@ func convertTemplateSteps(steps *[]api.TemplateStep) []*templatemanagergrpc.Temp
 			Args:      args,
 			FilesHash: step.FilesHash,
 			Force:     step.Force,
+			FromStage: step.FromStage,
+			Mounts:    convertTemplateStepMounts(step.Mounts),
+
+			TimeoutSeconds: step.Timeout,
+			VCpuCount:      step.CpuCount,
+			MemoryMB:       step.MemoryMB,
+		}
+	}
+	return result
+}
+
+func convertTemplateStepMounts(mounts *[]api.TemplateStepMount) []*templatemanagergrpc.TemplateStepMount {
+	if mounts == nil {
+		return nil
+	}
+
+	result := make([]*templatemanagergrpc.TemplateStepMount, len(*mounts))
+	for i, mount := range *mounts {
+		result[i] = &templatemanagergrpc.TemplateStepMount{
+			Type:     string(mount.Type),
+			Id:       ut.FromPtr(mount.Id),
+			Target:   ut.FromPtr(mount.Target),
+			Env:      mount.Env,
+			Required: ut.FromPtr(mount.Required),
+		}
+	}
+	return result
+}
+
+func convertTemplateStages(stages *[]api.TemplateBuildStage) []*templatemanagergrpc.TemplateStage {
+	if stages == nil {
+		return nil
+	}
+
+	result := make([]*templatemanagergrpc.TemplateStage, len(*stages))
+	for i, stage := range *stages {
+		result[i] = &templatemanagergrpc.TemplateStage{
+			Name:      stage.Name,
+			FromImage: stage.FromImage,
+			Steps:     convertTemplateSteps(stage.Steps),
 		}
 	}
 	return result
@@ -270,6 +323,27 @@ func convertImageRegistry(registry *api.FromImageRegistry) (*templatemanagergrpc
 	}
 }
 
+func (tm *TemplateManager) getBaseTemplate(ctx context.Context, fromTemplate string) (queries.GetTemplateWithBuildRow, error) {
+	aliasOrEnvID, tag, err := id.ParseTemplateTag(fromTemplate)
+	if err != nil {
+		return queries.GetTemplateWithBuildRow{}, err
+	}
+
+	if tag == "" {
+		return tm.sqlcDB.GetTemplateWithBuild(ctx, aliasOrEnvID)
+	}
+
+	baseTemplate, err := tm.sqlcDB.GetTemplateWithBuildByTag(ctx, queries.GetTemplateWithBuildByTagParams{
+		AliasOrEnvID: aliasOrEnvID,
+		Tag:          tag,
+	})
+	if err != nil {
+		return queries.GetTemplateWithBuildRow{}, err
+	}
+
+	return queries.GetTemplateWithBuildRow(baseTemplate), nil
+}
+
 // setTemplateSource sets the source (either fromImage or fromTemplate)
 func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUID, template *templatemanagergrpc.TemplateConfig, fromImage *string, fromTemplate *string) error {
 	// hasImage can be empty for v1 template builds
@@ -283,8 +357,8 @@ func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUI
 	case !hasImage && !hasTemplate:
 		return fmt.Errorf("must specify either fromImage or fromTemplate")
 	case hasTemplate:
-		// Look up the base template by alias to get its metadata
-		baseTemplate, err := tm.sqlcDB.GetTemplateWithBuild(ctx, *fromTemplate)
+		// Look up the base template by alias to get its metadata, the alias can reference a tagged build
+		baseTemplate, err := tm.getBaseTemplate(ctx, *fromTemplate)
 		if err != nil {
 			return &FromTemplateError{
 				err:     err,
@@ -299,6 +373,13 @@ func setTemplateSource(ctx context.Context, tm *TemplateManager, teamID uuid.UUI
 			}
 		}
 
+		if baseTemplate.EnvBuild.CpuArchitecture != consts.CPUArchitectureOrDefault(template.CpuArchitecture) {
+			return &FromTemplateError{
+				err:     nil,
+				message: fmt.Sprintf("base template '%s' is built for the %s architecture", *fromTemplate, baseTemplate.EnvBuild.CpuArchitecture),
+			}
+		}
+
 		template.Source = &templatemanagergrpc.TemplateConfig_FromTemplate{
 			FromTemplate: &templatemanagergrpc.FromTemplateConfig{
 				Alias:   *fromTemplate,
@@ -0,0 +1,43 @@
+package template_manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// ExportBuild starts the export of the template build as an OCI image tarball, the tarball is streamed in chunks.
+// The export reads only the template storage, so it can run on any builder in the cluster.
+func (tm *TemplateManager) ExportBuild(ctx context.Context, clusterID uuid.UUID, templateID string, buildID uuid.UUID, cpuArchitecture string, tag *string) (templatemanagergrpc.TemplateService_TemplateBuildExportClient, error) {
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available build client: %w", err)
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build client for template '%s': %w", templateID, err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	stream, err := client.GRPC.Client.Template.TemplateBuildExport(
+		reqCtx, &templatemanagergrpc.TemplateBuildExportRequest{
+			TemplateID:      templateID,
+			BuildID:         buildID.String(),
+			CpuArchitecture: cpuArchitecture,
+			Tag:             tag,
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export template build '%s': %w", buildID, err)
+	}
+
+	return stream, nil
+}
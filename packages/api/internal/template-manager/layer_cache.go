@@ -0,0 +1,71 @@
+package template_manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	ut "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// ListCachedLayers returns the layers cached by the template builds, the cache index is shared by the builders in the cluster.
+func (tm *TemplateManager) ListCachedLayers(ctx context.Context, clusterID uuid.UUID, teamID uuid.UUID, templateID string) ([]*template_manager.TemplateLayerCacheEntry, error) {
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available build client: %w", err)
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build client for template '%s': %w", templateID, err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	resp, err := client.GRPC.Client.Template.TemplateLayerCacheList(
+		reqCtx, &template_manager.TemplateLayerCacheListRequest{
+			CacheScope: ut.ToPtr(teamID.String()),
+			TemplateID: templateID,
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cached layers for template '%s': %w", templateID, err)
+	}
+
+	return resp.GetLayers(), nil
+}
+
+// InvalidateCachedLayers removes the layers from the build cache and returns the hashes of the removed layers.
+// All the layers of the template are removed if no hashes are given.
+func (tm *TemplateManager) InvalidateCachedLayers(ctx context.Context, clusterID uuid.UUID, teamID uuid.UUID, templateID string, hashes []string) ([]string, error) {
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available build client: %w", err)
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build client for template '%s': %w", templateID, err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	resp, err := client.GRPC.Client.Template.TemplateLayerCacheInvalidate(
+		reqCtx, &template_manager.TemplateLayerCacheInvalidateRequest{
+			CacheScope: ut.ToPtr(teamID.String()),
+			TemplateID: templateID,
+			Hashes:     hashes,
+		},
+	)
+
+	err = utils.UnwrapGRPCError(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invalidate cached layers for template '%s': %w", templateID, err)
+	}
+
+	return resp.GetHashes(), nil
+}
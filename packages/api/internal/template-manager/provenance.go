@@ -0,0 +1,227 @@
+package template_manager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	ut "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// BuildDefinition is the stored definition of the v2 template build.
+type BuildDefinition struct {
+	FromImage    *string
+	FromTemplate *string
+	Steps        *[]api.TemplateStep
+	Stages       *[]api.TemplateBuildStage
+}
+
+// GetBuildProvenance returns the provenance JSON document of the template build.
+// The document is read from the template storage, so it can be read by any builder in the cluster.
+func (tm *TemplateManager) GetBuildProvenance(ctx context.Context, clusterID uuid.UUID, templateID string, buildID uuid.UUID) ([]byte, *api.APIError) {
+	client, apiErr := tm.getAnyBuildClient(ctx, clusterID, templateID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	res, err := client.GRPC.Client.Template.TemplateBuildProvenance(
+		reqCtx, &templatemanagergrpc.TemplateBuildProvenanceRequest{
+			TemplateID: templateID,
+			BuildID:    buildID.String(),
+		},
+	)
+	if err != nil {
+		return nil, documentError(err, fmt.Sprintf("Build '%s' has no provenance, it was built before the provenance was recorded", buildID), "Error when getting template build provenance")
+	}
+
+	return res.GetProvenance(), nil
+}
+
+// GetBuildVerification returns the verification JSON document of the last rebuild of the template build.
+func (tm *TemplateManager) GetBuildVerification(ctx context.Context, clusterID uuid.UUID, templateID string, buildID uuid.UUID) ([]byte, *api.APIError) {
+	client, apiErr := tm.getAnyBuildClient(ctx, clusterID, templateID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	res, err := client.GRPC.Client.Template.TemplateBuildVerification(
+		reqCtx, &templatemanagergrpc.TemplateBuildVerificationRequest{
+			TemplateID: templateID,
+			BuildID:    buildID.String(),
+		},
+	)
+	if err != nil {
+		return nil, documentError(err, fmt.Sprintf("Build '%s' was not verified", buildID), "Error when getting template build verification")
+	}
+
+	return res.GetVerification(), nil
+}
+
+// VerifyBuild starts the rebuild of the template build from its stored definition.
+// The builder pins the base image and the base template to the ones the original build used.
+func (tm *TemplateManager) VerifyBuild(
+	ctx context.Context,
+	teamID uuid.UUID,
+	clusterID uuid.UUID,
+	templateID string,
+	build queries.EnvBuild,
+	definition BuildDefinition,
+	fromImageRegistry *api.FromImageRegistry,
+	secrets map[string]string,
+) *api.APIError {
+	features, err := sandbox.NewVersionInfo(build.FirecrackerVersion)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when verifying template build",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", build.FirecrackerVersion, err),
+		}
+	}
+
+	imageRegistry, err := convertImageRegistry(fromImageRegistry)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("Invalid image registry: %s", err),
+			Err:       fmt.Errorf("failed to convert image registry: %w", err),
+		}
+	}
+
+	template := &templatemanagergrpc.TemplateConfig{
+		TeamID:             teamID.String(),
+		TemplateID:         templateID,
+		BuildID:            build.ID.String(),
+		VCpuCount:          int32(build.Vcpu),
+		MemoryMB:           int32(build.RamMb),
+		DiskSizeMB:         int32(build.FreeDiskSizeMb),
+		KernelVersion:      build.KernelVersion,
+		FirecrackerVersion: build.FirecrackerVersion,
+		HugePages:          features.HasHugePages(),
+		StartCommand:       ut.FromPtr(build.StartCmd),
+		ReadyCommand:       ut.FromPtr(build.ReadyCmd),
+		Steps:              convertTemplateSteps(definition.Steps),
+		Stages:             convertTemplateStages(definition.Stages),
+		Secrets:            secrets,
+		CpuArchitecture:    build.CpuArchitecture,
+		FromImageRegistry:  imageRegistry,
+	}
+
+	// The base template build is pinned by the builder to the build from the provenance
+	if definition.FromTemplate != nil && *definition.FromTemplate != "" {
+		template.Source = &templatemanagergrpc.TemplateConfig_FromTemplate{
+			FromTemplate: &templatemanagergrpc.FromTemplateConfig{
+				Alias: *definition.FromTemplate,
+			},
+		}
+	} else {
+		template.Source = &templatemanagergrpc.TemplateConfig_FromImage{
+			FromImage: ut.FromPtr(definition.FromImage),
+		}
+	}
+
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID, build.CpuArchitecture)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when verifying template build",
+			Err:       fmt.Errorf("failed to get available build client: %w", err),
+		}
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when verifying template build",
+			Err:       fmt.Errorf("failed to get build client for template '%s': %w", templateID, err),
+		}
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, client.GRPC.Metadata)
+	_, err = client.GRPC.Client.Template.TemplateBuildVerify(
+		reqCtx, &templatemanagergrpc.TemplateBuildVerifyRequest{
+			Template:   template,
+			CacheScope: ut.ToPtr(teamID.String()),
+		},
+	)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Build '%s' can't be verified, it was built before the provenance was recorded", build.ID),
+				Err:       fmt.Errorf("failed to verify template build '%s': %w", build.ID, utils.UnwrapGRPCError(err)),
+			}
+		case codes.AlreadyExists:
+			return &api.APIError{
+				Code:      http.StatusConflict,
+				ClientMsg: fmt.Sprintf("Build '%s' is already being verified", build.ID),
+				Err:       fmt.Errorf("failed to verify template build '%s': %w", build.ID, utils.UnwrapGRPCError(err)),
+			}
+		case codes.FailedPrecondition:
+			return &api.APIError{
+				Code:      http.StatusBadRequest,
+				ClientMsg: fmt.Sprintf("Build '%s' can't be verified: %s", build.ID, status.Convert(err).Message()),
+				Err:       fmt.Errorf("failed to verify template build '%s': %w", build.ID, utils.UnwrapGRPCError(err)),
+			}
+		}
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when verifying template build",
+			Err:       fmt.Errorf("failed to verify template build '%s': %w", build.ID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	return nil
+}
+
+func (tm *TemplateManager) getAnyBuildClient(ctx context.Context, clusterID uuid.UUID, templateID string) (*BuildClient, *api.APIError) {
+	nodeID, err := tm.GetAvailableBuildClient(ctx, clusterID, "")
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting template build",
+			Err:       fmt.Errorf("failed to get available build client: %w", err),
+		}
+	}
+
+	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting template build",
+			Err:       fmt.Errorf("failed to get build client for template '%s': %w", templateID, err),
+		}
+	}
+
+	return client, nil
+}
+
+func documentError(err error, notFoundMsg string, clientMsg string) *api.APIError {
+	if status.Code(err) == codes.NotFound {
+		return &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: notFoundMsg,
+			Err:       utils.UnwrapGRPCError(err),
+		}
+	}
+
+	return &api.APIError{
+		Code:      http.StatusInternalServerError,
+		ClientMsg: clientMsg,
+		Err:       utils.UnwrapGRPCError(err),
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -57,6 +58,9 @@ const (
 	syncInterval = time.Minute * 1
 )
 
+// ErrBuildTagged is returned when deleting a build referenced by a tag, the tag has to be moved or removed first.
+var ErrBuildTagged = errors.New("build is tagged")
+
 func New(
 	config cfg.Config,
 	tracerProvider trace.TracerProvider,
@@ -121,13 +125,14 @@ func (tm *TemplateManager) BuildsStatusPeriodicalSync(ctx context.Context) {
 	}
 }
 
-func (tm *TemplateManager) GetAvailableBuildClient(ctx context.Context, clusterID uuid.UUID) (string, error) {
+// GetAvailableBuildClient returns the ID of a builder node with the architecture, an empty architecture matches any builder.
+func (tm *TemplateManager) GetAvailableBuildClient(ctx context.Context, clusterID uuid.UUID, cpuArchitecture string) (string, error) {
 	cluster, ok := tm.edgePool.GetClusterById(clusterID)
 	if !ok {
 		return "", fmt.Errorf("cluster with ID '%s' not found", clusterID)
 	}
 
-	builder, err := cluster.GetAvailableTemplateBuilder(ctx)
+	builder, err := cluster.GetAvailableTemplateBuilder(ctx, cpuArchitecture)
 	if err != nil {
 		return "", fmt.Errorf("failed to get available template builder for cluster '%s': %w", clusterID, err)
 	}
@@ -168,12 +173,22 @@ func (tm *TemplateManager) DeleteBuild(ctx context.Context, buildID uuid.UUID, t
 	)
 	defer span.End()
 
+	// Tagged builds are retained, so the tags always reference an existing build
+	tagged, err := tm.sqlcDB.IsEnvBuildTagged(ctx, buildID)
+	if err != nil {
+		return fmt.Errorf("failed to check tags of env build '%s': %w", buildID, err)
+	}
+
+	if tagged {
+		return fmt.Errorf("failed to delete env build '%s': %w", buildID, ErrBuildTagged)
+	}
+
 	client, err := tm.GetClusterBuildClient(clusterID, nodeID)
 	if err != nil {
 		// nodeID can be an orchestrator ID, if the build corresponds to a snapshot.
 		// We may want to improve this later by adding the Delete method to Orchestrator as well.
 		// This way we can remove the build (snapshot) from cache as well
-		nodeID, err = tm.GetAvailableBuildClient(ctx, clusterID)
+		nodeID, err = tm.GetAvailableBuildClient(ctx, clusterID, "")
 		if err != nil {
 			return fmt.Errorf("failed to get any available node in the cluster: %w", err)
 		}
@@ -204,6 +219,11 @@ func (tm *TemplateManager) DeleteBuild(ctx context.Context, buildID uuid.UUID, t
 func (tm *TemplateManager) DeleteBuilds(ctx context.Context, builds []DeleteBuild) error {
 	for _, build := range builds {
 		err := tm.DeleteBuild(ctx, build.BuildID, build.TemplateID, build.ClusterID, build.NodeID)
+		if errors.Is(err, ErrBuildTagged) {
+			zap.L().Info("Skipping deletion of tagged env build", logger.WithBuildID(build.BuildID.String()), logger.WithTemplateID(build.TemplateID))
+			continue
+		}
+
 		if err != nil {
 			return fmt.Errorf("failed to delete env build '%s': %w", build.BuildID, err)
 		}
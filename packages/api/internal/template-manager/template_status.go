@@ -262,6 +262,10 @@ func (tm *TemplateManager) SetStatus(ctx context.Context, templateID string, bui
 	})
 
 	tm.buildCache.SetStatus(buildID, status, buildReason)
+	if err == nil && status == envbuild.StatusFailed {
+		go tm.notifyBuildWebhook(context.WithoutCancel(ctx), templateID, buildID, status, buildReason)
+	}
+
 	return err
 }
 
@@ -276,6 +280,7 @@ func (tm *TemplateManager) SetFinished(ctx context.Context, templateID string, b
 	}
 
 	tm.buildCache.SetStatus(buildID, envbuild.StatusUploaded, types.BuildReason{})
+	go tm.notifyBuildWebhook(context.WithoutCancel(ctx), templateID, buildID, envbuild.StatusUploaded, types.BuildReason{})
 
 	return nil
 }
@@ -0,0 +1,195 @@
+package template_manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/db/dberrors"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+const (
+	// WebhookSignatureHeader contains the HMAC-SHA256 signature of the webhook body, if the webhook has a secret.
+	WebhookSignatureHeader = "X-Webhook-Signature"
+
+	webhookTimeout  = 10 * time.Second
+	webhookAttempts = 3
+	webhookBackoff  = 2 * time.Second
+)
+
+var webhookClient = newWebhookClient()
+
+// sharedAddressSpace is the carrier-grade NAT range, it's used for the internal networks by some cloud providers.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// newWebhookClient returns the client that connects only to the public addresses and doesn't follow redirects.
+// The address is checked when the connection is made, so the check can't be bypassed by a DNS change after the validation.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: webhookDialControl,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The proxy would connect to the webhook instead of the checked dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   webhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address '%s': %w", address, err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address '%s': %w", address, err)
+	}
+
+	if !IsWebhookAddrAllowed(addr) {
+		return fmt.Errorf("webhook address '%s' is not public", addr)
+	}
+
+	return nil
+}
+
+// IsWebhookAddrAllowed returns whether the webhook can be sent to the address, only the public addresses are allowed.
+// The loopback, private, link-local (including the metadata endpoint) and multicast addresses are rejected.
+func IsWebhookAddrAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsUnspecified() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// notifyBuildWebhook calls the webhook of the build with the final build status, if the build has one.
+// The delivery is retried, the receiver has to handle duplicate events.
+func (tm *TemplateManager) notifyBuildWebhook(ctx context.Context, templateID string, buildID uuid.UUID, status envbuild.Status, reason types.BuildReason) {
+	l := zap.L().With(logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()))
+
+	webhook, err := tm.sqlcDB.GetEnvBuildWebhook(ctx, buildID)
+	if err != nil {
+		if !dberrors.IsNotFoundError(err) {
+			l.Error("error when getting build webhook", zap.Error(err))
+		}
+
+		return
+	}
+
+	event := api.TemplateBuildWebhookEvent{
+		TemplateID: templateID,
+		BuildID:    buildID.String(),
+		Status:     api.TemplateBuildWebhookEventStatusReady,
+		Timestamp:  time.Now(),
+	}
+	if status == envbuild.StatusFailed {
+		event.Status = api.TemplateBuildWebhookEventStatusError
+		event.Reason = &api.BuildStatusReason{
+			Message: reason.Message,
+			Step:    reason.Step,
+		}
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		l.Error("error when marshalling build webhook event", zap.Error(err))
+
+		return
+	}
+
+	var secret string
+	if webhook.Secret != nil {
+		secret = *webhook.Secret
+	}
+
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		retry, err := sendWebhook(ctx, webhookClient, webhook.Url, secret, body)
+		if err == nil {
+			l.Debug("build webhook delivered", zap.Int("attempt", attempt))
+
+			return
+		}
+
+		l.Warn("error when delivering build webhook", zap.Error(err), zap.Int("attempt", attempt))
+		if !retry {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(attempt) * webhookBackoff):
+		}
+	}
+}
+
+// sendWebhook posts the body to the URL, it returns whether the failed request should be retried.
+func sendWebhook(ctx context.Context, client *http.Client, url string, secret string, body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	// Client errors are not retried, except for the rate limiting
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+
+	return retry, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 signature of the webhook body.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,103 @@
+package template_manager
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWebhook(t *testing.T) {
+	body := []byte(`{"status":"ready"}`)
+
+	var received []byte
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(WebhookSignatureHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	retry, err := sendWebhook(t.Context(), server.Client(), server.URL, "secret", body)
+	require.NoError(t, err)
+	assert.False(t, retry)
+	assert.Equal(t, body, received)
+	assert.Equal(t, "sha256="+SignWebhook("secret", body), signature)
+}
+
+func TestSendWebhook_Retry(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		retry  bool
+	}{
+		{name: "server error", status: http.StatusInternalServerError, retry: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, retry: true},
+		{name: "client error", status: http.StatusNotFound, retry: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, r.Header.Get(WebhookSignatureHeader))
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			retry, err := sendWebhook(t.Context(), server.Client(), server.URL, "", []byte("{}"))
+			require.Error(t, err)
+			assert.Equal(t, tc.retry, retry)
+		})
+	}
+}
+
+func TestSendWebhook_PrivateAddress(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The test server listens on the loopback
+	_, err := sendWebhook(t.Context(), webhookClient, server.URL, "", []byte("{}"))
+	require.Error(t, err)
+	assert.False(t, called)
+}
+
+func TestSendWebhook_Redirect(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	client := server.Client()
+	client.CheckRedirect = webhookClient.CheckRedirect
+
+	retry, err := sendWebhook(t.Context(), client, server.URL, "", []byte("{}"))
+	require.Error(t, err)
+	assert.False(t, retry)
+	assert.False(t, redirected)
+}
+
+func TestIsWebhookAddrAllowed(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "fd00:ec2::254", "fe80::1", "::ffff:127.0.0.1"} {
+		assert.False(t, IsWebhookAddrAllowed(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{"1.1.1.1", "8.8.8.8", "2606:4700:4700::1111"} {
+		assert.True(t, IsWebhookAddrAllowed(netip.MustParseAddr(addr)), addr)
+	}
+}
@@ -0,0 +1,577 @@
+package dockerfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+// Template is the template build described by the Dockerfile.
+type Template struct {
+	FromImage string
+	Steps     []api.TemplateStep
+	// Stages are the earlier stages of a multi-stage Dockerfile the template copies files from.
+	Stages []api.TemplateBuildStage
+	// StartCmd is the command built from the CMD and ENTRYPOINT instructions, nil if none of them is set.
+	StartCmd *string
+}
+
+// Error is returned for Dockerfiles that can't be translated into the template build steps.
+type Error struct {
+	Line int
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("dockerfile line %d: %s", e.Line, e.Msg)
+}
+
+// instruction is one logical line of the Dockerfile, with the line continuations joined.
+type instruction struct {
+	line    int
+	keyword string
+	rest    string
+}
+
+var heredocRegex = regexp.MustCompile(`(^|\s)<<-?["']?[A-Za-z_]`)
+
+// Parse translates the Dockerfile into the template build steps.
+// The files used by COPY are taken from the build context uploaded with the contextHash.
+// The last stage of the Dockerfile is the template, only the earlier stages it copies files from are built.
+func Parse(dockerfile string, buildArgs map[string]string, contextHash *string) (*Template, error) {
+	instructions, err := split(dockerfile)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		buildArgs:   buildArgs,
+		contextHash: contextHash,
+		globalArgs:  make(map[string]string),
+	}
+
+	for _, inst := range instructions {
+		err := p.apply(inst)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.stages) == 0 {
+		return nil, &Error{Line: len(strings.Split(dockerfile, "\n")), Msg: "missing FROM instruction"}
+	}
+
+	last := p.stages[len(p.stages)-1]
+
+	return &Template{
+		FromImage: last.fromImage,
+		Steps:     last.steps,
+		Stages:    p.usedStages(last),
+		StartCmd:  last.startCmd(),
+	}, nil
+}
+
+type parser struct {
+	buildArgs   map[string]string
+	contextHash *string
+
+	// globalArgs are the ARGs declared before the first FROM, they can be used only in FROM or re-declared after it.
+	globalArgs map[string]string
+
+	stages []*stage
+}
+
+// stage is one FROM section of the Dockerfile.
+type stage struct {
+	name      string
+	fromImage string
+	steps     []api.TemplateStep
+
+	// vars are the ARG and ENV values available to the instructions of the stage.
+	vars map[string]string
+	// copiesFrom are the names of the stages the files are copied from.
+	copiesFrom []string
+
+	entrypoint      *string
+	entrypointShell bool
+	cmd             *string
+}
+
+func (p *parser) current() *stage {
+	return p.stages[len(p.stages)-1]
+}
+
+func (p *parser) stage(name string) *stage {
+	for _, s := range p.stages {
+		if s.name == strings.ToLower(name) {
+			return s
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) apply(inst instruction) error {
+	fail := func(format string, a ...any) error {
+		return &Error{Line: inst.line, Msg: fmt.Sprintf(format, a...)}
+	}
+
+	if len(p.stages) == 0 && inst.keyword != "FROM" && inst.keyword != "ARG" {
+		return fail("%s before FROM", inst.keyword)
+	}
+
+	switch inst.keyword {
+	case "FROM":
+		flags, rest := parseFlags(inst.rest)
+		if len(flags) > 0 {
+			return fail("FROM flags are not supported")
+		}
+
+		args := strings.Fields(rest)
+
+		if len(args) != 1 && (len(args) != 3 || !strings.EqualFold(args[1], "AS")) {
+			return fail("FROM requires an image and an optional stage name")
+		}
+
+		image := expand(args[0], p.globalArgs)
+		if image == "scratch" {
+			return fail("FROM scratch is not supported")
+		}
+
+		if p.stage(image) != nil {
+			return fail("FROM an earlier stage is not supported")
+		}
+
+		// Unnamed stages are referenced by their index
+		name := strconv.Itoa(len(p.stages))
+		if len(args) == 3 {
+			name = strings.ToLower(args[2])
+		}
+
+		if p.stage(name) != nil {
+			return fail("duplicate stage name %s", name)
+		}
+
+		p.stages = append(p.stages, &stage{
+			name:      name,
+			fromImage: image,
+			vars:      make(map[string]string),
+		})
+	case "ARG":
+		args := splitWords(inst.rest)
+		if len(args) == 0 {
+			return fail("ARG requires a name")
+		}
+
+		for _, arg := range args {
+			name, value, hasValue := strings.Cut(arg, "=")
+
+			if len(p.stages) == 0 {
+				if v, ok := p.buildArgs[name]; ok {
+					p.globalArgs[name] = v
+				} else if hasValue {
+					p.globalArgs[name] = expand(value, p.globalArgs)
+				}
+
+				continue
+			}
+
+			s := p.current()
+			if v, ok := p.buildArgs[name]; ok {
+				value, hasValue = v, true
+			} else if hasValue {
+				value = expand(value, s.vars)
+			} else {
+				value, hasValue = p.globalArgs[name]
+			}
+
+			// ARGs without any value are not set
+			if !hasValue {
+				continue
+			}
+
+			s.vars[name] = value
+			s.addStep("ARG", name, value)
+		}
+	case "ENV":
+		pairs, err := parseEnv(inst.rest)
+		if err != nil {
+			return fail("%s", err)
+		}
+
+		s := p.current()
+		args := make([]string, 0, len(pairs)*2)
+		for _, pair := range pairs {
+			value := expand(pair[1], s.vars)
+			s.vars[pair[0]] = value
+
+			args = append(args, pair[0], value)
+		}
+
+		s.addStep("ENV", args...)
+	case "RUN":
+		return p.run(inst, fail)
+	case "COPY":
+		return p.copy(inst, fail)
+	case "ADD":
+		// ADD extracts the local archives, copying them as they are would build a different filesystem
+		return fail("ADD is not supported, use COPY")
+	case "WORKDIR":
+		s := p.current()
+		workdir := expand(strings.TrimSpace(inst.rest), s.vars)
+		if workdir == "" {
+			return fail("WORKDIR requires a path")
+		}
+
+		s.addStep("WORKDIR", workdir)
+	case "USER":
+		s := p.current()
+		user := expand(strings.TrimSpace(inst.rest), s.vars)
+		if user == "" {
+			return fail("USER requires a user")
+		}
+
+		// The template commands run with the primary group of the user
+		if strings.Contains(user, ":") {
+			return fail("USER with a group is not supported")
+		}
+
+		s.addStep("USER", user)
+	case "CMD":
+		command := parseCommand(inst.rest)
+		p.current().cmd = &command
+	case "ENTRYPOINT":
+		s := p.current()
+		command := parseCommand(inst.rest)
+		s.entrypoint = &command
+		s.entrypointShell = !isExecForm(inst.rest)
+	case "EXPOSE", "LABEL", "MAINTAINER", "STOPSIGNAL", "VOLUME":
+		// Metadata of the image, it doesn't change the template filesystem
+	case "SHELL", "ONBUILD", "HEALTHCHECK":
+		return fail("%s is not supported", inst.keyword)
+	default:
+		return fail("unknown instruction %s", inst.keyword)
+	}
+
+	return nil
+}
+
+// usedStages returns the stages the template copies files from, directly or through other stages, in the Dockerfile order.
+func (p *parser) usedStages(last *stage) []api.TemplateBuildStage {
+	used := make(map[string]bool)
+
+	var mark func(s *stage)
+	mark = func(s *stage) {
+		for _, name := range s.copiesFrom {
+			if used[name] {
+				continue
+			}
+
+			used[name] = true
+			mark(p.stage(name))
+		}
+	}
+	mark(last)
+
+	var stages []api.TemplateBuildStage
+	for _, s := range p.stages {
+		if !used[s.name] {
+			continue
+		}
+
+		steps := s.steps
+		stages = append(stages, api.TemplateBuildStage{
+			Name:      s.name,
+			FromImage: s.fromImage,
+			Steps:     &steps,
+		})
+	}
+
+	return stages
+}
+
+func (p *parser) copy(inst instruction, fail func(format string, a ...any) error) error {
+	s := p.current()
+	flags, rest := parseFlags(inst.rest)
+
+	var owner, permissions string
+	var from *stage
+	for _, f := range flags {
+		switch f.name {
+		case "chown":
+			owner = expand(f.value, s.vars)
+		case "chmod":
+			permissions = expand(f.value, s.vars)
+		case "from":
+			from = p.stage(expand(f.value, s.vars))
+			if from == nil || from == s {
+				return fail("%s --from an image or a later stage is not supported", inst.keyword)
+			}
+		case "link":
+			// Only changes how the layers are stored by Docker
+		default:
+			return fail("%s --%s is not supported", inst.keyword, f.name)
+		}
+	}
+
+	args := strings.Fields(rest)
+	if isExecForm(rest) {
+		err := json.Unmarshal([]byte(rest), &args)
+		if err != nil {
+			return fail("invalid JSON arguments: %s", err)
+		}
+	}
+
+	if len(args) < 2 {
+		return fail("%s requires a source and a destination", inst.keyword)
+	}
+
+	sources, target := args[:len(args)-1], expand(args[len(args)-1], s.vars)
+	if len(sources) > 1 && !strings.HasSuffix(target, "/") {
+		return fail("%s with multiple sources requires the destination to end with /", inst.keyword)
+	}
+
+	if from == nil && (p.contextHash == nil || *p.contextHash == "") {
+		return fail("%s requires the build context to be uploaded", inst.keyword)
+	}
+
+	for _, source := range sources {
+		source = expand(source, s.vars)
+
+		if strings.Contains(source, "://") || strings.HasPrefix(source, "git@") {
+			return fail("%s from remote sources is not supported", inst.keyword)
+		}
+
+		if strings.ContainsAny(source, "*?[") {
+			return fail("wildcards in %s sources are not supported", inst.keyword)
+		}
+
+		step := api.TemplateStep{
+			Type: "COPY",
+		}
+
+		if from != nil {
+			// Paths in the stage are relative to its root
+			source = path.Clean("/" + source)
+			step.FromStage = &from.name
+		} else {
+			source = path.Clean("/" + source)[1:]
+			if source == "" {
+				source = "."
+			}
+
+			step.FilesHash = p.contextHash
+		}
+
+		step.Args = &[]string{source, target, owner, permissions}
+		s.steps = append(s.steps, step)
+	}
+
+	if from != nil && !slices.Contains(s.copiesFrom, from.name) {
+		s.copiesFrom = append(s.copiesFrom, from.name)
+	}
+
+	return nil
+}
+
+func (p *parser) run(inst instruction, fail func(format string, a ...any) error) error {
+	s := p.current()
+	flags, rest := parseFlags(inst.rest)
+
+	var mounts []api.TemplateStepMount
+	for _, f := range flags {
+		if f.name != "mount" {
+			return fail("RUN --%s is not supported", f.name)
+		}
+
+		mount, err := parseMount(expand(f.value, s.vars))
+		if err != nil {
+			return fail("%s", err)
+		}
+
+		mounts = append(mounts, mount)
+	}
+
+	if heredocRegex.MatchString(rest) {
+		return fail("heredocs are not supported")
+	}
+
+	command := parseCommand(rest)
+	if command == "" {
+		return fail("RUN requires a command")
+	}
+
+	step := api.TemplateStep{
+		Type: "RUN",
+		Args: &[]string{command},
+	}
+	if len(mounts) > 0 {
+		step.Mounts = &mounts
+	}
+
+	s.steps = append(s.steps, step)
+
+	return nil
+}
+
+// parseMount returns the mount of the RUN --mount flag, only the secret and cache mounts are supported.
+func parseMount(value string) (api.TemplateStepMount, error) {
+	// Bind is the default mount type of Docker
+	mountType := "bind"
+	var id, target, env string
+	var required *bool
+
+	for _, option := range strings.Split(value, ",") {
+		key, val, hasValue := strings.Cut(option, "=")
+		switch strings.ToLower(key) {
+		case "type":
+			mountType = val
+		case "id":
+			id = val
+		case "target", "dst", "destination":
+			target = val
+		case "env":
+			env = val
+		case "required":
+			isRequired := !hasValue || strings.EqualFold(val, "true")
+			required = &isRequired
+		case "sharing":
+			// The cache is always shared, the last finished build saves it
+		default:
+			return api.TemplateStepMount{}, fmt.Errorf("RUN --mount option %s is not supported", key)
+		}
+	}
+
+	mount := api.TemplateStepMount{
+		Type:   api.TemplateStepMountType(mountType),
+		Target: optional(target),
+	}
+
+	switch mount.Type {
+	case api.Secret:
+		// Docker uses the file name of the target as the secret ID by default
+		if id == "" && target != "" {
+			id = path.Base(target)
+		}
+
+		if id == "" {
+			return api.TemplateStepMount{}, fmt.Errorf("RUN --mount=type=secret requires an id")
+		}
+
+		mount.Id = &id
+		mount.Env = optional(env)
+		mount.Required = required
+	case api.Cache:
+		if target == "" {
+			return api.TemplateStepMount{}, fmt.Errorf("RUN --mount=type=cache requires a target")
+		}
+
+		if env != "" || required != nil {
+			return api.TemplateStepMount{}, fmt.Errorf("RUN --mount=type=cache doesn't support the env and required options")
+		}
+
+		mount.Id = optional(id)
+	default:
+		return api.TemplateStepMount{}, fmt.Errorf("RUN --mount=type=%s is not supported", mountType)
+	}
+
+	return mount, nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func (s *stage) addStep(stepType string, args ...string) {
+	s.steps = append(s.steps, api.TemplateStep{
+		Type: stepType,
+		Args: &args,
+	})
+}
+
+// startCmd combines the ENTRYPOINT and CMD the same way Docker does, the shell form of ENTRYPOINT ignores CMD.
+func (s *stage) startCmd() *string {
+	switch {
+	case s.entrypoint != nil && (s.entrypointShell || s.cmd == nil):
+		return s.entrypoint
+	case s.entrypoint != nil:
+		command := *s.entrypoint + " " + *s.cmd
+
+		return &command
+	default:
+		return s.cmd
+	}
+}
+
+// split returns the instructions of the Dockerfile, comments and empty lines are skipped.
+func split(dockerfile string) ([]instruction, error) {
+	var instructions []instruction
+
+	var current strings.Builder
+	start := 0
+
+	lines := strings.Split(dockerfile, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "#") {
+			directive := strings.ToLower(strings.ReplaceAll(trimmed[1:], " ", ""))
+			if strings.HasPrefix(directive, "escape=") && directive != `escape=\` {
+				return nil, &Error{Line: i + 1, Msg: "only the \\ escape character is supported"}
+			}
+
+			continue
+		}
+
+		if trimmed == "" {
+			continue
+		}
+
+		if current.Len() == 0 {
+			start = i + 1
+		}
+
+		if strings.HasSuffix(line, `\`) {
+			current.WriteString(strings.TrimSuffix(line, `\`))
+
+			continue
+		}
+
+		current.WriteString(line)
+		instructions = append(instructions, newInstruction(start, current.String()))
+		current.Reset()
+	}
+
+	if current.Len() > 0 {
+		instructions = append(instructions, newInstruction(start, current.String()))
+	}
+
+	return instructions, nil
+}
+
+func newInstruction(line int, text string) instruction {
+	text = strings.TrimSpace(text)
+
+	keyword, rest := text, ""
+	if i := strings.IndexFunc(text, unicode.IsSpace); i >= 0 {
+		keyword, rest = text[:i], text[i:]
+	}
+
+	return instruction{
+		line:    line,
+		keyword: strings.ToUpper(keyword),
+		rest:    strings.TrimSpace(rest),
+	}
+}
@@ -0,0 +1,220 @@
+package dockerfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+func step(stepType string, filesHash *string, args ...string) api.TemplateStep {
+	return api.TemplateStep{Type: stepType, Args: &args, FilesHash: filesHash}
+}
+
+func TestParse(t *testing.T) {
+	contextHash := "context-hash"
+
+	dockerfile := `# syntax=docker/dockerfile:1
+ARG PYTHON_VERSION=3.11
+FROM python:${PYTHON_VERSION}-slim AS base
+
+ARG APP_DIR=/app
+ENV APP_HOME=$APP_DIR PATH="/opt/venv/bin:$PATH"
+ENV LEGACY value with spaces
+
+# Install the dependencies
+RUN apt-get update && \
+    # comment inside the continuation
+    apt-get install -y curl
+
+WORKDIR ${APP_HOME}
+COPY --chown=user:user requirements.txt ./
+COPY src/ main.py $APP_HOME/
+COPY ["config  dir", "/etc/app"]
+USER user
+EXPOSE 8080
+ENTRYPOINT ["python", "-m"]
+CMD ["app", "--port", "8080"]
+`
+
+	template, err := Parse(dockerfile, nil, &contextHash)
+	require.NoError(t, err)
+
+	assert.Equal(t, "python:3.11-slim", template.FromImage)
+	assert.Equal(t, []api.TemplateStep{
+		step("ARG", nil, "APP_DIR", "/app"),
+		step("ENV", nil, "APP_HOME", "/app", "PATH", "/opt/venv/bin:$PATH"),
+		step("ENV", nil, "LEGACY", "value with spaces"),
+		step("RUN", nil, "apt-get update &&     apt-get install -y curl"),
+		step("WORKDIR", nil, "/app"),
+		step("COPY", &contextHash, "requirements.txt", "./", "user:user", ""),
+		step("COPY", &contextHash, "src", "/app/", "", ""),
+		step("COPY", &contextHash, "main.py", "/app/", "", ""),
+		step("COPY", &contextHash, "config  dir", "/etc/app", "", ""),
+		step("USER", nil, "user"),
+	}, template.Steps)
+
+	require.NotNil(t, template.StartCmd)
+	assert.Equal(t, "python -m app --port 8080", *template.StartCmd)
+}
+
+func TestParseBuildArgs(t *testing.T) {
+	dockerfile := `ARG BASE=ubuntu:22.04
+FROM $BASE
+ARG BASE
+ARG VERSION=1
+ARG UNSET
+RUN echo $VERSION
+CMD echo "hello world"
+`
+
+	template, err := Parse(dockerfile, map[string]string{"BASE": "debian:12", "VERSION": "2"}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "debian:12", template.FromImage)
+	assert.Equal(t, []api.TemplateStep{
+		step("ARG", nil, "BASE", "debian:12"),
+		step("ARG", nil, "VERSION", "2"),
+		step("RUN", nil, "echo $VERSION"),
+	}, template.Steps)
+
+	require.NotNil(t, template.StartCmd)
+	assert.Equal(t, `echo "hello world"`, *template.StartCmd)
+}
+
+func TestParseMultiStage(t *testing.T) {
+	contextHash := "context-hash"
+
+	dockerfile := `ARG GO_VERSION=1.24
+FROM golang:${GO_VERSION} AS Build
+WORKDIR /src
+COPY . .
+RUN go build -o /out/app .
+
+FROM alpine AS unused
+RUN echo unused
+
+FROM ubuntu:22.04 AS assets
+COPY --from=build /out/app /tmp/app
+
+FROM ubuntu:22.04
+COPY --from=build out/app /usr/local/bin/app
+COPY --from=assets /tmp/app /usr/local/bin/app-copy
+CMD ["app"]
+`
+
+	template, err := Parse(dockerfile, nil, &contextHash)
+	require.NoError(t, err)
+
+	build, assets := "build", "assets"
+	fromStage := func(stage *string, args ...string) api.TemplateStep {
+		return api.TemplateStep{Type: "COPY", Args: &args, FromStage: stage}
+	}
+
+	assert.Equal(t, "ubuntu:22.04", template.FromImage)
+	assert.Equal(t, []api.TemplateStep{
+		fromStage(&build, "/out/app", "/usr/local/bin/app", "", ""),
+		fromStage(&assets, "/tmp/app", "/usr/local/bin/app-copy", "", ""),
+	}, template.Steps)
+
+	assert.Equal(t, []api.TemplateBuildStage{
+		{
+			Name:      "build",
+			FromImage: "golang:1.24",
+			Steps: &[]api.TemplateStep{
+				step("WORKDIR", nil, "/src"),
+				step("COPY", &contextHash, ".", ".", "", ""),
+				step("RUN", nil, "go build -o /out/app ."),
+			},
+		},
+		{
+			Name:      "assets",
+			FromImage: "ubuntu:22.04",
+			Steps: &[]api.TemplateStep{
+				fromStage(&build, "/out/app", "/tmp/app", "", ""),
+			},
+		},
+	}, template.Stages)
+
+	require.NotNil(t, template.StartCmd)
+	assert.Equal(t, "app", *template.StartCmd)
+}
+
+func TestParseRunMounts(t *testing.T) {
+	dockerfile := `FROM ubuntu:22.04
+ARG CACHE_DIR=/root/.cache/pip
+RUN --mount=type=cache,target=${CACHE_DIR},sharing=locked \
+    --mount=type=secret,id=token,env=TOKEN,required \
+    pip install -r requirements.txt
+RUN --mount=type=secret,target=/root/.npmrc npm ci
+`
+
+	template, err := Parse(dockerfile, nil, nil)
+	require.NoError(t, err)
+
+	cacheDir, token, npmrc, env, required := "/root/.cache/pip", "token", ".npmrc", "TOKEN", true
+	npmrcPath := "/root/.npmrc"
+
+	assert.Equal(t, []api.TemplateStep{
+		step("ARG", nil, "CACHE_DIR", "/root/.cache/pip"),
+		{
+			Type: "RUN",
+			Args: &[]string{"pip install -r requirements.txt"},
+			Mounts: &[]api.TemplateStepMount{
+				{Type: api.Cache, Target: &cacheDir},
+				{Type: api.Secret, Id: &token, Env: &env, Required: &required},
+			},
+		},
+		{
+			Type: "RUN",
+			Args: &[]string{"npm ci"},
+			Mounts: &[]api.TemplateStepMount{
+				{Type: api.Secret, Id: &npmrc, Target: &npmrcPath},
+			},
+		},
+	}, template.Steps)
+}
+
+func TestParseErrors(t *testing.T) {
+	contextHash := "context-hash"
+
+	for name, tc := range map[string]struct {
+		dockerfile string
+		line       int
+	}{
+		"missing FROM":         {dockerfile: "RUN echo", line: 1},
+		"from stage":           {dockerfile: "FROM ubuntu AS build\nRUN make\nFROM build\n", line: 3},
+		"duplicate stage":      {dockerfile: "FROM ubuntu AS build\nFROM ubuntu AS build\n", line: 2},
+		"copy from later":      {dockerfile: "FROM ubuntu AS build\nCOPY --from=build /app /app\n", line: 2},
+		"scratch":              {dockerfile: "FROM scratch", line: 1},
+		"unknown instruction":  {dockerfile: "FROM ubuntu\nFOO bar", line: 2},
+		"unsupported":          {dockerfile: "FROM ubuntu\nHEALTHCHECK CMD true", line: 2},
+		"run bind mount":       {dockerfile: "FROM ubuntu\nRUN --mount=type=bind,target=/src make", line: 2},
+		"run cache no target":  {dockerfile: "FROM ubuntu\nRUN --mount=type=cache pip install", line: 2},
+		"run secret no id":     {dockerfile: "FROM ubuntu\nRUN --mount=type=secret cat /run/secrets/token", line: 2},
+		"run network":          {dockerfile: "FROM ubuntu\nRUN --network=none make", line: 2},
+		"heredoc":              {dockerfile: "FROM ubuntu\nRUN <<EOF\necho\nEOF", line: 2},
+		"copy from image":      {dockerfile: "FROM ubuntu\nCOPY --from=nginx:latest /etc/nginx /etc/nginx", line: 2},
+		"wildcard":             {dockerfile: "FROM ubuntu\nCOPY *.json /app/", line: 2},
+		"remote copy":          {dockerfile: "FROM ubuntu\nCOPY https://example.com/file /file", line: 2},
+		"add":                  {dockerfile: "FROM ubuntu\nADD app.tar.gz /app/", line: 2},
+		"user group":           {dockerfile: "FROM ubuntu\nUSER user:staff", line: 2},
+		"multiple sources":     {dockerfile: "FROM ubuntu\nCOPY a b /app", line: 2},
+		"continued line error": {dockerfile: "FROM ubuntu\nRUN echo \\\n  ok\nSHELL [\"/bin/sh\"]", line: 4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tc.dockerfile, nil, &contextHash)
+
+			var parseErr *Error
+			require.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tc.line, parseErr.Line)
+		})
+	}
+
+	t.Run("copy without context", func(t *testing.T) {
+		_, err := Parse("FROM ubuntu\nCOPY a /app", nil, nil)
+		require.ErrorContains(t, err, "build context")
+	})
+}
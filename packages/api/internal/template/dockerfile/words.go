@@ -0,0 +1,221 @@
+package dockerfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type flag struct {
+	name  string
+	value string
+}
+
+// parseFlags splits the leading --name=value flags from the arguments of the instruction, the spacing of the arguments is kept.
+func parseFlags(rest string) ([]flag, string) {
+	rest = strings.TrimSpace(rest)
+
+	var flags []flag
+	for strings.HasPrefix(rest, "--") {
+		token := rest
+		if end := strings.IndexFunc(rest, unicode.IsSpace); end >= 0 {
+			token = rest[:end]
+		}
+
+		name, value, _ := strings.Cut(strings.TrimPrefix(token, "--"), "=")
+		flags = append(flags, flag{name: name, value: value})
+
+		rest = strings.TrimLeftFunc(rest[len(token):], unicode.IsSpace)
+	}
+
+	return flags, rest
+}
+
+// isExecForm reports whether the arguments are in the JSON array form.
+func isExecForm(rest string) bool {
+	var args []string
+
+	return strings.HasPrefix(strings.TrimSpace(rest), "[") && json.Unmarshal([]byte(rest), &args) == nil
+}
+
+// parseCommand returns the shell command of RUN, CMD or ENTRYPOINT, the exec form is quoted for the shell.
+func parseCommand(rest string) string {
+	if !isExecForm(rest) {
+		return strings.TrimSpace(rest)
+	}
+
+	var args []string
+	_ = json.Unmarshal([]byte(rest), &args)
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_./=:@%+,", r)
+	}) < 0 {
+		return s
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// splitWords splits the arguments on whitespace, the quotes are removed and the escaped characters are kept.
+func splitWords(rest string) []string {
+	var words []string
+
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+
+	for _, r := range rest {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words
+}
+
+// parseEnv returns the key and value pairs of ENV, both the key=value and the legacy "key value" forms are supported.
+func parseEnv(rest string) ([][2]string, error) {
+	words := splitWords(rest)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("ENV requires a key and a value")
+	}
+
+	if !strings.Contains(words[0], "=") {
+		key, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("ENV requires a value for %s", key)
+		}
+
+		return [][2]string{{key, value}}, nil
+	}
+
+	pairs := make([][2]string, 0, len(words))
+	for _, word := range words {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("ENV requires key=value pairs, got %q", word)
+		}
+
+		pairs = append(pairs, [2]string{key, value})
+	}
+
+	return pairs, nil
+}
+
+// expand substitutes the $VAR, ${VAR}, ${VAR:-default} and ${VAR:+alternative} variables.
+// Unknown variables are kept as they are, so they can still be evaluated in the sandbox.
+func expand(s string, vars map[string]string) string {
+	var out strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if c == '\\' && i+1 < len(s) && s[i+1] == '$' {
+			out.WriteByte('$')
+			i++
+
+			continue
+		}
+
+		if c != '$' || i+1 >= len(s) {
+			out.WriteByte(c)
+
+			continue
+		}
+
+		if s[i+1] == '{' {
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				out.WriteString(s[i:])
+
+				return out.String()
+			}
+
+			out.WriteString(expandBraces(s[i:i+end+1], vars))
+			i += end
+
+			continue
+		}
+
+		end := i + 1
+		for end < len(s) && (s[end] == '_' || unicode.IsLetter(rune(s[end])) || (end > i+1 && unicode.IsDigit(rune(s[end])))) {
+			end++
+		}
+
+		name := s[i+1 : end]
+		if value, ok := vars[name]; ok && name != "" {
+			out.WriteString(value)
+		} else {
+			out.WriteString(s[i:end])
+		}
+
+		i = end - 1
+	}
+
+	return out.String()
+}
+
+func expandBraces(variable string, vars map[string]string) string {
+	inner := variable[2 : len(variable)-1]
+
+	if name, word, ok := strings.Cut(inner, ":-"); ok {
+		if value, ok := vars[name]; ok && value != "" {
+			return value
+		}
+
+		return expand(word, vars)
+	}
+
+	if name, word, ok := strings.Cut(inner, ":+"); ok {
+		if value, ok := vars[name]; ok && value != "" {
+			return expand(word, vars)
+		}
+
+		return ""
+	}
+
+	if value, ok := vars[inner]; ok {
+		return value
+	}
+
+	return variable
+}
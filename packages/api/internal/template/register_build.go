@@ -43,6 +43,8 @@ type RegisterBuildData struct {
 	ReadyCmd      *string
 	CpuCount      *int32
 	MemoryMB      *int32
+	// CPUArchitecture of the template, the builder node must have the same architecture
+	CPUArchitecture string
 }
 
 type RegisterBuildResponse struct {
@@ -206,6 +208,7 @@ func RegisterBuild(
 		SetNillableStartCmd(data.StartCmd).
 		SetNillableReadyCmd(data.ReadyCmd).
 		SetClusterNodeID(data.BuilderNodeID).
+		SetCPUArchitecture(consts.CPUArchitectureOrDefault(data.CPUArchitecture)).
 		SetDockerfile(data.Dockerfile).
 		Save(ctx)
 	if err != nil {
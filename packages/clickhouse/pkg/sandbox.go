@@ -11,6 +11,12 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
+// The network metrics are newer than the shared version used by this module, the names match the shared telemetry gauges.
+const (
+	sandboxNetworkRxGaugeName = "e2b.sandbox.network.rx"
+	sandboxNetworkTxGaugeName = "e2b.sandbox.network.tx"
+)
+
 type Metrics struct {
 	SandboxID      string    `ch:"sandbox_id"`
 	TeamID         string    `ch:"team_id"`
@@ -21,6 +27,8 @@ type Metrics struct {
 	MemUsed        float64   `ch:"ram_used"`
 	DiskTotal      float64   `ch:"disk_total"`
 	DiskUsed       float64   `ch:"disk_used"`
+	NetworkRx      float64   `ch:"network_rx"`
+	NetworkTx      float64   `ch:"network_tx"`
 }
 
 var latestMetricsSelectQuery = fmt.Sprintf(`
@@ -32,6 +40,8 @@ SELECT sandbox_id,
        argMaxIf(value, timestamp, metric_name = '%s')  AS ram_used,
        argMaxIf(value, timestamp, metric_name = '%s')  AS disk_total,
        argMaxIf(value, timestamp, metric_name = '%s')  AS disk_used,
+       argMaxIf(value, timestamp, metric_name = '%s')  AS network_rx,
+       argMaxIf(value, timestamp, metric_name = '%s')  AS network_tx,
        -- All metrics are recorded at the same time, so we can use max(timestamp) to get the latest one
        max(timestamp) as ts
 FROM   sandbox_metrics_gauge
@@ -39,7 +49,7 @@ WHERE  sandbox_id IN ?
        AND team_id = ?
 GROUP  BY sandbox_id,
           team_id; 
-`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName)
+`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName, sandboxNetworkRxGaugeName, sandboxNetworkTxGaugeName)
 
 // QueryLatestMetrics returns rows ordered by timestamp, paged by limit.
 func (c *Client) QueryLatestMetrics(ctx context.Context, sandboxIDs []string, teamID string) ([]Metrics, error) {
@@ -83,7 +93,9 @@ SELECT   toStartOfInterval(timestamp, interval {step:UInt32} second) AS ts,
          maxIf(value, metric_name = '%s')         					 AS ram_total,
          maxIf(value, metric_name = '%s')          					 AS ram_used,
          maxIf(value, metric_name = '%s')        					 AS disk_total,
-         maxIf(value, metric_name = '%s')         					 AS disk_used
+         maxIf(value, metric_name = '%s')         					 AS disk_used,
+         maxIf(value, metric_name = '%s')         					 AS network_rx,
+         maxIf(value, metric_name = '%s')         					 AS network_tx
 FROM     sandbox_metrics_gauge s
 WHERE    sandbox_id = {sandbox_id:String}
 AND      team_id = {team_id:String}
@@ -91,7 +103,7 @@ AND      timestamp >= {start_time:DateTime64}
 AND      timestamp <= {end_time:DateTime64}
 GROUP BY ts
 ORDER BY ts;
-`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName)
+`, telemetry.SandboxCpuTotalGaugeName, telemetry.SandboxCpuUsedGaugeName, telemetry.SandboxRamTotalGaugeName, telemetry.SandboxRamUsedGaugeName, telemetry.SandboxDiskTotalGaugeName, telemetry.SandboxDiskUsedGaugeName, sandboxNetworkRxGaugeName, sandboxNetworkTxGaugeName)
 
 func (c *Client) QuerySandboxTimeRange(ctx context.Context, sandboxID string, teamID string) (time.Time, time.Time, error) {
 	var start, end time.Time
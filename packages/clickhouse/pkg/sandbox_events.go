@@ -28,6 +28,8 @@ const (
 	SandboxEventLabelResume SandboxEventLabel = "resume"
 	SandboxEventLabelUpdate SandboxEventLabel = "update"
 	SandboxEventLabelKill   SandboxEventLabel = "kill"
+
+	SandboxEventLabelProcessEnd SandboxEventLabel = "process_end"
 )
 
 type SandboxEvent struct {
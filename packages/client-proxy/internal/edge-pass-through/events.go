@@ -49,6 +49,7 @@ func (s *NodePassThroughServer) catalogCreateEventHandler(ctx context.Context, m
 			ExecutionID:             c.ExecutionID,
 			SandboxStartedAt:        c.SandboxStartTime,
 			SandboxMaxLengthInHours: c.SandboxMaxLengthInHours,
+			Ingress:                 c.Ingress,
 		},
 		time.Duration(c.SandboxMaxLengthInHours)*time.Hour,
 	)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 )
 
 type SandboxInfo struct {
@@ -12,6 +14,8 @@ type SandboxInfo struct {
 
 	SandboxStartedAt        time.Time `json:"sandbox_started_at"`          // when sandbox was started
 	SandboxMaxLengthInHours int64     `json:"sandbox_max_length_in_hours"` // how long can sandbox can possibly run (in hours)
+
+	Ingress *proxy.Ingress `json:"ingress,omitempty"` // inbound traffic policy, nil when all ports are public
 }
 
 type SandboxesCatalog interface {
@@ -82,22 +82,22 @@ func dnsResolution(sandboxId string, logger *zap.Logger) (string, error) {
 	return node, nil
 }
 
-func catalogResolution(ctx context.Context, sandboxId string, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool) (string, error) {
+func catalogResolution(ctx context.Context, sandboxId string, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool) (string, *reverseproxy.Ingress, error) {
 	s, err := catalog.GetSandbox(ctx, sandboxId)
 	if err != nil {
 		if errors.Is(err, sandboxes.ErrSandboxNotFound) {
-			return "", ErrNodeNotFound
+			return "", nil, ErrNodeNotFound
 		}
 
-		return "", fmt.Errorf("failed to get sandbox from catalog: %w", err)
+		return "", nil, fmt.Errorf("failed to get sandbox from catalog: %w", err)
 	}
 
 	o, ok := orchestrators.GetOrchestrator(s.OrchestratorID)
 	if !ok {
-		return "", errors.New("orchestrator not found")
+		return "", nil, errors.New("orchestrator not found")
 	}
 
-	return o.GetInfo().Ip, nil
+	return o.GetInfo().Ip, s.Ingress, nil
 }
 
 func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port uint, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool, useCatalogResolution bool, useDnsResolution bool) (*reverseproxy.Proxy, error) {
@@ -122,9 +122,11 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 			)
 
 			var nodeIP string
+			// Ingress is known only for the sandboxes in the catalog, the orchestrator proxy checks the policy for all sandboxes.
+			var ingress *reverseproxy.Ingress
 
 			if useCatalogResolution {
-				nodeIP, err = catalogResolution(r.Context(), sandboxId, catalog, orchestrators)
+				nodeIP, ingress, err = catalogResolution(r.Context(), sandboxId, catalog, orchestrators)
 				if err != nil {
 					if !errors.Is(err, ErrNodeNotFound) {
 						logger.Warn("failed to resolve node ip with Redis resolution", zap.Error(err))
@@ -154,6 +156,11 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 				}
 			}
 
+			err = ingress.Authorize(r, sandboxId, port)
+			if err != nil {
+				return nil, err
+			}
+
 			logger.Debug("Proxying request", zap.String("node_ip", nodeIP))
 
 			return &pool.Destination{
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."snapshot_checkpoints" (
+    id uuid NOT NULL DEFAULT gen_random_uuid(),
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    snapshot_id uuid NOT NULL,
+    build_id uuid NOT NULL,
+    name text NOT NULL,
+    CONSTRAINT snapshot_checkpoints_pkey PRIMARY KEY (id),
+    CONSTRAINT snapshot_checkpoints_snapshot_name_key UNIQUE (snapshot_id, name),
+    CONSTRAINT fk_snapshot_checkpoints_snapshot
+        FOREIGN KEY (snapshot_id)
+        REFERENCES "public"."snapshots"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT fk_snapshot_checkpoints_build
+        FOREIGN KEY (build_id)
+        REFERENCES "public"."env_builds"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."snapshot_checkpoints" ENABLE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."snapshot_checkpoints";
+-- +goose StatementEnd
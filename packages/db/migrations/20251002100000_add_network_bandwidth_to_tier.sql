@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Add network_bandwidth_mbps column to tiers table
+ALTER TABLE "public"."tiers" ADD COLUMN "network_bandwidth_mbps" bigint NOT NULL DEFAULT 0;
+
+-- Add check constraint for network_bandwidth_mbps
+ALTER TABLE "public"."tiers" ADD CONSTRAINT "tiers_network_bandwidth_mbps_check" CHECK (network_bandwidth_mbps >= 0);
+
+-- Add comment for the new column
+COMMENT ON COLUMN public.tiers.network_bandwidth_mbps
+    IS 'The outbound network bandwidth limit of the sandbox in Mbit/s, zero means unlimited';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Drop the constraint and column
+ALTER TABLE "public"."tiers" DROP CONSTRAINT IF EXISTS "tiers_network_bandwidth_mbps_check";
+ALTER TABLE "public"."tiers" DROP COLUMN IF EXISTS "network_bandwidth_mbps";
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."volumes" (
+    id uuid NOT NULL DEFAULT gen_random_uuid(),
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    team_id uuid NOT NULL,
+    name text NOT NULL,
+    size_mb bigint NOT NULL,
+    sandbox_id text,
+    mount_path text,
+    CONSTRAINT volumes_pkey PRIMARY KEY (id),
+    CONSTRAINT volumes_team_name_key UNIQUE (team_id, name),
+    CONSTRAINT volumes_size_mb_check CHECK (size_mb > 0),
+    CONSTRAINT fk_volumes_team
+        FOREIGN KEY (team_id)
+        REFERENCES "public"."teams"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS volumes_sandbox_id_idx ON "public"."volumes" (sandbox_id) WHERE sandbox_id IS NOT NULL;
+ALTER TABLE "public"."volumes" ENABLE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."volumes";
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."sandbox_schedules" (
+    id uuid NOT NULL DEFAULT gen_random_uuid(),
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    team_id uuid NOT NULL,
+    sandbox_id text NOT NULL,
+    action text NOT NULL,
+    cron text,
+    command text,
+    timeout integer NOT NULL,
+    next_run_at timestamp with time zone NOT NULL,
+    last_run_at timestamp with time zone,
+    last_error text,
+    CONSTRAINT sandbox_schedules_pkey PRIMARY KEY (id),
+    CONSTRAINT sandbox_schedules_action_check CHECK (action IN ('resume', 'pause', 'kill')),
+    CONSTRAINT sandbox_schedules_timeout_check CHECK (timeout > 0),
+    CONSTRAINT fk_sandbox_schedules_team
+        FOREIGN KEY (team_id)
+        REFERENCES "public"."teams"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS sandbox_schedules_next_run_at_idx ON "public"."sandbox_schedules" (next_run_at);
+CREATE INDEX IF NOT EXISTS sandbox_schedules_sandbox_idx ON "public"."sandbox_schedules" (team_id, sandbox_id);
+ALTER TABLE "public"."sandbox_schedules" ENABLE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."sandbox_schedules";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS idle_timeout bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS idle_timeout;
+-- +goose StatementEnd
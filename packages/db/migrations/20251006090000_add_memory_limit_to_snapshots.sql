@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS memory_limit_mb bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS memory_limit_mb;
+-- +goose StatementEnd
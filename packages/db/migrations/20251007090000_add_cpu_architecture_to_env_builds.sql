@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS cpu_architecture text NOT NULL DEFAULT 'amd64';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS cpu_architecture;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."env_build_tags" (
+    env_id text NOT NULL,
+    tag text NOT NULL,
+    build_id uuid NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT env_build_tags_pkey PRIMARY KEY (env_id, tag),
+    CONSTRAINT fk_env_build_tags_env
+        FOREIGN KEY (env_id)
+        REFERENCES "public"."envs"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE,
+    -- Tagged builds can't be deleted, the tag has to be moved or removed first
+    CONSTRAINT fk_env_build_tags_build
+        FOREIGN KEY (build_id)
+        REFERENCES "public"."env_builds"(id)
+        ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+CREATE INDEX IF NOT EXISTS env_build_tags_build_id_idx ON "public"."env_build_tags" (build_id);
+ALTER TABLE "public"."env_build_tags" ENABLE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."env_build_tags";
+-- +goose StatementEnd
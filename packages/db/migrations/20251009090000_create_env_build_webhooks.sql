@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."env_build_webhooks" (
+    build_id uuid NOT NULL,
+    url text NOT NULL,
+    secret text,
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT env_build_webhooks_pkey PRIMARY KEY (build_id),
+    CONSTRAINT fk_env_build_webhooks_build
+        FOREIGN KEY (build_id)
+        REFERENCES "public"."env_builds"(id)
+        ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."env_build_webhooks" ENABLE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."env_build_webhooks";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."sandbox_schedules"
+    ADD COLUMN IF NOT EXISTS exec_user text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."sandbox_schedules"
+    DROP COLUMN IF EXISTS exec_user;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshot_checkpoints"
+    ADD COLUMN IF NOT EXISTS allow_internet_access boolean NULL,
+    ADD COLUMN IF NOT EXISTS network jsonb NULL,
+    ADD COLUMN IF NOT EXISTS idle_timeout bigint NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS memory_limit_mb bigint NOT NULL DEFAULT 0;
+
+-- The config of the existing checkpoints wasn't saved, the latest config of the sandbox is the closest one
+UPDATE "public"."snapshot_checkpoints" c
+SET allow_internet_access = s.allow_internet_access,
+    network = s.network,
+    idle_timeout = s.idle_timeout,
+    memory_limit_mb = s.memory_limit_mb
+FROM "public"."snapshots" s
+WHERE c.snapshot_id = s.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshot_checkpoints"
+    DROP COLUMN IF EXISTS allow_internet_access,
+    DROP COLUMN IF EXISTS network,
+    DROP COLUMN IF EXISTS idle_timeout,
+    DROP COLUMN IF EXISTS memory_limit_mb;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- name: UpsertEnvBuildTag :one
+-- Creating an existing tag moves it to the build, this is how the tag is promoted or rolled back.
+INSERT INTO "public"."env_build_tags" (env_id, tag, build_id)
+VALUES (@env_id, @tag, @build_id)
+ON CONFLICT (env_id, tag) DO UPDATE SET build_id = EXCLUDED.build_id, updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetEnvBuildTags :many
+SELECT *
+FROM "public"."env_build_tags"
+WHERE env_id = @env_id
+ORDER BY tag;
+
+-- name: DeleteEnvBuildTag :execrows
+DELETE FROM "public"."env_build_tags"
+WHERE env_id = @env_id AND tag = @tag;
+
+-- name: IsEnvBuildTagged :one
+SELECT EXISTS (
+    SELECT 1
+    FROM "public"."env_build_tags"
+    WHERE build_id = @build_id
+) AS tagged;
+
+-- name: GetTemplateWithBuildByTag :one
+-- get the env_id when querying by alias; if not, @alias_or_env_id should be env_id
+WITH s AS NOT MATERIALIZED (
+    SELECT ea.env_id as env_id
+    FROM public.env_aliases as ea
+    WHERE ea.alias = @alias_or_env_id
+    UNION
+    SELECT @alias_or_env_id as env_id
+)
+
+SELECT sqlc.embed(e), sqlc.embed(eb), aliases
+FROM s
+JOIN public.envs AS e ON e.id = s.env_id
+JOIN public.env_build_tags AS ebt ON ebt.env_id = e.id
+AND ebt.tag = @tag
+JOIN public.env_builds AS eb ON eb.id = ebt.build_id
+AND eb.status = 'uploaded'
+CROSS JOIN LATERAL (
+    SELECT array_agg(alias)::text[] AS aliases
+    FROM public.env_aliases
+    WHERE env_id = e.id
+) AS al;
@@ -0,0 +1,9 @@
+-- name: UpsertEnvBuildWebhook :exec
+INSERT INTO "public"."env_build_webhooks" (build_id, url, secret)
+VALUES (@build_id, @url, sqlc.narg(secret))
+ON CONFLICT (build_id) DO UPDATE SET url = EXCLUDED.url, secret = EXCLUDED.secret;
+
+-- name: GetEnvBuildWebhook :one
+SELECT *
+FROM "public"."env_build_webhooks"
+WHERE build_id = @build_id;
@@ -12,7 +12,7 @@ import (
 )
 
 const getLastSnapshot = `-- name: GetLastSnapshot :one
-SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, s.origin_node_id, s.allow_internet_access, s.auto_pause, s.team_id, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.reason
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, s.origin_node_id, s.allow_internet_access, s.auto_pause, s.team_id, s.network, s.idle_timeout, s.memory_limit_mb, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.reason, eb.cpu_architecture
 FROM "public"."snapshots" s
 JOIN "public"."envs" e ON s.env_id  = e.id
 JOIN "public"."env_builds" eb ON e.id = eb.env_id
@@ -54,6 +54,9 @@ func (q *Queries) GetLastSnapshot(ctx context.Context, arg GetLastSnapshotParams
 		&i.Snapshot.AllowInternetAccess,
 		&i.Snapshot.AutoPause,
 		&i.Snapshot.TeamID,
+		&i.Snapshot.Network,
+		&i.Snapshot.IdleTimeout,
+		&i.Snapshot.MemoryLimitMb,
 		&i.EnvBuild.ID,
 		&i.EnvBuild.CreatedAt,
 		&i.EnvBuild.UpdatedAt,
@@ -72,6 +75,7 @@ func (q *Queries) GetLastSnapshot(ctx context.Context, arg GetLastSnapshotParams
 		&i.EnvBuild.ReadyCmd,
 		&i.EnvBuild.ClusterNodeID,
 		&i.EnvBuild.Reason,
+		&i.EnvBuild.CpuArchitecture,
 	)
 	return i, err
 }
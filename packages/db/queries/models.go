@@ -78,6 +78,37 @@ type EnvBuild struct {
 	ReadyCmd           *string
 	ClusterNodeID      string
 	Reason             types.BuildReason
+	CpuArchitecture    string
+}
+
+type EnvBuildTag struct {
+	EnvID     string
+	Tag       string
+	BuildID   uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type EnvBuildWebhook struct {
+	BuildID   uuid.UUID
+	Url       string
+	Secret    *string
+	CreatedAt time.Time
+}
+
+type SandboxSchedule struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	TeamID    uuid.UUID
+	SandboxID string
+	Action    string
+	Cron      *string
+	Command   *string
+	Timeout   int32
+	NextRunAt time.Time
+	LastRunAt *time.Time
+	LastError *string
+	ExecUser  *string
 }
 
 type Snapshot struct {
@@ -93,6 +124,21 @@ type Snapshot struct {
 	AllowInternetAccess *bool
 	AutoPause           bool
 	TeamID              uuid.UUID
+	Network             *types.SandboxNetworkConfig
+	IdleTimeout         int64
+	MemoryLimitMb       int64
+}
+
+type SnapshotCheckpoint struct {
+	ID                  uuid.UUID
+	CreatedAt           time.Time
+	SnapshotID          uuid.UUID
+	BuildID             uuid.UUID
+	Name                string
+	AllowInternetAccess *bool
+	Network             *types.SandboxNetworkConfig
+	IdleTimeout         int64
+	MemoryLimitMb       int64
 }
 
 type Team struct {
@@ -134,6 +180,8 @@ type Tier struct {
 	MaxRamMb            int64
 	// The number of concurrent template builds the team can run
 	ConcurrentTemplateBuilds int64
+	// The outbound network bandwidth limit of the sandbox in Mbit/s, zero means unlimited
+	NetworkBandwidthMbps int64
 }
 
 type UsersTeam struct {
@@ -144,3 +192,13 @@ type UsersTeam struct {
 	AddedBy   *uuid.UUID
 	CreatedAt pgtype.Timestamp
 }
+
+type Volume struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	TeamID    uuid.UUID
+	Name      string
+	SizeMb    int64
+	SandboxID *string
+	MountPath *string
+}
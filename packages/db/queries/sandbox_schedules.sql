@@ -0,0 +1,37 @@
+-- name: CreateSandboxSchedule :one
+INSERT INTO "public"."sandbox_schedules" (team_id, sandbox_id, action, cron, command, exec_user, timeout, next_run_at)
+VALUES (@team_id, @sandbox_id, @action, sqlc.narg(cron), sqlc.narg(command), sqlc.narg(exec_user), @timeout, @next_run_at)
+RETURNING *;
+
+-- name: GetSandboxSchedules :many
+SELECT *
+FROM "public"."sandbox_schedules"
+WHERE sandbox_id = @sandbox_id AND team_id = @team_id
+ORDER BY created_at;
+
+-- name: DeleteSandboxSchedule :execrows
+DELETE FROM "public"."sandbox_schedules"
+WHERE id = @id AND sandbox_id = @sandbox_id AND team_id = @team_id;
+
+-- name: DeleteSandboxSchedules :exec
+DELETE FROM "public"."sandbox_schedules"
+WHERE sandbox_id = @sandbox_id AND team_id = @team_id;
+
+-- name: ClaimDueSandboxSchedules :many
+-- The claimed schedules are moved to the lease end, so they are not picked by other API instances and run again if the instance running them dies.
+UPDATE "public"."sandbox_schedules"
+SET next_run_at = @lease_until
+WHERE id IN (
+    SELECT id
+    FROM "public"."sandbox_schedules"
+    WHERE next_run_at <= @now
+    ORDER BY next_run_at
+    LIMIT @max_count
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: FinishSandboxSchedule :exec
+UPDATE "public"."sandbox_schedules"
+SET next_run_at = @next_run_at, last_run_at = @last_run_at, last_error = sqlc.narg(last_error)
+WHERE id = @id;
@@ -0,0 +1,31 @@
+-- name: CreateSnapshotCheckpoint :one
+INSERT INTO "public"."snapshot_checkpoints" (snapshot_id, build_id, name, allow_internet_access, network, idle_timeout, memory_limit_mb)
+SELECT s.id, @build_id::uuid, @name::text, s.allow_internet_access, s.network, s.idle_timeout, s.memory_limit_mb
+FROM "public"."snapshots" s
+WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id
+ON CONFLICT (snapshot_id, name) DO NOTHING
+RETURNING *;
+
+-- name: GetSnapshotCheckpoints :many
+SELECT c.*
+FROM "public"."snapshot_checkpoints" c
+JOIN "public"."snapshots" s ON c.snapshot_id = s.id
+WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id
+ORDER BY c.created_at DESC;
+
+-- name: GetSnapshotCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, sqlc.embed(c), sqlc.embed(s), sqlc.embed(eb)
+FROM "public"."snapshot_checkpoints" c
+JOIN "public"."snapshots" s ON c.snapshot_id = s.id
+JOIN "public"."env_builds" eb ON c.build_id = eb.id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = s.base_env_id
+) ea ON TRUE
+WHERE s.sandbox_id = @sandbox_id AND s.team_id = @team_id AND c.name = @name;
+
+-- name: DeleteSnapshotCheckpoint :execrows
+DELETE FROM "public"."snapshot_checkpoints" c
+USING "public"."snapshots" s
+WHERE c.snapshot_id = s.id AND s.sandbox_id = @sandbox_id AND s.team_id = @team_id AND c.name = @name;
@@ -0,0 +1,38 @@
+-- name: CreateVolume :one
+INSERT INTO "public"."volumes" (team_id, name, size_mb)
+VALUES (@team_id, @name, @size_mb)
+ON CONFLICT (team_id, name) DO NOTHING
+RETURNING *;
+
+-- name: GetVolume :one
+SELECT *
+FROM "public"."volumes"
+WHERE id = @id AND team_id = @team_id;
+
+-- name: GetTeamVolumes :many
+SELECT *
+FROM "public"."volumes"
+WHERE team_id = @team_id
+ORDER BY created_at DESC;
+
+-- name: GetSandboxVolumes :many
+SELECT *
+FROM "public"."volumes"
+WHERE sandbox_id = @sandbox_id AND team_id = @team_id
+ORDER BY created_at;
+
+-- name: AttachVolume :one
+-- The volume is attached only when it's free or still held by the expected sandbox, so two sandboxes can't attach the same volume.
+UPDATE "public"."volumes"
+SET sandbox_id = @sandbox_id, mount_path = @mount_path
+WHERE id = @id AND team_id = @team_id AND sandbox_id IS NOT DISTINCT FROM sqlc.narg(held_by)
+RETURNING *;
+
+-- name: DetachSandboxVolumes :exec
+UPDATE "public"."volumes"
+SET sandbox_id = NULL, mount_path = NULL
+WHERE sandbox_id = @sandbox_id AND team_id = @team_id;
+
+-- name: DeleteVolume :execrows
+DELETE FROM "public"."volumes"
+WHERE id = @id AND team_id = @team_id AND sandbox_id IS NULL;
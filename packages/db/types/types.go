@@ -9,3 +9,43 @@ type BuildReason struct {
 	// Step that failed
 	Step *string `json:"step,omitempty"`
 }
+
+type SandboxNetworkConfig struct {
+	// Allowed egress destinations (IPv4 addresses, CIDRs or domains)
+	AllowOut []string `json:"allowOut,omitempty"`
+
+	// Denied egress destinations (IPv4 addresses, CIDRs or domains)
+	DenyOut []string `json:"denyOut,omitempty"`
+
+	// Access to the sandbox ports exposed through the proxy, all ports are public when not set
+	Ingress *SandboxIngressConfig `json:"ingress,omitempty"`
+
+	// Resolver config and static host entries of the sandbox, the template config is kept when not set
+	DNS *SandboxDNSConfig `json:"dns,omitempty"`
+}
+
+type SandboxIngressConfig struct {
+	// Access to the ports that are not listed (public, token or closed)
+	DefaultAccess string `json:"defaultAccess,omitempty"`
+
+	Ports []SandboxPortIngress `json:"ports,omitempty"`
+
+	// Token for the ports with the token access
+	TrafficAccessToken string `json:"trafficAccessToken,omitempty"`
+}
+
+type SandboxPortIngress struct {
+	Port   uint64 `json:"port"`
+	Access string `json:"access"`
+}
+
+type SandboxDNSConfig struct {
+	Nameservers []string           `json:"nameservers,omitempty"`
+	Searches    []string           `json:"searches,omitempty"`
+	Hosts       []SandboxHostEntry `json:"hosts,omitempty"`
+}
+
+type SandboxHostEntry struct {
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
@@ -63,6 +63,9 @@ type Metrics struct {
 	// DiskUsed Used disk space in bytes
 	DiskUsed *int `json:"disk_used,omitempty"`
 
+	// LastProcessActivity Unix timestamp in UTC of the last process start, input or output
+	LastProcessActivity *int64 `json:"last_process_activity,omitempty"`
+
 	// MemTotal Total virtual memory in bytes
 	MemTotal *int `json:"mem_total,omitempty"`
 
@@ -59,7 +59,16 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 	go func() { //nolint:contextcheck // TODO: fix this later
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
-		host.PollForMMDSOpts(ctx, a.mmdsChan, a.envVars)
+		mmdsOpts := host.PollForMMDSOpts(ctx, a.mmdsChan, a.envVars)
+		if mmdsOpts == nil {
+			return
+		}
+
+		if mmdsOpts.DNS != nil {
+			a.SetupDNS(*mmdsOpts.DNS)
+		}
+
+		a.SetupVolumes(ctx, mmdsOpts.Volumes)
 	}()
 
 	w.Header().Set("Cache-Control", "no-store")
@@ -69,8 +78,8 @@ func (a *API) PostInit(w http.ResponseWriter, r *http.Request) {
 }
 
 func (a *API) SetupHyperloop(address string) {
-	a.hyperloopLock.Lock()
-	defer a.hyperloopLock.Unlock()
+	a.hostsLock.Lock()
+	defer a.hostsLock.Unlock()
 
 	hosts, err := txeh.NewHosts(&txeh.HostsConfig{ReadFilePath: "/etc/hosts", WriteFilePath: "/etc/hosts"})
 	if err != nil {
@@ -88,3 +97,41 @@ func (a *API) SetupHyperloop(address string) {
 
 	a.envVars.Store("E2B_EVENTS_ADDRESS", fmt.Sprintf("http://%s", address))
 }
+
+func (a *API) SetupVolumes(ctx context.Context, volumes []host.Volume) {
+	for _, volume := range volumes {
+		err := host.MountVolume(ctx, volume)
+		if err != nil {
+			a.logger.Error().Msgf("Failed to mount volume %s to %s: %v", volume.Device, volume.Path, err)
+		}
+	}
+}
+
+func (a *API) SetupDNS(config host.DNSConfig) {
+	err := host.ApplyResolvConf(config)
+	if err != nil {
+		a.logger.Error().Msgf("Failed to update resolver config: %v", err)
+	}
+
+	if len(config.Hosts) == 0 {
+		return
+	}
+
+	a.hostsLock.Lock()
+	defer a.hostsLock.Unlock()
+
+	hosts, err := txeh.NewHosts(&txeh.HostsConfig{ReadFilePath: "/etc/hosts", WriteFilePath: "/etc/hosts"})
+	if err != nil {
+		a.logger.Error().Msgf("Failed to create hosts: %v", err)
+		return
+	}
+
+	for _, entry := range config.Hosts {
+		hosts.AddHost(entry.IP, entry.Hostname)
+	}
+
+	err = hosts.Save()
+	if err != nil {
+		a.logger.Error().Msgf("Failed to add host entries: %v", err)
+	}
+}
@@ -12,12 +12,13 @@ import (
 )
 
 type API struct {
-	isNotFC       bool
-	logger        *zerolog.Logger
-	accessToken   *string
-	envVars       *utils.Map[string, string]
-	mmdsChan      chan *host.MMDSOpts
-	hyperloopLock sync.Mutex
+	isNotFC     bool
+	logger      *zerolog.Logger
+	accessToken *string
+	envVars     *utils.Map[string, string]
+	mmdsChan    chan *host.MMDSOpts
+	// hostsLock guards the /etc/hosts updates.
+	hostsLock sync.Mutex
 }
 
 func New(l *zerolog.Logger, envVars *utils.Map[string, string], mmdsChan chan *host.MMDSOpts, isNotFC bool) *API {
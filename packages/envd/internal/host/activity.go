@@ -0,0 +1,19 @@
+package host
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// lastProcessActivity is the Unix timestamp of the last process start, input or output.
+var lastProcessActivity atomic.Int64
+
+// MarkProcessActivity records that a process was started, written to, or produced output.
+func MarkProcessActivity() {
+	lastProcessActivity.Store(time.Now().UTC().Unix())
+}
+
+// LastProcessActivity returns the Unix timestamp of the last process activity, zero if there was none.
+func LastProcessActivity() int64 {
+	return lastProcessActivity.Load()
+}
@@ -0,0 +1,78 @@
+package host
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const resolvConfPath = "/etc/resolv.conf"
+
+// DNSConfig is the resolver config and the static host entries of the sandbox.
+type DNSConfig struct {
+	Nameservers []string    `json:"nameservers,omitempty"`
+	Searches    []string    `json:"searches,omitempty"`
+	Hosts       []HostEntry `json:"hosts,omitempty"`
+}
+
+type HostEntry struct {
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
+
+// ApplyResolvConf updates the nameservers and search domains in /etc/resolv.conf, the rest of the file is kept.
+func ApplyResolvConf(config DNSConfig) error {
+	if len(config.Nameservers) == 0 && len(config.Searches) == 0 {
+		return nil
+	}
+
+	current, err := os.ReadFile(resolvConfPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading %s: %w", resolvConfPath, err)
+	}
+
+	err = os.WriteFile(resolvConfPath, renderResolvConf(current, config), 0o644)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", resolvConfPath, err)
+	}
+
+	return nil
+}
+
+func renderResolvConf(current []byte, config DNSConfig) []byte {
+	var out bytes.Buffer
+
+	scanner := bufio.NewScanner(bytes.NewReader(current))
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+
+		if len(fields) > 0 {
+			switch fields[0] {
+			case "nameserver":
+				if len(config.Nameservers) > 0 {
+					continue
+				}
+			case "search", "domain":
+				if len(config.Searches) > 0 {
+					continue
+				}
+			}
+		}
+
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+
+	for _, nameserver := range config.Nameservers {
+		fmt.Fprintf(&out, "nameserver %s\n", nameserver)
+	}
+
+	if len(config.Searches) > 0 {
+		fmt.Fprintf(&out, "search %s\n", strings.Join(config.Searches, " "))
+	}
+
+	return out.Bytes()
+}
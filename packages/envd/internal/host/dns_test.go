@@ -0,0 +1,32 @@
+package host
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderResolvConf(t *testing.T) {
+	current := []byte("# generated\nnameserver 8.8.8.8\nsearch example.com\noptions ndots:1\n")
+
+	t.Run("nameservers and searches", func(t *testing.T) {
+		rendered := renderResolvConf(current, DNSConfig{
+			Nameservers: []string{"10.0.0.2", "10.0.0.3"},
+			Searches:    []string{"internal.dev", "svc.local"},
+		})
+
+		assert.Equal(t, "# generated\noptions ndots:1\nnameserver 10.0.0.2\nnameserver 10.0.0.3\nsearch internal.dev svc.local\n", string(rendered))
+	})
+
+	t.Run("only searches keep the nameservers", func(t *testing.T) {
+		rendered := renderResolvConf(current, DNSConfig{Searches: []string{"internal.dev"}})
+
+		assert.Equal(t, "# generated\nnameserver 8.8.8.8\noptions ndots:1\nsearch internal.dev\n", string(rendered))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		rendered := renderResolvConf(nil, DNSConfig{Nameservers: []string{"10.0.0.2"}})
+
+		assert.Equal(t, "nameserver 10.0.0.2\n", string(rendered))
+	})
+}
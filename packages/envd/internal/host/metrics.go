@@ -28,6 +28,8 @@ type Metrics struct {
 
 	DiskUsed  uint64 `json:"disk_used"`  // Used disk space in bytes
 	DiskTotal uint64 `json:"disk_total"` // Total disk space in bytes
+
+	LastProcessActivity int64 `json:"last_process_activity"` // Unix Timestamp in UTC of the last process start, input or output
 }
 
 func GetMetrics() (*Metrics, error) {
@@ -70,6 +72,8 @@ func GetMetrics() (*Metrics, error) {
 		MemUsed:        v.Used,
 		DiskUsed:       diskMetrics.Total - diskMetrics.Available,
 		DiskTotal:      diskMetrics.Total,
+
+		LastProcessActivity: LastProcessActivity(),
 	}, nil
 }
 
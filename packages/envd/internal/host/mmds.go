@@ -26,6 +26,9 @@ type MMDSOpts struct {
 	InstanceID string `json:"instanceID"`
 	EnvID      string `json:"envID"`
 	Address    string `json:"address"`
+
+	DNS     *DNSConfig `json:"dns,omitempty"`
+	Volumes []Volume   `json:"volumes,omitempty"`
 }
 
 func (opts *MMDSOpts) Update(traceID, instanceID, envID, collectorAddress string) {
@@ -111,7 +114,8 @@ func getMMDSOpts(ctx context.Context, client *http.Client, token string) (*MMDSO
 	return &opts, nil
 }
 
-func PollForMMDSOpts(ctx context.Context, mmdsChan chan<- *MMDSOpts, envVars *utils.Map[string, string]) {
+// PollForMMDSOpts waits for the sandbox metadata, it returns nil when the context is cancelled first.
+func PollForMMDSOpts(ctx context.Context, mmdsChan chan<- *MMDSOpts, envVars *utils.Map[string, string]) *MMDSOpts {
 	httpClient := &http.Client{}
 	defer httpClient.CloseIdleConnections()
 
@@ -122,7 +126,7 @@ func PollForMMDSOpts(ctx context.Context, mmdsChan chan<- *MMDSOpts, envVars *ut
 		select {
 		case <-ctx.Done():
 			fmt.Fprintf(os.Stderr, "context cancelled while waiting for mmds opts")
-			return
+			return nil
 		case <-ticker.C:
 			token, err := getMMDSToken(ctx, httpClient)
 			if err != nil {
@@ -150,7 +154,7 @@ func PollForMMDSOpts(ctx context.Context, mmdsChan chan<- *MMDSOpts, envVars *ut
 				mmdsChan <- mmdsOpts
 			}
 
-			return
+			return mmdsOpts
 		}
 	}
 }
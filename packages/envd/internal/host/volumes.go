@@ -0,0 +1,88 @@
+package host
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const mountsPath = "/proc/self/mounts"
+
+// blkid exits with this code when the device has no filesystem.
+const blkidNotFoundExitCode = 2
+
+// Volume is the persistent volume device attached to the sandbox.
+type Volume struct {
+	Device string `json:"device"`
+	Path   string `json:"path"`
+}
+
+// MountVolume mounts the volume, the device is formatted when it's attached for the first time.
+// The volume is skipped if it's already mounted, e.g. after the sandbox is resumed.
+func MountVolume(ctx context.Context, volume Volume) error {
+	mounts, err := os.ReadFile(mountsPath)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", mountsPath, err)
+	}
+
+	if isMountPoint(mounts, volume.Path) {
+		return nil
+	}
+
+	formatted := false
+
+	err = exec.CommandContext(ctx, "blkid", volume.Device).Run()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == blkidNotFoundExitCode:
+		out, err := exec.CommandContext(ctx, "mkfs.ext4", "-q", "-F", volume.Device).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("error formatting volume %s: %w: %s", volume.Device, err, out)
+		}
+
+		formatted = true
+	case err != nil:
+		return fmt.Errorf("error checking volume %s filesystem: %w", volume.Device, err)
+	}
+
+	err = os.MkdirAll(volume.Path, 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating volume mount path %s: %w", volume.Path, err)
+	}
+
+	err = unix.Mount(volume.Device, volume.Path, "ext4", 0, "")
+	if err != nil {
+		return fmt.Errorf("error mounting volume %s to %s: %w", volume.Device, volume.Path, err)
+	}
+
+	// The new volume is writable by all the sandbox users, same as the volume owner can change it later.
+	if formatted {
+		err = os.Chmod(volume.Path, 0o777)
+		if err != nil {
+			return fmt.Errorf("error setting volume %s permissions: %w", volume.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func isMountPoint(mounts []byte, path string) bool {
+	path = strings.TrimSuffix(path, "/")
+
+	scanner := bufio.NewScanner(bytes.NewReader(mounts))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 1 && fields[1] == path {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package host
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMountPoint(t *testing.T) {
+	mounts := []byte("/dev/root / ext4 rw,relatime 0 0\n/dev/vdb /mnt/data ext4 rw,relatime 0 0\ntmpfs /run tmpfs rw 0 0\n")
+
+	assert.True(t, isMountPoint(mounts, "/mnt/data"))
+	assert.True(t, isMountPoint(mounts, "/mnt/data/"))
+	assert.False(t, isMountPoint(mounts, "/mnt"))
+	assert.False(t, isMountPoint(nil, "/mnt/data"))
+}
@@ -20,7 +20,8 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 	ctx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
 
-	proc, err := s.getProcess(req.Msg.GetProcess())
+	// The exited processes can be connected to for a while, so the clients can read the output they missed
+	proc, err := s.getProcessOrExited(req.Msg.GetProcess())
 	if err != nil {
 		return err
 	}
@@ -33,6 +34,13 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 	end, endCancel := proc.EndEvent.Fork()
 	defer endCancel()
 
+	// The replay is read after the fork, the chunks written in between are received from both and skipped in the live output
+	var replay []*rpc.ProcessEvent_DataEvent
+	var replayEnd uint64
+	if req.Msg.Offset != nil {
+		replay, replayEnd = proc.OutputSince(req.Msg.GetOffset())
+	}
+
 	streamErr := stream.Send(&rpc.ConnectResponse{
 		Event: &rpc.ProcessEvent{
 			Event: &rpc.ProcessEvent_Start{
@@ -52,6 +60,21 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 		keepaliveTicker, resetKeepalive := permissions.GetKeepAliveTicker(req)
 		defer keepaliveTicker.Stop()
 
+		for _, chunk := range replay {
+			streamErr := stream.Send(&rpc.ConnectResponse{
+				Event: &rpc.ProcessEvent{
+					Event: &rpc.ProcessEvent_Data{
+						Data: chunk,
+					},
+				},
+			})
+			if streamErr != nil {
+				cancel(connect.NewError(connect.CodeUnknown, fmt.Errorf("error sending replayed data event: %w", streamErr)))
+
+				return
+			}
+		}
+
 	dataLoop:
 		for {
 			select {
@@ -77,6 +100,10 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 					break dataLoop
 				}
 
+				if req.Msg.Offset != nil && event.Data.GetOffset() < replayEnd {
+					continue
+				}
+
 				streamErr := stream.Send(&rpc.ConnectResponse{
 					Event: &rpc.ProcessEvent{
 						Event: &event,
@@ -99,9 +126,15 @@ func (s *Service) handleConnect(ctx context.Context, req *connect.Request[rpc.Co
 			return
 		case event, ok := <-end:
 			if !ok {
-				cancel(connect.NewError(connect.CodeUnknown, errors.New("end event channel closed before sending end event")))
+				// The process ended before the client connected
+				stored := proc.End()
+				if stored == nil {
+					cancel(connect.NewError(connect.CodeUnknown, errors.New("end event channel closed before sending end event")))
 
-				return
+					return
+				}
+
+				event = *stored
 			}
 
 			streamErr := stream.Send(&rpc.ConnectResponse{
@@ -0,0 +1,112 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const processEventsTimeout = 10 * time.Second
+
+// processEnd is the body of the process end event sent to the hyperloop server, it's recorded in the sandbox events.
+type processEnd struct {
+	Pid           uint32       `json:"pid"`
+	Cmd           string       `json:"cmd"`
+	Tag           *string      `json:"tag,omitempty"`
+	ExitCode      int32        `json:"exitCode"`
+	Exited        bool         `json:"exited"`
+	Status        string       `json:"status"`
+	LimitExceeded *string      `json:"limitExceeded,omitempty"`
+	Usage         processUsage `json:"usage"`
+}
+
+type processUsage struct {
+	CpuUserMs    uint64 `json:"cpuUserMs"`
+	CpuSystemMs  uint64 `json:"cpuSystemMs"`
+	MaxRssBytes  uint64 `json:"maxRssBytes"`
+	IoReadBytes  uint64 `json:"ioReadBytes"`
+	IoWriteBytes uint64 `json:"ioWriteBytes"`
+	WallTimeMs   uint64 `json:"wallTimeMs"`
+}
+
+func newProcessEnd(pid uint32, proc *handler.Handler, end *rpc.ProcessEvent_EndEvent) processEnd {
+	event := processEnd{
+		Pid:      pid,
+		Cmd:      proc.Config.GetCmd(),
+		Tag:      proc.Tag,
+		ExitCode: end.GetExitCode(),
+		Exited:   end.GetExited(),
+		Status:   end.GetStatus(),
+		Usage: processUsage{
+			CpuUserMs:    end.GetUsage().GetCpuUserMs(),
+			CpuSystemMs:  end.GetUsage().GetCpuSystemMs(),
+			MaxRssBytes:  end.GetUsage().GetMaxRssBytes(),
+			IoReadBytes:  end.GetUsage().GetIoReadBytes(),
+			IoWriteBytes: end.GetUsage().GetIoWriteBytes(),
+			WallTimeMs:   end.GetUsage().GetWallTimeMs(),
+		},
+	}
+
+	if end.LimitExceeded != nil {
+		limit := end.GetLimitExceeded().String()
+		event.LimitExceeded = &limit
+	}
+
+	return event
+}
+
+// reportProcessEnd sends the end of the process with its resource usage to the hyperloop server.
+// The events are sent only when the sandbox was initialized with the hyperloop address.
+func (s *Service) reportProcessEnd(pid uint32, proc *handler.Handler) {
+	address, ok := s.envVars.Load("E2B_EVENTS_ADDRESS")
+	if !ok || address == "" {
+		return
+	}
+
+	end := proc.End()
+	if end == nil {
+		return
+	}
+
+	body, err := json.Marshal(newProcessEnd(pid, proc, end.End))
+	if err != nil {
+		s.logger.Error().Err(err).Uint32("pid", pid).Msg("Failed to marshal the process end event")
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), processEventsTimeout)
+	defer cancel()
+
+	err = s.sendProcessEvent(ctx, address+"/events/process", body)
+	if err != nil {
+		s.logger.Error().Err(err).Uint32("pid", pid).Msg("Failed to send the process end event")
+	}
+}
+
+func (s *Service) sendProcessEvent(ctx context.Context, url string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	return nil
+}
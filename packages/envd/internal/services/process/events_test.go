@@ -0,0 +1,56 @@
+package process
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func TestNewProcessEnd(t *testing.T) {
+	proc := &handler.Handler{
+		Config: &rpc.ProcessConfig{Cmd: "/bin/bash"},
+		Tag:    ptr("build"),
+	}
+
+	limit := rpc.ProcessLimit_PROCESS_LIMIT_MEMORY
+	end := &rpc.ProcessEvent_EndEvent{
+		ExitCode:      137,
+		Status:        "signal: killed",
+		LimitExceeded: &limit,
+		Usage: &rpc.ProcessUsage{
+			CpuUserMs:    10,
+			CpuSystemMs:  5,
+			MaxRssBytes:  1024,
+			IoReadBytes:  512,
+			IoWriteBytes: 2048,
+			WallTimeMs:   100,
+		},
+	}
+
+	data, err := json.Marshal(newProcessEnd(42, proc, end))
+	require.NoError(t, err)
+
+	// The body must match the ProcessEnd schema of the hyperloop API
+	assert.JSONEq(t, `{
+		"pid": 42,
+		"cmd": "/bin/bash",
+		"tag": "build",
+		"exitCode": 137,
+		"exited": false,
+		"status": "signal: killed",
+		"limitExceeded": "PROCESS_LIMIT_MEMORY",
+		"usage": {
+			"cpuUserMs": 10,
+			"cpuSystemMs": 5,
+			"maxRssBytes": 1024,
+			"ioReadBytes": 512,
+			"ioWriteBytes": 2048,
+			"wallTimeMs": 100
+		}
+	}`, string(data))
+}
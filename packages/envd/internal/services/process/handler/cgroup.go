@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+	// processesCgroup is created next to the systemd slices, so the limited processes are separated from envd
+	processesCgroup = "envd-processes"
+
+	cpuPeriodUs  = 100_000
+	maxCpuWeight = 10_000
+
+	// cgroupRemoveInterval is how often the group left populated by the background processes is checked
+	cgroupRemoveInterval = 5 * time.Second
+)
+
+var cgroupControllers = []string{"cpu", "memory", "pids"}
+
+var cgroupCounter atomic.Uint64
+
+// processesCgroupPath enables the controllers for the cgroups of the processes, it's done only once.
+var processesCgroupPath = sync.OnceValues(func() (string, error) {
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return "", fmt.Errorf("cgroup v2 is not available: %w", err)
+	}
+
+	err := enableControllers(cgroupRoot)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(cgroupRoot, processesCgroup)
+
+	err = os.Mkdir(path, 0o755)
+	if err != nil && !errors.Is(err, os.ErrExist) {
+		return "", fmt.Errorf("failed to create cgroup '%s': %w", path, err)
+	}
+
+	err = enableControllers(path)
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+})
+
+func enableControllers(path string) error {
+	controllers := make([]string, len(cgroupControllers))
+	for i, controller := range cgroupControllers {
+		controllers[i] = "+" + controller
+	}
+
+	err := os.WriteFile(filepath.Join(path, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to enable cgroup controllers in '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+func hasLimits(limits *rpc.ProcessLimits) bool {
+	return limits != nil && (limits.MemoryMaxBytes != nil ||
+		limits.CpuWeight != nil ||
+		limits.CpuQuotaPercent != nil ||
+		limits.PidsMax != nil ||
+		limits.TimeoutMs != nil)
+}
+
+func validateLimits(limits *rpc.ProcessLimits) error {
+	if limits.CpuWeight != nil && (limits.GetCpuWeight() < 1 || limits.GetCpuWeight() > maxCpuWeight) {
+		return fmt.Errorf("cpu weight must be between 1 and %d, got %d", maxCpuWeight, limits.GetCpuWeight())
+	}
+
+	if limits.CpuQuotaPercent != nil && limits.GetCpuQuotaPercent() == 0 {
+		return fmt.Errorf("cpu quota must be greater than 0")
+	}
+
+	if limits.MemoryMaxBytes != nil && limits.GetMemoryMaxBytes() == 0 {
+		return fmt.Errorf("memory max must be greater than 0")
+	}
+
+	if limits.PidsMax != nil && limits.GetPidsMax() == 0 {
+		return fmt.Errorf("pids max must be greater than 0")
+	}
+
+	if limits.TimeoutMs != nil && limits.GetTimeoutMs() == 0 {
+		return fmt.Errorf("timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// cgroup is the cgroup v2 group of one process and all its children.
+type cgroup struct {
+	path string
+	// fd is used to start the process directly in the group
+	fd *os.File
+}
+
+func newCgroup(limits *rpc.ProcessLimits) (*cgroup, error) {
+	parent, err := processesCgroupPath()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(parent, fmt.Sprintf("process-%d-%d", time.Now().UnixNano(), cgroupCounter.Add(1)))
+
+	err = os.Mkdir(path, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cgroup '%s': %w", path, err)
+	}
+
+	cg := &cgroup{path: path}
+
+	err = cg.setLimits(limits)
+	if err != nil {
+		cg.Close()
+
+		return nil, err
+	}
+
+	cg.fd, err = os.Open(path)
+	if err != nil {
+		cg.Close()
+
+		return nil, fmt.Errorf("failed to open cgroup '%s': %w", path, err)
+	}
+
+	return cg, nil
+}
+
+func (c *cgroup) setLimits(limits *rpc.ProcessLimits) error {
+	values := make(map[string]string)
+
+	if limits.MemoryMaxBytes != nil {
+		values["memory.max"] = strconv.FormatUint(limits.GetMemoryMaxBytes(), 10)
+		// Kill the whole group on OOM, a partially killed process group is rarely usable
+		values["memory.oom.group"] = "1"
+	}
+
+	if limits.CpuWeight != nil {
+		values["cpu.weight"] = strconv.FormatUint(uint64(limits.GetCpuWeight()), 10)
+	}
+
+	if limits.CpuQuotaPercent != nil {
+		quota := uint64(limits.GetCpuQuotaPercent()) * cpuPeriodUs / 100
+		values["cpu.max"] = fmt.Sprintf("%d %d", quota, cpuPeriodUs)
+	}
+
+	if limits.PidsMax != nil {
+		values["pids.max"] = strconv.FormatUint(uint64(limits.GetPidsMax()), 10)
+	}
+
+	for file, value := range values {
+		err := os.WriteFile(filepath.Join(c.path, file), []byte(value), 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to set %s of cgroup '%s' to '%s': %w", file, c.path, value, err)
+		}
+	}
+
+	return nil
+}
+
+// Kill kills all the processes in the group.
+func (c *cgroup) Kill() error {
+	err := os.WriteFile(filepath.Join(c.path, "cgroup.kill"), []byte("1"), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to kill cgroup '%s': %w", c.path, err)
+	}
+
+	return nil
+}
+
+// KillCgroup kills all the processes in the cgroup of the process started by the previous envd.
+func KillCgroup(path string) error {
+	parent, err := processesCgroupPath()
+	if err != nil {
+		return err
+	}
+
+	// Only the cgroups of the processes can be killed
+	if filepath.Dir(path) != parent {
+		return fmt.Errorf("cgroup '%s' isn't a process cgroup", path)
+	}
+
+	cg := &cgroup{path: path}
+
+	return cg.Kill()
+}
+
+// ExceededLimit returns the limit the group reached, the memory limit takes precedence as it kills the processes.
+func (c *cgroup) ExceededLimit() rpc.ProcessLimit {
+	if readEvent(filepath.Join(c.path, "memory.events"), "oom_kill") > 0 {
+		return rpc.ProcessLimit_PROCESS_LIMIT_MEMORY
+	}
+
+	if readEvent(filepath.Join(c.path, "pids.events"), "max") > 0 {
+		return rpc.ProcessLimit_PROCESS_LIMIT_PIDS
+	}
+
+	return rpc.ProcessLimit_PROCESS_LIMIT_UNSPECIFIED
+}
+
+// Close removes the group, when some processes started in it are still running the group is removed after they exit.
+func (c *cgroup) Close() error {
+	var errs []error
+
+	if c.fd != nil {
+		errs = append(errs, c.fd.Close())
+	}
+
+	err := os.Remove(c.path)
+	switch {
+	case errors.Is(err, syscall.EBUSY):
+		go c.removeWhenEmpty()
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		errs = append(errs, fmt.Errorf("failed to remove cgroup '%s': %w", c.path, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// removeWhenEmpty removes the group once cgroup.events reports that no process is left in it.
+func (c *cgroup) removeWhenEmpty() {
+	ticker := time.NewTicker(cgroupRemoveInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if readEvent(filepath.Join(c.path, "cgroup.events"), "populated") > 0 {
+			continue
+		}
+
+		err := os.Remove(c.path)
+		if errors.Is(err, syscall.EBUSY) {
+			continue
+		}
+
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "error removing cgroup '%s': %s\n", c.path, err)
+		}
+
+		return
+	}
+}
+
+// readEvent returns the counter of the event from the cgroup events file.
+func readEvent(path string, event string) uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok || key != event {
+			continue
+		}
+
+		count, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0
+		}
+
+		return count
+	}
+
+	return 0
+}
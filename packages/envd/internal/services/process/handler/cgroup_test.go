@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestValidateLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits *rpc.ProcessLimits
+		valid  bool
+	}{
+		{name: "all limits", limits: &rpc.ProcessLimits{MemoryMaxBytes: ptr[uint64](1 << 20), CpuWeight: ptr[uint32](100), CpuQuotaPercent: ptr[uint32](150), PidsMax: ptr[uint32](10), TimeoutMs: ptr[uint32](1000)}, valid: true},
+		{name: "cpu weight too low", limits: &rpc.ProcessLimits{CpuWeight: ptr[uint32](0)}},
+		{name: "cpu weight too high", limits: &rpc.ProcessLimits{CpuWeight: ptr[uint32](maxCpuWeight + 1)}},
+		{name: "zero cpu quota", limits: &rpc.ProcessLimits{CpuQuotaPercent: ptr[uint32](0)}},
+		{name: "zero memory", limits: &rpc.ProcessLimits{MemoryMaxBytes: ptr[uint64](0)}},
+		{name: "zero pids", limits: &rpc.ProcessLimits{PidsMax: ptr[uint32](0)}},
+		{name: "zero timeout", limits: &rpc.ProcessLimits{TimeoutMs: ptr[uint32](0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLimits(tt.limits)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestHasLimits(t *testing.T) {
+	assert.False(t, hasLimits(nil))
+	assert.False(t, hasLimits(&rpc.ProcessLimits{}))
+	assert.True(t, hasLimits(&rpc.ProcessLimits{PidsMax: ptr[uint32](1)}))
+}
+
+func TestCgroupExceededLimit(t *testing.T) {
+	dir := t.TempDir()
+	cg := &cgroup{path: dir}
+
+	assert.Equal(t, rpc.ProcessLimit_PROCESS_LIMIT_UNSPECIFIED, cg.ExceededLimit())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pids.events"), []byte("max 2\n"), 0o644))
+	assert.Equal(t, rpc.ProcessLimit_PROCESS_LIMIT_PIDS, cg.ExceededLimit())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.events"), []byte("low 0\nhigh 0\nmax 5\noom 1\noom_kill 1\noom_group_kill 1\n"), 0o644))
+	assert.Equal(t, rpc.ProcessLimit_PROCESS_LIMIT_MEMORY, cg.ExceededLimit())
+}
@@ -9,12 +9,15 @@ import (
 	"os/exec"
 	"os/user"
 	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/creack/pty"
 	"github.com/rs/zerolog"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/host"
 	"github.com/e2b-dev/infra/packages/envd/internal/logs"
 	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
@@ -40,9 +43,17 @@ type Handler struct {
 
 	logger *zerolog.Logger
 
-	Tag *string
-	cmd *exec.Cmd
-	tty *os.File
+	Tag    *string
+	Group  *string
+	Labels map[string]string
+	Limits *rpc.ProcessLimits
+	cmd    *exec.Cmd
+	tty    *os.File
+
+	// cgroup is set only for the processes started with limits
+	cgroup       *cgroup
+	timeoutTimer *time.Timer
+	timedOut     atomic.Bool
 
 	cancel context.CancelFunc
 
@@ -53,6 +64,13 @@ type Handler struct {
 
 	DataEvent *MultiplexedChannel[rpc.ProcessEvent_Data]
 	EndEvent  *MultiplexedChannel[rpc.ProcessEvent_End]
+
+	// output is kept for the clients that connect after the output was sent
+	output *outputBuffer
+	end    atomic.Pointer[rpc.ProcessEvent_End]
+
+	// startedAt is used for the wall time of the process in the usage
+	startedAt time.Time
 }
 
 // This method must be called only after the process has been started
@@ -60,6 +78,15 @@ func (p *Handler) Pid() uint32 {
 	return uint32(p.cmd.Process.Pid)
 }
 
+// CgroupPath returns the cgroup of the process, it's empty for the processes started without limits.
+func (p *Handler) CgroupPath() string {
+	if p.cgroup == nil {
+		return ""
+	}
+
+	return p.cgroup.path
+}
+
 func New(
 	ctx context.Context,
 	user *user.User,
@@ -67,7 +94,7 @@ func New(
 	logger *zerolog.Logger,
 	envVars *utils.Map[string, string],
 	cancel context.CancelFunc,
-) (*Handler, error) {
+) (_ *Handler, e error) {
 	cmd := exec.CommandContext(ctx, req.GetProcess().GetCmd(), req.GetProcess().GetArgs()...)
 
 	uid, gid, err := permissions.GetUserIds(user)
@@ -95,6 +122,29 @@ func New(
 
 	cmd.Dir = resolvedPath
 
+	var cg *cgroup
+	if hasLimits(req.GetLimits()) {
+		err = validateLimits(req.GetLimits())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid process limits: %w", err))
+		}
+
+		cg, err = newCgroup(req.GetLimits())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("error creating cgroup for the process limits: %w", err))
+		}
+
+		defer func() {
+			if e != nil {
+				cg.Close()
+			}
+		}()
+
+		// The process is started directly in the cgroup, so even its first children can't escape the limits
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = int(cg.fd.Fd())
+	}
+
 	var formattedVars []string
 
 	// Take only 'PATH' variable from the current environment
@@ -131,11 +181,16 @@ func New(
 		Config:    req.GetProcess(),
 		cmd:       cmd,
 		Tag:       req.Tag,
+		Group:     req.Group,
+		Labels:    req.GetLabels(),
+		Limits:    req.GetLimits(),
+		cgroup:    cg,
 		DataEvent: outMultiplex,
 		cancel:    cancel,
 		outCtx:    outCtx,
 		outCancel: outCancel,
 		EndEvent:  NewMultiplexedChannel[rpc.ProcessEvent_End](0),
+		output:    newOutputBuffer(outputBufferMaxSize),
 		logger:    logger,
 	}
 
@@ -150,6 +205,8 @@ func New(
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("error starting pty with command '%s' in dir '%s' with '%d' cols and '%d' rows: %w", cmd, cmd.Dir, req.GetPty().GetSize().Cols, req.GetPty().GetSize().Rows, err))
 		}
 
+		h.startedAt = time.Now()
+
 		outWg.Add(1)
 		go func() {
 			defer outWg.Done()
@@ -160,13 +217,13 @@ func New(
 				n, readErr := tty.Read(buf)
 
 				if n > 0 {
-					outMultiplex.Source <- rpc.ProcessEvent_Data{
-						Data: &rpc.ProcessEvent_DataEvent{
-							Output: &rpc.ProcessEvent_DataEvent_Pty{
-								Pty: buf[:n],
-							},
+					host.MarkProcessActivity()
+
+					h.writeOutput(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Pty{
+							Pty: buf[:n],
 						},
-					}
+					})
 				}
 
 				if errors.Is(readErr, io.EOF) {
@@ -183,6 +240,10 @@ func New(
 
 		h.tty = tty
 	} else {
+		// The pty process is a session leader, the other processes get their own process group,
+		// so the signal sent to the group reaches all their descendants
+		cmd.SysProcAttr.Setpgid = true
+
 		stdout, err := cmd.StdoutPipe()
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error creating stdout pipe for command '%s': %w", cmd, err))
@@ -204,13 +265,13 @@ func New(
 				n, readErr := stdout.Read(buf)
 
 				if n > 0 {
-					outMultiplex.Source <- rpc.ProcessEvent_Data{
-						Data: &rpc.ProcessEvent_DataEvent{
-							Output: &rpc.ProcessEvent_DataEvent_Stdout{
-								Stdout: buf[:n],
-							},
+					host.MarkProcessActivity()
+
+					h.writeOutput(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Stdout{
+							Stdout: buf[:n],
 						},
-					}
+					})
 
 					stdoutLogs <- buf[:n]
 				}
@@ -248,13 +309,13 @@ func New(
 				n, readErr := stderr.Read(buf)
 
 				if n > 0 {
-					outMultiplex.Source <- rpc.ProcessEvent_Data{
-						Data: &rpc.ProcessEvent_DataEvent{
-							Output: &rpc.ProcessEvent_DataEvent_Stderr{
-								Stderr: buf[:n],
-							},
+					host.MarkProcessActivity()
+
+					h.writeOutput(&rpc.ProcessEvent_DataEvent{
+						Output: &rpc.ProcessEvent_DataEvent_Stderr{
+							Stderr: buf[:n],
 						},
-					}
+					})
 
 					stderrLogs <- buf[:n]
 				}
@@ -294,6 +355,25 @@ func New(
 	return h, nil
 }
 
+// writeOutput keeps the output in the buffer before sending it to the connected clients.
+func (p *Handler) writeOutput(event *rpc.ProcessEvent_DataEvent) {
+	p.output.Write(event)
+
+	p.DataEvent.Source <- rpc.ProcessEvent_Data{
+		Data: event,
+	}
+}
+
+// OutputSince returns the buffered output starting at the offset and the offset after the last buffered chunk.
+func (p *Handler) OutputSince(offset uint64) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	return p.output.Since(offset)
+}
+
+// End returns the end event of the process, nil is returned while the process is running.
+func (p *Handler) End() *rpc.ProcessEvent_End {
+	return p.end.Load()
+}
+
 func (p *Handler) SendSignal(signal syscall.Signal) error {
 	if p.cmd.Process == nil {
 		return fmt.Errorf("process not started")
@@ -306,6 +386,56 @@ func (p *Handler) SendSignal(signal syscall.Signal) error {
 	return p.cmd.Process.Signal(signal)
 }
 
+// SendGroupSignal sends the signal to the process group of the process.
+// The SIGKILL is sent to the whole cgroup when the process has limits, so even the descendants that left the group are killed.
+func (p *Handler) SendGroupSignal(signal syscall.Signal) error {
+	if p.cmd.Process == nil {
+		return fmt.Errorf("process not started")
+	}
+
+	if signal == syscall.SIGKILL || signal == syscall.SIGTERM {
+		p.outCancel()
+	}
+
+	if signal == syscall.SIGKILL && p.cgroup != nil {
+		err := p.cgroup.Kill()
+		if err == nil {
+			return nil
+		}
+
+		fmt.Fprintf(os.Stderr, "error killing cgroup of process '%s', falling back to the process group: %s\n", p.cmd, err)
+	}
+
+	// The process is the leader of its process group, so the group ID is its pid
+	err := syscall.Kill(-p.cmd.Process.Pid, signal)
+	if errors.Is(err, syscall.ESRCH) {
+		// All the processes in the group already exited
+		return nil
+	}
+
+	return err
+}
+
+// Matches returns true if the process is in the selected group and has all the selected labels.
+func (p *Handler) Matches(selector *rpc.ProcessGroupSelector) bool {
+	if selector == nil {
+		return true
+	}
+
+	if selector.Group != nil && (p.Group == nil || *p.Group != selector.GetGroup()) {
+		return false
+	}
+
+	for key, value := range selector.GetLabels() {
+		label, ok := p.Labels[key]
+		if !ok || label != value {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (p *Handler) ResizeTty(size *pty.Winsize) error {
 	if p.tty == nil {
 		return fmt.Errorf("tty not assigned to process")
@@ -323,6 +453,8 @@ func (p *Handler) WriteStdin(data []byte) error {
 		return fmt.Errorf("stdin not enabled — set stdin to true when starting the command")
 	}
 
+	host.MarkProcessActivity()
+
 	_, err := p.stdin.Write(data)
 	if err != nil {
 		return fmt.Errorf("error writing to stdin of process '%d': %w", p.cmd.Process.Pid, err)
@@ -336,6 +468,8 @@ func (p *Handler) WriteTty(data []byte) error {
 		return fmt.Errorf("tty not assigned to process — input should be written to the stdin, not the tty")
 	}
 
+	host.MarkProcessActivity()
+
 	_, err := p.tty.Write(data)
 	if err != nil {
 		return fmt.Errorf("error writing to tty of process '%d': %w", p.cmd.Process.Pid, err)
@@ -349,8 +483,24 @@ func (p *Handler) Start() (uint32, error) {
 	if p.tty == nil {
 		err := p.cmd.Start()
 		if err != nil {
+			// Nothing was started in the cgroup, so it's removed right away
+			if p.cgroup != nil {
+				closeErr := p.cgroup.Close()
+				if closeErr != nil {
+					fmt.Fprintf(os.Stderr, "error removing cgroup of process '%s': %s\n", p.cmd, closeErr)
+				}
+			}
+
 			return 0, fmt.Errorf("error starting process '%s': %w", p.cmd, err)
 		}
+
+		p.startedAt = time.Now()
+	}
+
+	host.MarkProcessActivity()
+
+	if timeout := p.Limits.GetTimeoutMs(); timeout > 0 {
+		p.timeoutTimer = time.AfterFunc(time.Duration(timeout)*time.Millisecond, p.killTimedOut)
 	}
 
 	adjustErr := adjustOomScore(p.cmd.Process.Pid, defaultOomScore)
@@ -376,6 +526,12 @@ func (p *Handler) Wait() {
 
 	p.tty.Close()
 
+	if p.timeoutTimer != nil {
+		p.timeoutTimer.Stop()
+	}
+
+	limitExceeded := p.exceededLimit()
+
 	var errMsg *string
 
 	if err != nil {
@@ -388,13 +544,22 @@ func (p *Handler) Wait() {
 		ExitCode: int32(p.cmd.ProcessState.ExitCode()),
 		Exited:   p.cmd.ProcessState.Exited(),
 		Status:   p.cmd.ProcessState.String(),
+		Usage:    processUsage(p.cmd.ProcessState, time.Since(p.startedAt)),
+	}
+
+	if limitExceeded != rpc.ProcessLimit_PROCESS_LIMIT_UNSPECIFIED {
+		endEvent.LimitExceeded = &limitExceeded
 	}
 
 	event := rpc.ProcessEvent_End{
 		End: endEvent,
 	}
 
+	p.end.Store(&event)
+
 	p.EndEvent.Source <- event
+	// The clients connecting after the end get the stored end event
+	close(p.EndEvent.Source)
 
 	p.logger.
 		Info().
@@ -402,7 +567,44 @@ func (p *Handler) Wait() {
 		Interface("process_result", endEvent).
 		Msg(fmt.Sprintf("Process with pid %d ended", p.cmd.Process.Pid))
 
+	if p.cgroup != nil {
+		// The processes left running in the background keep the cgroup, it's removed after they exit
+		closeErr := p.cgroup.Close()
+		if closeErr != nil {
+			fmt.Fprintf(os.Stderr, "error removing cgroup of process '%s': %s\n", p.cmd, closeErr)
+		}
+	}
+
 	// Ensure the process cancel is called to cleanup resources.
 	// As it is called after end event and Wait, it should not affect command execution or returned events.
 	p.cancel()
 }
+
+// killTimedOut kills the process and all its children when the process exceeds the timeout limit.
+func (p *Handler) killTimedOut() {
+	p.timedOut.Store(true)
+	p.outCancel()
+
+	err := p.cgroup.Kill()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error killing cgroup of process '%s': %s\n", p.cmd, err)
+
+		// Kill at least the process itself
+		killErr := p.cmd.Process.Kill()
+		if killErr != nil {
+			fmt.Fprintf(os.Stderr, "error killing process '%s': %s\n", p.cmd, killErr)
+		}
+	}
+}
+
+func (p *Handler) exceededLimit() rpc.ProcessLimit {
+	if p.timedOut.Load() {
+		return rpc.ProcessLimit_PROCESS_LIMIT_TIMEOUT
+	}
+
+	if p.cgroup == nil {
+		return rpc.ProcessLimit_PROCESS_LIMIT_UNSPECIFIED
+	}
+
+	return p.cgroup.ExceededLimit()
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func TestHandlerMatches(t *testing.T) {
+	h := &Handler{
+		Group:  ptr("task"),
+		Labels: map[string]string{"agent": "a1", "step": "build"},
+	}
+
+	tests := []struct {
+		name     string
+		selector *rpc.ProcessGroupSelector
+		expected bool
+	}{
+		{name: "nil selector", selector: nil, expected: true},
+		{name: "empty selector", selector: &rpc.ProcessGroupSelector{}, expected: true},
+		{name: "group", selector: &rpc.ProcessGroupSelector{Group: ptr("task")}, expected: true},
+		{name: "other group", selector: &rpc.ProcessGroupSelector{Group: ptr("other")}, expected: false},
+		{name: "label", selector: &rpc.ProcessGroupSelector{Labels: map[string]string{"agent": "a1"}}, expected: true},
+		{name: "all labels", selector: &rpc.ProcessGroupSelector{Group: ptr("task"), Labels: map[string]string{"agent": "a1", "step": "build"}}, expected: true},
+		{name: "other label value", selector: &rpc.ProcessGroupSelector{Labels: map[string]string{"agent": "a2"}}, expected: false},
+		{name: "missing label", selector: &rpc.ProcessGroupSelector{Labels: map[string]string{"agent": "a1", "missing": ""}}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, h.Matches(tt.selector))
+		})
+	}
+
+	// The process without a group doesn't match any group
+	assert.False(t, (&Handler{}).Matches(&rpc.ProcessGroupSelector{Group: ptr("task")}))
+}
+
+func TestHandlerStartFailureRemovesCgroup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "process-1")
+	require.NoError(t, os.Mkdir(path, 0o755))
+
+	fd, err := os.Open(path)
+	require.NoError(t, err)
+
+	h := &Handler{
+		cmd:    exec.Command(filepath.Join(t.TempDir(), "missing")),
+		Limits: &rpc.ProcessLimits{PidsMax: ptr[uint32](10)},
+		cgroup: &cgroup{path: path, fd: fd},
+	}
+
+	_, err = h.Start()
+	require.Error(t, err)
+
+	assert.NoDirExists(t, path)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"bytes"
+	"sync"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+// outputBufferMaxSize is the size of the output kept for the clients connecting later, the oldest output is dropped first.
+const outputBufferMaxSize = 4 << 20
+
+// outputBuffer keeps the last output of the process, each chunk is numbered by its offset in the whole output of the process.
+type outputBuffer struct {
+	mu sync.Mutex
+
+	chunks []*rpc.ProcessEvent_DataEvent
+	// size is the size of the buffered chunks
+	size int
+	// end is the offset after the last written chunk
+	end uint64
+
+	maxSize int
+}
+
+func newOutputBuffer(maxSize int) *outputBuffer {
+	return &outputBuffer{
+		maxSize: maxSize,
+	}
+}
+
+func chunkData(event *rpc.ProcessEvent_DataEvent) []byte {
+	switch output := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		return output.Stdout
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		return output.Stderr
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		return output.Pty
+	default:
+		return nil
+	}
+}
+
+// Write sets the offset of the chunk and keeps it in the buffer.
+// The data is copied, so the buffer doesn't keep the whole read buffer of the small chunks.
+func (b *outputBuffer) Write(event *rpc.ProcessEvent_DataEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	compactChunk(event)
+
+	size := len(chunkData(event))
+
+	event.Offset = b.end
+	b.end += uint64(size)
+
+	b.chunks = append(b.chunks, event)
+	b.size += size
+
+	for b.size > b.maxSize && len(b.chunks) > 1 {
+		b.size -= len(chunkData(b.chunks[0]))
+		b.chunks[0] = nil
+		b.chunks = b.chunks[1:]
+	}
+}
+
+// compactChunk replaces the data of the chunk with its copy of the exact size.
+func compactChunk(event *rpc.ProcessEvent_DataEvent) {
+	switch output := event.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		output.Stdout = bytes.Clone(output.Stdout)
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		output.Stderr = bytes.Clone(output.Stderr)
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		output.Pty = bytes.Clone(output.Pty)
+	}
+}
+
+// Since returns the buffered chunks starting at the offset and the offset after the last written chunk.
+// The first chunk starts after the offset when the output at the offset was already dropped.
+func (b *outputBuffer) Since(offset uint64) ([]*rpc.ProcessEvent_DataEvent, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var chunks []*rpc.ProcessEvent_DataEvent
+	for _, chunk := range b.chunks {
+		data := chunkData(chunk)
+		chunkEnd := chunk.GetOffset() + uint64(len(data))
+
+		if chunkEnd <= offset {
+			continue
+		}
+
+		if chunk.GetOffset() < offset {
+			chunk = trimChunk(chunk, offset-chunk.GetOffset())
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, b.end
+}
+
+// trimChunk returns the chunk without the first n bytes.
+func trimChunk(chunk *rpc.ProcessEvent_DataEvent, n uint64) *rpc.ProcessEvent_DataEvent {
+	trimmed := &rpc.ProcessEvent_DataEvent{
+		Offset: chunk.GetOffset() + n,
+	}
+
+	switch output := chunk.GetOutput().(type) {
+	case *rpc.ProcessEvent_DataEvent_Stdout:
+		trimmed.Output = &rpc.ProcessEvent_DataEvent_Stdout{Stdout: output.Stdout[n:]}
+	case *rpc.ProcessEvent_DataEvent_Stderr:
+		trimmed.Output = &rpc.ProcessEvent_DataEvent_Stderr{Stderr: output.Stderr[n:]}
+	case *rpc.ProcessEvent_DataEvent_Pty:
+		trimmed.Output = &rpc.ProcessEvent_DataEvent_Pty{Pty: output.Pty[n:]}
+	}
+
+	return trimmed
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func stdout(data string) *rpc.ProcessEvent_DataEvent {
+	return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stdout{Stdout: []byte(data)}}
+}
+
+func stderr(data string) *rpc.ProcessEvent_DataEvent {
+	return &rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stderr{Stderr: []byte(data)}}
+}
+
+func TestOutputBufferSince(t *testing.T) {
+	b := newOutputBuffer(1024)
+
+	b.Write(stdout("hello "))
+	b.Write(stderr("error "))
+	b.Write(stdout("world"))
+
+	chunks, end := b.Since(0)
+	assert.Equal(t, uint64(17), end)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, uint64(0), chunks[0].GetOffset())
+	assert.Equal(t, uint64(6), chunks[1].GetOffset())
+	assert.Equal(t, []byte("error "), chunks[1].GetStderr())
+	assert.Equal(t, uint64(12), chunks[2].GetOffset())
+
+	// The offset in the middle of the chunk returns only the rest of the chunk
+	chunks, end = b.Since(8)
+	assert.Equal(t, uint64(17), end)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, uint64(8), chunks[0].GetOffset())
+	assert.Equal(t, []byte("ror "), chunks[0].GetStderr())
+	assert.Equal(t, []byte("world"), chunks[1].GetStdout())
+
+	chunks, end = b.Since(17)
+	assert.Equal(t, uint64(17), end)
+	assert.Empty(t, chunks)
+}
+
+func TestOutputBufferDropsOldest(t *testing.T) {
+	b := newOutputBuffer(10)
+
+	b.Write(stdout("aaaa"))
+	b.Write(stdout("bbbb"))
+	b.Write(stdout("cccc"))
+
+	// The dropped output is skipped, the replay starts at the oldest buffered chunk
+	chunks, end := b.Since(0)
+	assert.Equal(t, uint64(12), end)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, uint64(4), chunks[0].GetOffset())
+	assert.Equal(t, []byte("bbbb"), chunks[0].GetStdout())
+	assert.Equal(t, []byte("cccc"), chunks[1].GetStdout())
+
+	// The chunk larger than the buffer is kept until the next write
+	b.Write(stdout("dddddddddddd"))
+	chunks, _ = b.Since(0)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, uint64(12), chunks[0].GetOffset())
+}
+
+func TestOutputBufferSmallWrites(t *testing.T) {
+	b := newOutputBuffer(4096)
+
+	for range 10_000 {
+		// The chunks are read into the large buffers
+		buf := make([]byte, stdChunkSize)
+		n := copy(buf, "a line of output\n")
+
+		b.Write(&rpc.ProcessEvent_DataEvent{Output: &rpc.ProcessEvent_DataEvent_Stdout{Stdout: buf[:n]}})
+	}
+
+	chunks, end := b.Since(0)
+	assert.Equal(t, uint64(10_000*17), end)
+
+	// The buffer keeps only the copied data, not the whole read buffers.
+	// The copies are rounded up to the allocation size classes.
+	retained := 0
+	for _, chunk := range chunks {
+		retained += cap(chunkData(chunk))
+	}
+	assert.LessOrEqual(t, retained, 2*4096)
+}
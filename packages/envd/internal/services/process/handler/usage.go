@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"os"
+	"syscall"
+	"time"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+// blockSize is the unit of the block I/O counters in the rusage
+const blockSize = 512
+
+// processUsage returns the resources used by the exited process and its children that were waited for.
+func processUsage(state *os.ProcessState, wallTime time.Duration) *rpc.ProcessUsage {
+	usage := &rpc.ProcessUsage{
+		CpuUserMs:   uint64(state.UserTime().Milliseconds()),
+		CpuSystemMs: uint64(state.SystemTime().Milliseconds()),
+		WallTimeMs:  uint64(wallTime.Milliseconds()),
+	}
+
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok || rusage == nil {
+		return usage
+	}
+
+	// The max RSS is in kilobytes on Linux
+	usage.MaxRssBytes = uint64(rusage.Maxrss) * 1024
+	usage.IoReadBytes = uint64(rusage.Inblock) * blockSize
+	usage.IoWriteBytes = uint64(rusage.Oublock) * blockSize
+
+	return usage
+}
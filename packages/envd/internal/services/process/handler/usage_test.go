@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessUsage(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "i=0; while [ $i -lt 100000 ]; do i=$((i+1)); done")
+	require.NoError(t, cmd.Run())
+
+	usage := processUsage(cmd.ProcessState, 1500*time.Millisecond)
+
+	assert.Equal(t, uint64(1500), usage.GetWallTimeMs())
+	assert.Positive(t, usage.GetCpuUserMs()+usage.GetCpuSystemMs())
+	assert.Positive(t, usage.GetMaxRssBytes())
+}
@@ -13,10 +13,50 @@ func (s *Service) List(ctx context.Context, req *connect.Request[rpc.ListRequest
 	processes := make([]*rpc.ProcessInfo, 0)
 
 	s.processes.Range(func(pid uint32, value *handler.Handler) bool {
-		processes = append(processes, &rpc.ProcessInfo{
+		if !value.Matches(req.Msg.GetSelector()) {
+			return true
+		}
+
+		info := &rpc.ProcessInfo{
 			Pid:    pid,
 			Tag:    value.Tag,
 			Config: value.Config,
+			Limits: value.Limits,
+			Group:  value.Group,
+			Labels: value.Labels,
+		}
+
+		if svc := s.serviceOf(value); svc != nil {
+			info.Service = svc.req.GetService()
+			info.ServiceState = svc.State()
+		}
+
+		processes = append(processes, info)
+
+		return true
+	})
+
+	// The services waiting for the restart have no running process, they are listed with their last process
+	s.services.Range(func(_ uint64, svc *supervisedService) bool {
+		state := svc.State()
+		if state.GetStatus() != rpc.ServiceStatus_SERVICE_STATUS_BACKOFF {
+			return true
+		}
+
+		last := svc.process()
+		if !last.Matches(req.Msg.GetSelector()) {
+			return true
+		}
+
+		processes = append(processes, &rpc.ProcessInfo{
+			Pid:          last.Pid(),
+			Tag:          last.Tag,
+			Config:       last.Config,
+			Limits:       last.Limits,
+			Group:        last.Group,
+			Labels:       last.Labels,
+			Service:      svc.req.GetService(),
+			ServiceState: state,
 		})
 
 		return true
@@ -1,7 +1,11 @@
 package process
 
 import (
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/go-chi/chi/v5"
@@ -14,17 +18,28 @@ import (
 	"github.com/e2b-dev/infra/packages/envd/internal/utils"
 )
 
+// exitedProcessRetention is how long the clients can connect to the exited process to read the rest of its output
+const exitedProcessRetention = time.Minute
+
 type Service struct {
 	processes *utils.Map[uint32, *handler.Handler]
+	exited    *utils.Map[uint32, *handler.Handler]
 	logger    *zerolog.Logger
 	envVars   *utils.Map[string, string]
+
+	services       *utils.Map[uint64, *supervisedService]
+	serviceCounter atomic.Uint64
+	// servicesMu serializes the saving of the services
+	servicesMu sync.Mutex
 }
 
 func newService(l *zerolog.Logger, envVars *utils.Map[string, string]) *Service {
 	return &Service{
 		logger:    l,
 		processes: utils.NewMap[uint32, *handler.Handler](),
+		exited:    utils.NewMap[uint32, *handler.Handler](),
 		envVars:   envVars,
+		services:  utils.NewMap[uint64, *supervisedService](),
 	}
 }
 
@@ -37,15 +52,54 @@ func Handle(server *chi.Mux, l *zerolog.Logger, envVars *utils.Map[string, strin
 
 	server.Mount(path, h)
 
+	go service.restoreServices()
+
 	return service
 }
 
+// trackProcess keeps the started process until it exits, then it's kept for the exitedProcessRetention.
+func (s *Service) trackProcess(pid uint32, proc *handler.Handler) {
+	s.processes.Store(pid, proc)
+
+	go func() {
+		proc.Wait()
+
+		go s.reportProcessEnd(pid, proc)
+
+		s.exited.Store(pid, proc)
+		s.processes.CompareAndDelete(pid, proc)
+
+		time.AfterFunc(exitedProcessRetention, func() {
+			s.exited.CompareAndDelete(pid, proc)
+		})
+	}()
+}
+
 func (s *Service) getProcess(selector *rpc.ProcessSelector) (*handler.Handler, error) {
+	return findProcess(s.processes, selector)
+}
+
+// getProcessOrExited returns the running process or the recently exited one.
+func (s *Service) getProcessOrExited(selector *rpc.ProcessSelector) (*handler.Handler, error) {
+	proc, err := findProcess(s.processes, selector)
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
+		exited, exitedErr := findProcess(s.exited, selector)
+		if exitedErr == nil {
+			return exited, nil
+		}
+	}
+
+	return proc, err
+}
+
+func findProcess(processes *utils.Map[uint32, *handler.Handler], selector *rpc.ProcessSelector) (*handler.Handler, error) {
 	var proc *handler.Handler
 
 	switch selector.GetSelector().(type) {
 	case *rpc.ProcessSelector_Pid:
-		p, ok := s.processes.Load(selector.GetPid())
+		p, ok := processes.Load(selector.GetPid())
 		if !ok {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("process with pid %d not found", selector.GetPid()))
 		}
@@ -54,7 +108,7 @@ func (s *Service) getProcess(selector *rpc.ProcessSelector) (*handler.Handler, e
 	case *rpc.ProcessSelector_Tag:
 		tag := selector.GetTag()
 
-		s.processes.Range(func(_ uint32, value *handler.Handler) bool {
+		processes.Range(func(_ uint32, value *handler.Handler) bool {
 			if value.Tag == nil {
 				return true
 			}
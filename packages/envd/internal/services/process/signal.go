@@ -2,34 +2,102 @@ package process
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"syscall"
 
 	"connectrpc.com/connect"
 
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
 	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
 )
 
 func (s *Service) SendSignal(ctx context.Context, req *connect.Request[rpc.SendSignalRequest]) (*connect.Response[rpc.SendSignalResponse], error) {
-	handler, err := s.getProcess(req.Msg.Process)
+	signal, err := toSyscallSignal(req.Msg.GetSignal())
 	if err != nil {
 		return nil, err
 	}
 
-	var signal syscall.Signal
-	switch req.Msg.GetSignal() {
-	case rpc.Signal_SIGNAL_SIGKILL:
-		signal = syscall.SIGKILL
-	case rpc.Signal_SIGNAL_SIGTERM:
-		signal = syscall.SIGTERM
-	default:
-		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("invalid signal: %s", req.Msg.GetSignal()))
+	// The services are looked up first, the service waiting for its restart has no running process.
+	// The signalled service isn't restarted.
+	if svc := s.findService(req.Msg.Process); svc != nil {
+		svc.Stop()
+
+		proc := svc.process()
+		if proc.End() != nil {
+			return connect.NewResponse(&rpc.SendSignalResponse{}), nil
+		}
+
+		return sendSignal(proc, signal)
+	}
+
+	handler, err := s.getProcess(req.Msg.Process)
+	if err != nil {
+		return nil, err
 	}
 
-	err = handler.SendSignal(signal)
+	return sendSignal(handler, signal)
+}
+
+func sendSignal(handler *handler.Handler, signal syscall.Signal) (*connect.Response[rpc.SendSignalResponse], error) {
+	err := handler.SendSignal(signal)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error sending signal: %w", err))
 	}
 
 	return connect.NewResponse(&rpc.SendSignalResponse{}), nil
 }
+
+func (s *Service) SendGroupSignal(ctx context.Context, req *connect.Request[rpc.SendGroupSignalRequest]) (*connect.Response[rpc.SendGroupSignalResponse], error) {
+	selector := req.Msg.GetSelector()
+	// The empty selector would select all the processes
+	if selector.GetGroup() == "" && len(selector.GetLabels()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group or labels must be set"))
+	}
+
+	signal, err := toSyscallSignal(req.Msg.GetSignal())
+	if err != nil {
+		return nil, err
+	}
+
+	s.stopServices(selector)
+
+	pids := make([]uint32, 0)
+	var errs []error
+
+	s.processes.Range(func(pid uint32, value *handler.Handler) bool {
+		if !value.Matches(selector) {
+			return true
+		}
+
+		signalErr := value.SendGroupSignal(signal)
+		if signalErr != nil {
+			errs = append(errs, fmt.Errorf("process %d: %w", pid, signalErr))
+
+			return true
+		}
+
+		pids = append(pids, pid)
+
+		return true
+	})
+
+	if len(errs) > 0 {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error sending signal: %w", errors.Join(errs...)))
+	}
+
+	return connect.NewResponse(&rpc.SendGroupSignalResponse{
+		Pids: pids,
+	}), nil
+}
+
+func toSyscallSignal(signal rpc.Signal) (syscall.Signal, error) {
+	switch signal {
+	case rpc.Signal_SIGNAL_SIGKILL:
+		return syscall.SIGKILL, nil
+	case rpc.Signal_SIGNAL_SIGTERM:
+		return syscall.SIGTERM, nil
+	default:
+		return 0, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("invalid signal: %s", signal))
+	}
+}
@@ -41,13 +41,7 @@ func (s *Service) InitializeStartProcess(ctx context.Context, user *user.User, r
 		return err
 	}
 
-	s.processes.Store(pid, proc)
-
-	go func() {
-		defer s.processes.Delete(pid)
-
-		proc.Wait()
-	}()
+	s.trackProcess(pid, proc)
 
 	return nil
 }
@@ -72,10 +66,18 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	if req.Msg.Service != nil {
+		err = validateServiceConfig(req.Msg.GetService())
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid service config: %w", err))
+		}
+	}
+
 	// Create a new context with a timeout if provided.
 	// We do not want the command to be killed if the request context is cancelled
 	procCtx, cancelProc := context.Background(), func() {}
-	if timeout > 0 { // zero timeout means no timeout
+	// The services run until they are stopped, the request timeout applies only to the stream
+	if timeout > 0 && req.Msg.Service == nil { // zero timeout means no timeout
 		procCtx, cancelProc = context.WithTimeout(procCtx, timeout)
 	}
 
@@ -200,12 +202,25 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 		}
 	}()
 
+	// The supervision needs its own fork of the end events, the stream can end before the process
+	var serviceEnd chan rpc.ProcessEvent_End
+	serviceEndCancel := func() {}
+	if req.Msg.Service != nil {
+		serviceEnd, serviceEndCancel = proc.EndEvent.Fork()
+	}
+
 	pid, err := proc.Start()
 	if err != nil {
+		serviceEndCancel()
+
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	s.processes.Store(pid, proc)
+	s.trackProcess(pid, proc)
+
+	if req.Msg.Service != nil {
+		s.addService(u, req.Msg, proc, serviceEnd, serviceEndCancel)
+	}
 
 	start <- rpc.ProcessEvent_Start{
 		Start: &rpc.ProcessEvent_StartEvent{
@@ -213,12 +228,6 @@ func (s *Service) handleStart(ctx context.Context, req *connect.Request[rpc.Star
 		},
 	}
 
-	go func() {
-		defer s.processes.Delete(pid)
-
-		proc.Wait()
-	}()
-
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
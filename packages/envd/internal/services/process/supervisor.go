@@ -0,0 +1,510 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/e2b-dev/infra/packages/envd/internal/host"
+	"github.com/e2b-dev/infra/packages/envd/internal/permissions"
+	"github.com/e2b-dev/infra/packages/envd/internal/services/process/handler"
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+const (
+	defaultServiceBackoff    = time.Second
+	defaultServiceMaxBackoff = time.Minute
+	// serviceBackoffReset is how long the service has to run, so the next restart starts with the initial backoff again
+	serviceBackoffReset = 10 * time.Second
+)
+
+// servicesStatePath keeps the services, so they are started again when envd restarts.
+// The run directory is kept in the memory snapshot, the services continue running after the sandbox resume.
+var servicesStatePath = filepath.Join(host.E2BRunDir, "services.json")
+
+type supervisedService struct {
+	id   uint64
+	user *user.User
+	req  *rpc.StartRequest
+
+	mu       sync.Mutex
+	proc     *handler.Handler
+	restarts uint32
+	backoff  time.Duration
+	lastExit *int32
+	status   rpc.ServiceStatus
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+type storedService struct {
+	ID       uint64          `json:"id"`
+	Username string          `json:"username"`
+	Request  json.RawMessage `json:"request"`
+
+	// The last process of the service, it's stopped before the service is restored, so it doesn't run twice
+	Pid       uint32 `json:"pid,omitempty"`
+	StartTime uint64 `json:"start_time,omitempty"`
+	Cgroup    string `json:"cgroup,omitempty"`
+}
+
+func validateServiceConfig(config *rpc.ServiceConfig) error {
+	switch config.GetRestart() {
+	case rpc.RestartPolicy_RESTART_POLICY_ALWAYS, rpc.RestartPolicy_RESTART_POLICY_ON_FAILURE:
+	default:
+		return fmt.Errorf("invalid restart policy: %s", config.GetRestart())
+	}
+
+	if config.BackoffMs != nil && config.GetBackoffMs() == 0 {
+		return errors.New("backoff must be greater than 0")
+	}
+
+	if config.MaxBackoffMs != nil && config.GetMaxBackoffMs() < config.GetBackoffMs() {
+		return errors.New("max backoff must be greater than the backoff")
+	}
+
+	return nil
+}
+
+func newSupervisedService(id uint64, u *user.User, req *rpc.StartRequest) *supervisedService {
+	backoff := defaultServiceBackoff
+	if req.GetService().BackoffMs != nil {
+		backoff = time.Duration(req.GetService().GetBackoffMs()) * time.Millisecond
+	}
+
+	return &supervisedService{
+		id:      id,
+		user:    u,
+		req:     req,
+		backoff: backoff,
+		status:  rpc.ServiceStatus_SERVICE_STATUS_RUNNING,
+		stop:    make(chan struct{}),
+	}
+}
+
+// State returns the state of the service for the process info.
+func (svc *supervisedService) State() *rpc.ServiceState {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	return &rpc.ServiceState{
+		Status:       svc.status,
+		Restarts:     svc.restarts,
+		LastExitCode: svc.lastExit,
+	}
+}
+
+func (svc *supervisedService) process() *handler.Handler {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	return svc.proc
+}
+
+// Stop stops the restarts of the service, the running process isn't affected.
+func (svc *supervisedService) Stop() {
+	svc.stopOnce.Do(func() {
+		close(svc.stop)
+	})
+}
+
+func (svc *supervisedService) stopped() bool {
+	select {
+	case <-svc.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRestart decides by the restart policy and the count of restarts if the exited process is started again.
+func (svc *supervisedService) shouldRestart(end *rpc.ProcessEvent_EndEvent) bool {
+	if svc.stopped() {
+		return false
+	}
+
+	config := svc.req.GetService()
+	if config.MaxRestarts != nil && svc.restarts >= config.GetMaxRestarts() {
+		return false
+	}
+
+	if config.GetRestart() == rpc.RestartPolicy_RESTART_POLICY_ON_FAILURE {
+		return end == nil || !end.GetExited() || end.GetExitCode() != 0
+	}
+
+	return true
+}
+
+// nextBackoff returns the delay before the restart, the backoff grows only for the processes that exited shortly after the start.
+func (svc *supervisedService) nextBackoff(ranFor time.Duration) time.Duration {
+	initial := defaultServiceBackoff
+	if svc.req.GetService().BackoffMs != nil {
+		initial = time.Duration(svc.req.GetService().GetBackoffMs()) * time.Millisecond
+	}
+
+	maxBackoff := defaultServiceMaxBackoff
+	if svc.req.GetService().MaxBackoffMs != nil {
+		maxBackoff = time.Duration(svc.req.GetService().GetMaxBackoffMs()) * time.Millisecond
+	}
+
+	if ranFor >= serviceBackoffReset {
+		svc.backoff = initial
+	}
+
+	backoff := svc.backoff
+	svc.backoff = min(svc.backoff*2, maxBackoff)
+
+	return min(backoff, maxBackoff)
+}
+
+// addService starts the supervision of the started process, the process is restarted by the restart policy when it exits.
+// The end events must be forked before the process is started, so the end of the process isn't missed.
+func (s *Service) addService(u *user.User, req *rpc.StartRequest, proc *handler.Handler, end chan rpc.ProcessEvent_End, endCancel func()) {
+	svc := newSupervisedService(s.serviceCounter.Add(1), u, req)
+	svc.proc = proc
+
+	s.services.Store(svc.id, svc)
+	s.saveServices()
+
+	go s.supervise(svc, end, endCancel)
+}
+
+func (s *Service) supervise(svc *supervisedService, end chan rpc.ProcessEvent_End, endCancel func()) {
+	defer func() {
+		s.services.Delete(svc.id)
+		s.saveServices()
+	}()
+
+	startedAt := time.Now()
+
+	for {
+		var endEvent *rpc.ProcessEvent_EndEvent
+		select {
+		case event, ok := <-end:
+			if ok {
+				endEvent = event.End
+			}
+		case <-svc.stop:
+			// The stopped service isn't restarted, the running process is left to the signal that stopped it
+			endCancel()
+
+			return
+		}
+		endCancel()
+
+		svc.mu.Lock()
+		if endEvent != nil {
+			exitCode := endEvent.GetExitCode()
+			svc.lastExit = &exitCode
+		}
+
+		if !svc.shouldRestart(endEvent) {
+			svc.mu.Unlock()
+
+			return
+		}
+
+		backoff := svc.nextBackoff(time.Since(startedAt))
+		svc.status = rpc.ServiceStatus_SERVICE_STATUS_BACKOFF
+		svc.mu.Unlock()
+
+		s.logger.Info().
+			Str("event_type", "service_restart").
+			Str("command", svc.req.GetProcess().GetCmd()).
+			Dur("backoff", backoff).
+			Msg("Restarting the service after its process exited")
+
+		select {
+		case <-svc.stop:
+			return
+		case <-time.After(backoff):
+		}
+
+		var err error
+		end, endCancel, err = s.startServiceProcess(svc)
+		if err != nil {
+			s.logger.Error().Err(err).Str("command", svc.req.GetProcess().GetCmd()).Msg("Failed to restart the service")
+
+			// The failed start is retried like the failed process
+			closed := make(chan rpc.ProcessEvent_End)
+			close(closed)
+			end, endCancel = closed, func() {}
+		}
+
+		startedAt = time.Now()
+
+		svc.mu.Lock()
+		svc.restarts++
+		if err == nil {
+			svc.status = rpc.ServiceStatus_SERVICE_STATUS_RUNNING
+		}
+		svc.mu.Unlock()
+
+		// The saved process is replaced by the new one
+		s.saveServices()
+	}
+}
+
+// startServiceProcess starts the new process of the service with the same configuration as the first one.
+func (s *Service) startServiceProcess(svc *supervisedService) (chan rpc.ProcessEvent_End, func(), error) {
+	handlerL := s.logger.With().Str("service", svc.req.GetProcess().GetCmd()).Logger()
+
+	procCtx, cancelProc := context.WithCancel(context.Background())
+	proc, err := handler.New(procCtx, svc.user, svc.req, &handlerL, s.envVars, cancelProc)
+	if err != nil {
+		cancelProc()
+
+		return nil, nil, err
+	}
+
+	end, endCancel := proc.EndEvent.Fork()
+
+	pid, err := proc.Start()
+	if err != nil {
+		endCancel()
+		cancelProc()
+
+		return nil, nil, err
+	}
+
+	svc.mu.Lock()
+	svc.proc = proc
+	svc.mu.Unlock()
+
+	s.trackProcess(pid, proc)
+
+	return end, endCancel, nil
+}
+
+// serviceOf returns the service the process belongs to.
+func (s *Service) serviceOf(proc *handler.Handler) *supervisedService {
+	var found *supervisedService
+
+	s.services.Range(func(_ uint64, svc *supervisedService) bool {
+		if svc.process() == proc {
+			found = svc
+
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// findService returns the service selected by the pid of its last process or by its tag.
+func (s *Service) findService(selector *rpc.ProcessSelector) *supervisedService {
+	var found *supervisedService
+
+	s.services.Range(func(_ uint64, svc *supervisedService) bool {
+		switch selector.GetSelector().(type) {
+		case *rpc.ProcessSelector_Pid:
+			if svc.process().Pid() == selector.GetPid() {
+				found = svc
+			}
+		case *rpc.ProcessSelector_Tag:
+			if svc.req.Tag != nil && svc.req.GetTag() == selector.GetTag() {
+				found = svc
+			}
+		}
+
+		return found == nil
+	})
+
+	return found
+}
+
+// stopServices stops the restarts of the services matching the selector.
+func (s *Service) stopServices(selector *rpc.ProcessGroupSelector) {
+	s.services.Range(func(_ uint64, svc *supervisedService) bool {
+		if svc.process().Matches(selector) {
+			svc.Stop()
+		}
+
+		return true
+	})
+}
+
+// saveServices keeps the configuration of the services, so they are started again when envd restarts.
+func (s *Service) saveServices() {
+	s.servicesMu.Lock()
+	defer s.servicesMu.Unlock()
+
+	stored := make([]storedService, 0)
+
+	s.services.Range(func(_ uint64, svc *supervisedService) bool {
+		req, err := protojson.Marshal(svc.req)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to marshal the service request")
+
+			return true
+		}
+
+		service := storedService{
+			ID:       svc.id,
+			Username: svc.user.Username,
+			Request:  req,
+		}
+
+		proc := svc.process()
+		if proc.End() == nil {
+			service.Pid = proc.Pid()
+			service.StartTime, _ = processStartTime(service.Pid)
+			service.Cgroup = proc.CgroupPath()
+		}
+
+		stored = append(stored, service)
+
+		return true
+	})
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to marshal the services")
+
+		return
+	}
+
+	tmpPath := servicesStatePath + ".tmp"
+
+	err = os.WriteFile(tmpPath, data, 0o600)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to save the services")
+
+		return
+	}
+
+	err = os.Rename(tmpPath, servicesStatePath)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to save the services")
+	}
+}
+
+// restoreServices starts the services saved before envd restarted.
+func (s *Service) restoreServices() {
+	data, err := os.ReadFile(servicesStatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	} else if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to read the saved services")
+
+		return
+	}
+
+	var stored []storedService
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("Failed to parse the saved services")
+
+		return
+	}
+
+	for _, service := range stored {
+		err := s.restoreService(service)
+		if err != nil {
+			s.logger.Error().Err(err).Uint64("service_id", service.ID).Msg("Failed to restore the service")
+		}
+	}
+
+	// The services that failed to start are dropped
+	s.saveServices()
+}
+
+func (s *Service) restoreService(service storedService) error {
+	req := &rpc.StartRequest{}
+
+	err := protojson.Unmarshal(service.Request, req)
+	if err != nil {
+		return fmt.Errorf("failed to parse the service request: %w", err)
+	}
+
+	u, err := permissions.GetUser(service.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user '%s': %w", service.Username, err)
+	}
+
+	// The process of the service survives the envd restart, it's stopped so the service doesn't run twice
+	err = stopServiceProcess(service)
+	if err != nil {
+		return fmt.Errorf("failed to stop the previous process of the service: %w", err)
+	}
+
+	svc := newSupervisedService(s.serviceCounter.Add(1), u, req)
+
+	end, endCancel, err := s.startServiceProcess(svc)
+	if err != nil {
+		return fmt.Errorf("failed to start the service: %w", err)
+	}
+
+	s.services.Store(svc.id, svc)
+
+	go s.supervise(svc, end, endCancel)
+
+	return nil
+}
+
+// stopServiceProcess kills the process of the service started by the previous envd with all its descendants.
+// The start time is compared, so the process that reused the pid isn't killed.
+func stopServiceProcess(service storedService) error {
+	if service.Cgroup != "" {
+		err := handler.KillCgroup(service.Cgroup)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	if service.Pid == 0 {
+		return nil
+	}
+
+	startTime, err := processStartTime(service.Pid)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && startTime != service.StartTime) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// The process is the leader of its process group, so the group ID is its pid
+	err = syscall.Kill(-int(service.Pid), syscall.SIGKILL)
+	if err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("failed to kill process %d: %w", service.Pid, err)
+	}
+
+	return nil
+}
+
+// processStartTime returns the start time of the process in clock ticks since the boot.
+func processStartTime(pid uint32) (uint64, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	// The command can contain spaces and parentheses, the fields are parsed after its end
+	end := bytes.LastIndexByte(data, ')')
+	if end < 0 {
+		return 0, fmt.Errorf("invalid stat of process %d", pid)
+	}
+
+	// The start time is the 22nd field, the fields after the command start with the 3rd one
+	fields := strings.Fields(string(data[end+1:]))
+	if len(fields) < 20 {
+		return 0, fmt.Errorf("invalid stat of process %d", pid)
+	}
+
+	return strconv.ParseUint(fields[19], 10, 64)
+}
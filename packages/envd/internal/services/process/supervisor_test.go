@@ -0,0 +1,124 @@
+package process
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/e2b-dev/infra/packages/envd/internal/services/spec/process"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestValidateServiceConfig(t *testing.T) {
+	assert.NoError(t, validateServiceConfig(&rpc.ServiceConfig{Restart: rpc.RestartPolicy_RESTART_POLICY_ALWAYS}))
+	assert.NoError(t, validateServiceConfig(&rpc.ServiceConfig{
+		Restart:      rpc.RestartPolicy_RESTART_POLICY_ON_FAILURE,
+		BackoffMs:    ptr[uint32](100),
+		MaxBackoffMs: ptr[uint32](1000),
+	}))
+
+	assert.Error(t, validateServiceConfig(&rpc.ServiceConfig{}))
+	assert.Error(t, validateServiceConfig(&rpc.ServiceConfig{
+		Restart:   rpc.RestartPolicy_RESTART_POLICY_ALWAYS,
+		BackoffMs: ptr[uint32](0),
+	}))
+	assert.Error(t, validateServiceConfig(&rpc.ServiceConfig{
+		Restart:      rpc.RestartPolicy_RESTART_POLICY_ALWAYS,
+		BackoffMs:    ptr[uint32](1000),
+		MaxBackoffMs: ptr[uint32](100),
+	}))
+}
+
+func TestServiceShouldRestart(t *testing.T) {
+	success := &rpc.ProcessEvent_EndEvent{Exited: true, ExitCode: 0}
+	failure := &rpc.ProcessEvent_EndEvent{Exited: true, ExitCode: 1}
+
+	always := newSupervisedService(1, nil, &rpc.StartRequest{
+		Service: &rpc.ServiceConfig{Restart: rpc.RestartPolicy_RESTART_POLICY_ALWAYS},
+	})
+	assert.True(t, always.shouldRestart(success))
+	assert.True(t, always.shouldRestart(failure))
+
+	onFailure := newSupervisedService(2, nil, &rpc.StartRequest{
+		Service: &rpc.ServiceConfig{
+			Restart:     rpc.RestartPolicy_RESTART_POLICY_ON_FAILURE,
+			MaxRestarts: ptr[uint32](2),
+		},
+	})
+	assert.False(t, onFailure.shouldRestart(success))
+	assert.True(t, onFailure.shouldRestart(failure))
+	// The process that failed to start has no end event
+	assert.True(t, onFailure.shouldRestart(nil))
+
+	onFailure.restarts = 2
+	assert.False(t, onFailure.shouldRestart(failure))
+
+	always.Stop()
+	assert.False(t, always.shouldRestart(failure))
+}
+
+func TestServiceNextBackoff(t *testing.T) {
+	svc := newSupervisedService(1, nil, &rpc.StartRequest{
+		Service: &rpc.ServiceConfig{
+			Restart:      rpc.RestartPolicy_RESTART_POLICY_ALWAYS,
+			BackoffMs:    ptr[uint32](100),
+			MaxBackoffMs: ptr[uint32](300),
+		},
+	})
+
+	assert.Equal(t, 100*time.Millisecond, svc.nextBackoff(0))
+	assert.Equal(t, 200*time.Millisecond, svc.nextBackoff(0))
+	assert.Equal(t, 300*time.Millisecond, svc.nextBackoff(0))
+	assert.Equal(t, 300*time.Millisecond, svc.nextBackoff(0))
+
+	// The process running long enough resets the backoff
+	assert.Equal(t, 100*time.Millisecond, svc.nextBackoff(serviceBackoffReset))
+}
+
+func TestProcessStartTime(t *testing.T) {
+	startTime, err := processStartTime(uint32(os.Getpid()))
+	require.NoError(t, err)
+	assert.Positive(t, startTime)
+
+	_, err = processStartTime(0)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestStopServiceProcess(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	require.NoError(t, cmd.Start())
+
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+
+	pid := uint32(cmd.Process.Pid)
+	startTime, err := processStartTime(pid)
+	require.NoError(t, err)
+
+	// The process that reused the pid isn't killed
+	require.NoError(t, stopServiceProcess(storedService{Pid: pid, StartTime: startTime + 1}))
+	select {
+	case <-exited:
+		t.Fatal("the process with a different start time was killed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, stopServiceProcess(storedService{Pid: pid, StartTime: startTime}))
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the process of the service wasn't killed")
+	}
+}
@@ -47,6 +47,8 @@ const (
 	ProcessSendInputProcedure = "/process.Process/SendInput"
 	// ProcessSendSignalProcedure is the fully-qualified name of the Process's SendSignal RPC.
 	ProcessSendSignalProcedure = "/process.Process/SendSignal"
+	// ProcessSendGroupSignalProcedure is the fully-qualified name of the Process's SendGroupSignal RPC.
+	ProcessSendGroupSignalProcedure = "/process.Process/SendGroupSignal"
 )
 
 // ProcessClient is a client for the process.Process service.
@@ -59,6 +61,8 @@ type ProcessClient interface {
 	StreamInput(context.Context) *connect.ClientStreamForClient[process.StreamInputRequest, process.StreamInputResponse]
 	SendInput(context.Context, *connect.Request[process.SendInputRequest]) (*connect.Response[process.SendInputResponse], error)
 	SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error)
+	// Sends the signal to the process groups of all the selected processes, so their descendants get it too
+	SendGroupSignal(context.Context, *connect.Request[process.SendGroupSignalRequest]) (*connect.Response[process.SendGroupSignalResponse], error)
 }
 
 // NewProcessClient constructs a client for the process.Process service. By default, it uses the
@@ -114,18 +118,25 @@ func NewProcessClient(httpClient connect.HTTPClient, baseURL string, opts ...con
 			connect.WithSchema(processMethods.ByName("SendSignal")),
 			connect.WithClientOptions(opts...),
 		),
+		sendGroupSignal: connect.NewClient[process.SendGroupSignalRequest, process.SendGroupSignalResponse](
+			httpClient,
+			baseURL+ProcessSendGroupSignalProcedure,
+			connect.WithSchema(processMethods.ByName("SendGroupSignal")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // processClient implements ProcessClient.
 type processClient struct {
-	list        *connect.Client[process.ListRequest, process.ListResponse]
-	connect     *connect.Client[process.ConnectRequest, process.ConnectResponse]
-	start       *connect.Client[process.StartRequest, process.StartResponse]
-	update      *connect.Client[process.UpdateRequest, process.UpdateResponse]
-	streamInput *connect.Client[process.StreamInputRequest, process.StreamInputResponse]
-	sendInput   *connect.Client[process.SendInputRequest, process.SendInputResponse]
-	sendSignal  *connect.Client[process.SendSignalRequest, process.SendSignalResponse]
+	list            *connect.Client[process.ListRequest, process.ListResponse]
+	connect         *connect.Client[process.ConnectRequest, process.ConnectResponse]
+	start           *connect.Client[process.StartRequest, process.StartResponse]
+	update          *connect.Client[process.UpdateRequest, process.UpdateResponse]
+	streamInput     *connect.Client[process.StreamInputRequest, process.StreamInputResponse]
+	sendInput       *connect.Client[process.SendInputRequest, process.SendInputResponse]
+	sendSignal      *connect.Client[process.SendSignalRequest, process.SendSignalResponse]
+	sendGroupSignal *connect.Client[process.SendGroupSignalRequest, process.SendGroupSignalResponse]
 }
 
 // List calls process.Process.List.
@@ -163,6 +174,11 @@ func (c *processClient) SendSignal(ctx context.Context, req *connect.Request[pro
 	return c.sendSignal.CallUnary(ctx, req)
 }
 
+// SendGroupSignal calls process.Process.SendGroupSignal.
+func (c *processClient) SendGroupSignal(ctx context.Context, req *connect.Request[process.SendGroupSignalRequest]) (*connect.Response[process.SendGroupSignalResponse], error) {
+	return c.sendGroupSignal.CallUnary(ctx, req)
+}
+
 // ProcessHandler is an implementation of the process.Process service.
 type ProcessHandler interface {
 	List(context.Context, *connect.Request[process.ListRequest]) (*connect.Response[process.ListResponse], error)
@@ -173,6 +189,8 @@ type ProcessHandler interface {
 	StreamInput(context.Context, *connect.ClientStream[process.StreamInputRequest]) (*connect.Response[process.StreamInputResponse], error)
 	SendInput(context.Context, *connect.Request[process.SendInputRequest]) (*connect.Response[process.SendInputResponse], error)
 	SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error)
+	// Sends the signal to the process groups of all the selected processes, so their descendants get it too
+	SendGroupSignal(context.Context, *connect.Request[process.SendGroupSignalRequest]) (*connect.Response[process.SendGroupSignalResponse], error)
 }
 
 // NewProcessHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -224,6 +242,12 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 		connect.WithSchema(processMethods.ByName("SendSignal")),
 		connect.WithHandlerOptions(opts...),
 	)
+	processSendGroupSignalHandler := connect.NewUnaryHandler(
+		ProcessSendGroupSignalProcedure,
+		svc.SendGroupSignal,
+		connect.WithSchema(processMethods.ByName("SendGroupSignal")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/process.Process/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProcessListProcedure:
@@ -240,6 +264,8 @@ func NewProcessHandler(svc ProcessHandler, opts ...connect.HandlerOption) (strin
 			processSendInputHandler.ServeHTTP(w, r)
 		case ProcessSendSignalProcedure:
 			processSendSignalHandler.ServeHTTP(w, r)
+		case ProcessSendGroupSignalProcedure:
+			processSendGroupSignalHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -276,3 +302,7 @@ func (UnimplementedProcessHandler) SendInput(context.Context, *connect.Request[p
 func (UnimplementedProcessHandler) SendSignal(context.Context, *connect.Request[process.SendSignalRequest]) (*connect.Response[process.SendSignalResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.SendSignal is not implemented"))
 }
+
+func (UnimplementedProcessHandler) SendGroupSignal(context.Context, *connect.Request[process.SendGroupSignalRequest]) (*connect.Response[process.SendGroupSignalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("process.Process.SendGroupSignal is not implemented"))
+}
@@ -16,6 +16,11 @@ func (m *Map[K, V]) Delete(key K) {
 	m.m.Delete(key)
 }
 
+// CompareAndDelete deletes the value for the key only if it's the old value, the values must be comparable.
+func (m *Map[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	return m.m.CompareAndDelete(key, old)
+}
+
 func (m *Map[K, V]) Load(key K) (value V, ok bool) {
 	v, ok := m.m.Load(key)
 	if !ok {
@@ -38,7 +38,7 @@ const (
 )
 
 var (
-	Version = "0.3.3"
+	Version = "0.3.11"
 
 	commitSHA string
 
@@ -190,7 +190,7 @@ func buildTemplate(
 		KernelVersion:      kernelVersion,
 		FirecrackerVersion: fcVersion,
 	}
-	_, err = builder.Build(ctx, metadata, template, logger.Core())
+	_, err = builder.Build(ctx, metadata, template, logger.Core(), nil)
 	if err != nil {
 		return fmt.Errorf("error building template: %w", err)
 	}
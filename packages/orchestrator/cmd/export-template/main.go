@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/export"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func main() {
+	templateID := flag.String("template", "", "template id")
+	buildID := flag.String("build", "", "build id")
+	arch := flag.String("arch", consts.DefaultCPUArchitecture, "cpu architecture of the build ('amd64' or 'arm64')")
+	output := flag.String("output", "", "path of the image tarball")
+	push := flag.Bool("push", false, "push the image to the registry of the tag instead of writing a tarball")
+	tag := flag.String("tag", "", "image tag, defaults to <template>:<build>")
+
+	flag.Parse()
+
+	if *buildID == "" {
+		log.Fatal("build id is required")
+	}
+
+	if !*push && *output == "" {
+		log.Fatal("output path is required when not pushing the image")
+	}
+
+	if *tag == "" {
+		if *templateID == "" {
+			log.Fatal("template id or tag is required")
+		}
+
+		*tag = export.DefaultTag(*templateID, *buildID)
+	}
+
+	ctx := context.Background()
+
+	s, err := storage.GetTemplateStorageProvider(ctx, nil)
+	if err != nil {
+		log.Fatalf("failed to get storage provider: %s", err)
+	}
+
+	dir, err := os.MkdirTemp("", "template-export")
+	if err != nil {
+		log.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	img, err := export.Image(ctx, s, *buildID, *arch, dir)
+	if err != nil {
+		log.Fatalf("failed to export image: %s", err)
+	}
+
+	if *push {
+		err = export.Push(ctx, img, *tag)
+		if err != nil {
+			log.Fatalf("failed to push image: %s", err)
+		}
+
+		fmt.Printf("Pushed %s\n", *tag)
+
+		return
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatalf("failed to create output file: %s", err)
+	}
+	defer f.Close()
+
+	err = export.WriteTarball(img, *tag, f)
+	if err != nil {
+		log.Fatalf("failed to write image: %s", err)
+	}
+
+	fmt.Printf("Exported %s to %s\n", *tag, *output)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events/event"
+	api "github.com/e2b-dev/infra/packages/shared/pkg/http/hyperloop"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+func (h *APIStore) ProcessEnd(c *gin.Context) {
+	sbx, err := h.findSandbox(c)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Error when finding source sandbox")
+		h.logger.Error("error finding sandbox for source addr", zap.String("addr", c.Request.RemoteAddr), zap.Error(err))
+		return
+	}
+
+	sbxID := sbx.Runtime.SandboxID
+
+	if !h.allowProcessEvent(sbx) {
+		h.sendAPIStoreError(c, http.StatusTooManyRequests, "Too many process events")
+		return
+	}
+
+	var body api.ProcessEnd
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.sendAPIStoreError(c, http.StatusBadRequest, "Invalid body for process end")
+		h.logger.Error("error when parsing process end request", zap.Error(err), logger.WithSandboxID(sbxID))
+		return
+	}
+
+	teamID, err := uuid.Parse(sbx.Runtime.TeamID)
+	if err != nil {
+		h.sendAPIStoreError(c, http.StatusInternalServerError, "Error when parsing sandbox team ID")
+		h.logger.Error("error parsing team ID", zap.String("team_id", sbx.Runtime.TeamID), zap.Error(err), logger.WithSandboxID(sbxID))
+		return
+	}
+
+	buildID := ""
+	if config := sbx.StoredConfig(); config != nil {
+		buildID = config.BuildId
+	}
+
+	// The usage is measured inside the sandbox, it's marked so it isn't trusted like the host metrics.
+	eventData := map[string]any{
+		"guest_reported": true,
+		"pid":            body.Pid,
+		"cmd":            body.Cmd,
+		"exit_code":      body.ExitCode,
+		"exited":         body.Exited,
+		"status":         body.Status,
+		"usage": map[string]any{
+			"cpu_user_ms":    body.Usage.CpuUserMs,
+			"cpu_system_ms":  body.Usage.CpuSystemMs,
+			"max_rss_bytes":  body.Usage.MaxRssBytes,
+			"io_read_bytes":  body.Usage.IoReadBytes,
+			"io_write_bytes": body.Usage.IoWriteBytes,
+			"wall_time_ms":   body.Usage.WallTimeMs,
+		},
+	}
+
+	if body.Tag != nil {
+		eventData["tag"] = *body.Tag
+	}
+
+	if body.LimitExceeded != nil {
+		eventData["limit_exceeded"] = *body.LimitExceeded
+	}
+
+	h.sbxEventsService.HandleEvent(context.WithoutCancel(c), event.SandboxEvent{
+		Timestamp:          time.Now().UTC(),
+		SandboxID:          sbxID,
+		SandboxExecutionID: sbx.Runtime.ExecutionID,
+		SandboxTemplateID:  sbx.Config.BaseTemplateID,
+		SandboxBuildID:     buildID,
+		SandboxTeamID:      teamID,
+		EventCategory:      string(clickhouse.SandboxEventCategoryProcess),
+		EventLabel:         string(clickhouse.SandboxEventLabelProcessEnd),
+		EventData:          eventData,
+	})
+
+	c.Status(http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -8,35 +9,70 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jellydator/ttlcache/v3"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/events"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events/event"
 	api "github.com/e2b-dev/infra/packages/shared/pkg/http/hyperloop"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
-const CollectorExporterTimeout = 10 * time.Second
+const (
+	CollectorExporterTimeout = 10 * time.Second
+
+	// The process events are sent by the guest, the limit keeps a sandbox from flooding the events storage.
+	processEventsRate       = rate.Limit(10)
+	processEventsBurst      = 100
+	processEventsLimiterTTL = 10 * time.Minute
+)
 
 type APIStore struct {
 	logger    *zap.Logger
 	sandboxes *smap.Map[*sandbox.Sandbox]
 
+	sbxEventsService events.EventsService[event.SandboxEvent]
+
 	collectorClient http.Client
 	collectorAddr   string
+
+	// Process events limiters by the sandbox execution ID
+	processEventsLimiters *ttlcache.Cache[string, *rate.Limiter]
 }
 
-func NewHyperloopStore(logger *zap.Logger, sandboxes *smap.Map[*sandbox.Sandbox], sandboxCollectorAddr string) *APIStore {
+func NewHyperloopStore(ctx context.Context, logger *zap.Logger, sandboxes *smap.Map[*sandbox.Sandbox], sbxEventsService events.EventsService[event.SandboxEvent], sandboxCollectorAddr string) *APIStore {
+	processEventsLimiters := ttlcache.New(
+		ttlcache.WithTTL[string, *rate.Limiter](processEventsLimiterTTL),
+	)
+	go processEventsLimiters.Start()
+	context.AfterFunc(ctx, processEventsLimiters.Stop)
+
 	return &APIStore{
-		logger:    logger,
-		sandboxes: sandboxes,
+		logger:           logger,
+		sandboxes:        sandboxes,
+		sbxEventsService: sbxEventsService,
 
 		collectorAddr: sandboxCollectorAddr,
 		collectorClient: http.Client{
 			Timeout: CollectorExporterTimeout,
 		},
+
+		processEventsLimiters: processEventsLimiters,
 	}
 }
 
+// allowProcessEvent reports whether the sandbox is still within its process events rate.
+func (h *APIStore) allowProcessEvent(sbx *sandbox.Sandbox) bool {
+	item, _ := h.processEventsLimiters.GetOrSet(
+		sbx.Runtime.ExecutionID,
+		rate.NewLimiter(processEventsRate, processEventsBurst),
+	)
+
+	return item.Value().Allow()
+}
+
 func (h *APIStore) findSandbox(req *gin.Context) (*sandbox.Sandbox, error) {
 	reqIP, _, err := net.SplitHostPort(req.Request.RemoteAddr)
 	if err != nil {
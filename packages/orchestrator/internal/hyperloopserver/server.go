@@ -11,18 +11,20 @@ import (
 	middleware "github.com/oapi-codegen/gin-middleware"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/events"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/hyperloopserver/handlers"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/events/event"
 	api "github.com/e2b-dev/infra/packages/shared/pkg/http/hyperloop"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
 const maxUploadLimit = 1 << 28 // 256 MiB
 
-func NewHyperloopServer(ctx context.Context, port uint, logger *zap.Logger, sandboxes *smap.Map[*sandbox.Sandbox]) (*http.Server, error) {
+func NewHyperloopServer(ctx context.Context, port uint, logger *zap.Logger, sandboxes *smap.Map[*sandbox.Sandbox], sbxEventsService events.EventsService[event.SandboxEvent]) (*http.Server, error) {
 	sandboxCollectorAddr := env.LogsCollectorAddress()
-	store := handlers.NewHyperloopStore(logger, sandboxes, sandboxCollectorAddr)
+	store := handlers.NewHyperloopStore(ctx, logger, sandboxes, sbxEventsService, sandboxCollectorAddr)
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("error getting swagger spec: %w", err)
@@ -60,6 +60,8 @@ type SandboxObserver struct {
 	memoryUsed  metric.Int64ObservableGauge
 	diskTotal   metric.Int64ObservableGauge
 	diskUsed    metric.Int64ObservableGauge
+	networkRx   metric.Int64ObservableGauge
+	networkTx   metric.Int64ObservableGauge
 }
 
 func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit, serviceVersion, serviceInstanceID string, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxObserver, error) {
@@ -118,6 +120,16 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		return nil, fmt.Errorf("failed to create disk used gauge: %w", err)
 	}
 
+	networkRx, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkRxGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network rx gauge: %w", err)
+	}
+
+	networkTx, err := telemetry.GetGaugeInt(meter, telemetry.SandboxNetworkTxGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network tx gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
 		exportInterval: sandboxMetricExportPeriod,
 		meterExporter:  externalMeterExporter,
@@ -129,6 +141,8 @@ func NewSandboxObserver(ctx context.Context, nodeID, serviceName, serviceCommit,
 		memoryUsed:     memoryUsed,
 		diskTotal:      diskTotal,
 		diskUsed:       diskUsed,
+		networkRx:      networkRx,
+		networkTx:      networkTx,
 	}
 
 	registration, err := so.startObserving()
@@ -237,6 +251,15 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 						o.ObserveInt64(so.diskUsed, sbxMetrics.DiskUsed, attributes)
 					}
 
+					// Network traffic is read from the host side of the sandbox network, so it doesn't depend on the envd version
+					networkStats, err := sbx.Slot.Stats()
+					if err != nil {
+						zap.L().Warn("Failed to get sandbox network stats", zap.Error(err), logger.WithSandboxID(sbx.Runtime.SandboxID))
+					} else {
+						o.ObserveInt64(so.networkRx, int64(networkStats.RxBytes), attributes)
+						o.ObserveInt64(so.networkTx, int64(networkStats.TxBytes), attributes)
+					}
+
 					// Log warnings if memory or CPU usage exceeds thresholds
 					// Round percentage to 2 decimal places
 					memUsedPct := float32(math.Floor(float64(memoryUsed)/float64(memoryTotal)*10000) / 100)
@@ -264,7 +287,7 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 			}
 
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed)
+		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.diskTotal, so.diskUsed, so.networkRx, so.networkTx)
 	if err != nil {
 		return nil, err
 	}
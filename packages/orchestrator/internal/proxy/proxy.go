@@ -44,6 +44,14 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				return nil, reverseproxy.NewErrSandboxNotFound(sandboxId)
 			}
 
+			err = sbx.Ingress().Authorize(r, sandboxId, port)
+			if err != nil {
+				return nil, err
+			}
+
+			// The token is used only by the proxy, it shouldn't be visible to the services in the sandbox.
+			reverseproxy.RemoveTrafficAccessToken(r)
+
 			url := &url.URL{
 				Scheme: "http",
 				Host:   fmt.Sprintf("%s:%d", sbx.Slot.HostIPString(), port),
@@ -58,6 +66,7 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				// We need to include id unique to sandbox to prevent reuse of connection to the same IP:port pair by different sandboxes reusing the network slot.
 				// We are not using sandbox id to prevent removing connections based on sandbox id (pause/resume race condition).
 				ConnectionKey: sbx.Runtime.ExecutionID,
+				Activity:      sbx.Activity(),
 				RequestLogger: zap.L().With(
 					zap.String("host", r.Host),
 					logger.WithSandboxID(sbx.Runtime.SandboxID),
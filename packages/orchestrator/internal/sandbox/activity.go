@@ -0,0 +1,58 @@
+package sandbox
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/tracking"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+const (
+	activityCheckTimeout = 100 * time.Millisecond
+
+	// minEnvdVersionForProcessActivity is the first envd version reporting the last process activity in the metrics.
+	minEnvdVersionForProcessActivity = "0.3.6"
+)
+
+// idleCPUThreshold is the CPU usage in percent under which the sandbox is considered idle.
+var idleCPUThreshold = utils.Must(strconv.ParseFloat(env.GetEnv("SANDBOX_IDLE_CPU_THRESHOLD", "5"), 64))
+
+// Activity returns the activity of the sandbox, it's marked by the proxy traffic to the sandbox too.
+func (s *Sandbox) Activity() *tracking.Activity {
+	return &s.activity
+}
+
+// checkActivity marks the sandbox as active when the CPU usage is over the idle threshold or envd had any process activity since the last check.
+func (c *Checks) checkActivity(ctx context.Context) {
+	metrics, err := c.GetMetrics(ctx, activityCheckTimeout)
+	if err != nil {
+		sbxlogger.I(c.sandbox).Debug("failed to get metrics for the activity check", zap.Error(err))
+
+		return
+	}
+
+	active := metrics.CPUUsedPercent >= idleCPUThreshold
+
+	ok, err := utils.IsGTEVersion(c.sandbox.Config.Envd.Version, minEnvdVersionForProcessActivity)
+	if err != nil {
+		sbxlogger.I(c.sandbox).Warn("failed to check envd version for the process activity", zap.Error(err))
+	}
+
+	if ok {
+		if metrics.LastProcessActivity > c.lastProcessActivity {
+			active = true
+		}
+
+		c.lastProcessActivity = metrics.LastProcessActivity
+	}
+
+	if active {
+		c.sandbox.activity.Mark()
+	}
+}
@@ -19,6 +19,7 @@ func (NoDiffError) Error() string {
 const (
 	Memfile DiffType = storage.MemfileName
 	Rootfs  DiffType = storage.RootfsName
+	Volume  DiffType = "volume"
 )
 
 type Diff interface {
@@ -17,6 +17,8 @@ var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/interna
 const (
 	healthCheckInterval = 20 * time.Second
 	healthCheckTimeout  = 100 * time.Millisecond
+
+	activityCheckInterval = 30 * time.Second
 )
 
 type Checks struct {
@@ -26,6 +28,9 @@ type Checks struct {
 
 	healthy atomic.Bool
 
+	// lastProcessActivity is the last process activity reported by envd, used only by the activity checks.
+	lastProcessActivity int64
+
 	UseClickhouseMetrics bool
 }
 
@@ -48,6 +53,10 @@ func NewChecks(sandbox *Sandbox, useClickhouseMetrics bool) *Checks {
 func (c *Checks) Start(ctx context.Context) {
 	ctx, c.cancelCtx = context.WithCancelCause(ctx)
 
+	if c.sandbox.Config.IdleTimeout > 0 {
+		go c.watchActivity(ctx)
+	}
+
 	c.logHealth(ctx)
 }
 
@@ -103,3 +112,17 @@ func (c *Checks) Healthcheck(ctx context.Context, alwaysReport bool) {
 		}
 	}
 }
+
+func (c *Checks) watchActivity(ctx context.Context) {
+	activityTicker := time.NewTicker(activityCheckInterval)
+	defer activityTicker.Stop()
+
+	for {
+		select {
+		case <-activityTicker.C:
+			c.checkActivity(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
@@ -86,11 +87,16 @@ func (c *Cleanup) run(ctx context.Context) {
 func cleanupFiles(files *storage.SandboxFiles) error {
 	var errs []error
 
-	for _, p := range []string{
+	paths := []string{
 		files.SandboxFirecrackerSocketPath(),
 		files.SandboxUffdSocketPath(),
 		files.SandboxCacheRootfsLinkPath(),
-	} {
+	}
+	for i := range fc.VolumeDrives {
+		paths = append(paths, files.SandboxCacheVolumeLinkPath(i))
+	}
+
+	for _, p := range paths {
 		err := os.RemoveAll(p)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to delete '%s': %w", p, err))
@@ -0,0 +1,102 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process/processconnect"
+)
+
+const (
+	// defaultExecUser is the default user of the sandbox templates, it's used when the request has no user.
+	defaultExecUser = "user"
+
+	// execOutputLimit is the size of the output tail kept from the command.
+	execOutputLimit = 4096
+)
+
+// execHTTPClient is shared by the commands, so the connections to envd are reused.
+// The command length is limited by the context, only the connection and the start of the command have timeouts.
+var execHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ResponseHeaderTimeout: defaultEnvdTimeout,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConnsPerHost:   4,
+	},
+}
+
+type ExecResult struct {
+	ExitCode int32
+	Output   string
+}
+
+// Exec runs the command in the sandbox through envd and waits until it exits.
+// The command is stopped when the context is done.
+func (s *Sandbox) Exec(ctx context.Context, command string, user string) (*ExecResult, error) {
+	ctx, span := tracer.Start(ctx, "sandbox-exec")
+	defer span.End()
+
+	if user == "" {
+		user = defaultExecUser
+	}
+
+	req := connect.NewRequest(&process.StartRequest{
+		Process: &process.ProcessConfig{
+			Cmd:  "/bin/bash",
+			Args: []string{"-l", "-c", command},
+		},
+	})
+	grpc.SetUserHeader(req.Header(), user)
+	if s.Config.Envd.AccessToken != nil {
+		req.Header().Set("X-Access-Token", *s.Config.Envd.AccessToken)
+	}
+
+	address := fmt.Sprintf("http://%s:%d", s.Slot.HostIPString(), consts.DefaultEnvdServerPort)
+	client := processconnect.NewProcessClient(execHTTPClient, address)
+
+	stream, err := client.Start(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error starting process: %w", err)
+	}
+	defer stream.Close()
+
+	var output []byte
+	for stream.Receive() {
+		event := stream.Msg().GetEvent()
+
+		switch {
+		case event.GetData() != nil:
+			data := event.GetData()
+			output = append(output, data.GetStdout()...)
+			output = append(output, data.GetStderr()...)
+
+			if len(output) > execOutputLimit {
+				output = output[len(output)-execOutputLimit:]
+			}
+		case event.GetEnd() != nil:
+			return &ExecResult{
+				ExitCode: event.GetEnd().GetExitCode(),
+				Output:   string(output),
+			}, nil
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return nil, fmt.Errorf("error running process: %w", err)
+	}
+
+	return nil, errors.New("process stream ended without exit")
+}
@@ -202,6 +202,48 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 	return nil
 }
 
+func (c *apiClient) setVolumeDrive(ctx context.Context, driveID string, volumePath string) error {
+	ioEngine := "Async"
+	isRootDevice := false
+	driveConfig := operations.PutGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.Drive{
+			DriveID:      &driveID,
+			PathOnHost:   volumePath,
+			IsRootDevice: &isRootDevice,
+			IsReadOnly:   false,
+			IoEngine:     &ioEngine,
+		},
+	}
+
+	_, err := c.client.Operations.PutGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc volume drive config: %w", err)
+	}
+
+	return nil
+}
+
+// updateDrive reopens the drive backing file, the guest is notified about the new drive size.
+func (c *apiClient) updateDrive(ctx context.Context, driveID string, path string) error {
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: driveID,
+		Body: &models.PartialDrive{
+			DriveID:    &driveID,
+			PathOnHost: path,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive '%s': %w", driveID, err)
+	}
+
+	return nil
+}
+
 func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string) error {
 	networkConfig := operations.PutGuestNetworkInterfaceByIDParams{
 		Context: ctx,
@@ -264,6 +306,41 @@ func (c *apiClient) setMachineConfig(
 	return nil
 }
 
+// setBalloon adds the balloon device before the VM start, the guest can reclaim the balloon memory under memory pressure.
+func (c *apiClient) setBalloon(ctx context.Context, amountMiB int64) error {
+	deflateOnOom := true
+	balloonParams := operations.PutBalloonParams{
+		Context: ctx,
+		Body: &models.Balloon{
+			AmountMib:    &amountMiB,
+			DeflateOnOom: &deflateOnOom,
+		},
+	}
+
+	_, err := c.client.Operations.PutBalloon(&balloonParams)
+	if err != nil {
+		return fmt.Errorf("error setting fc balloon: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMiB int64) error {
+	balloonParams := operations.PatchBalloonParams{
+		Context: ctx,
+		Body: &models.BalloonUpdate{
+			AmountMib: &amountMiB,
+		},
+	}
+
+	_, err := c.client.Operations.PatchBalloon(&balloonParams)
+	if err != nil {
+		return fmt.Errorf("error updating fc balloon: %w", err)
+	}
+
+	return nil
+}
+
 func (c *apiClient) startVM(ctx context.Context) error {
 	start := models.InstanceActionInfoActionTypeInstanceStart
 	startActionParams := operations.CreateSyncActionParams{
@@ -1,6 +1,11 @@
 package fc
 
-import "path/filepath"
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
 
 const (
 	HostKernelsDir = "/fc-kernels"
@@ -15,8 +20,25 @@ const (
 	buildDirName = "builds"
 
 	SandboxRootfsFile = "rootfs.ext4"
+
+	// VolumeDrives is the number of spare drives added to the VM on the cold boot.
+	// The drives can't be added to a VM restored from a snapshot, so the volumes are attached in place of the spare drives.
+	VolumeDrives = 4
 )
 
+// VolumeDriveID is the ID of the spare drive, the drive is also used as the file name in the sandbox dir.
+func VolumeDriveID(idx int) string {
+	return fmt.Sprintf("volume%d", idx)
+}
+
+// VolumeGuestDevice is the device of the spare drive in the guest, the rootfs is always the first drive.
+func VolumeGuestDevice(idx int) string {
+	return fmt.Sprintf("/dev/vd%c", 'b'+idx)
+}
+
+// HostArchitecture is the CPU architecture of the node, the sandboxes can run only on the architecture they were built for.
+var HostArchitecture = runtime.GOARCH
+
 type FirecrackerVersions struct {
 	KernelVersion      string
 	FirecrackerVersion string
@@ -27,11 +49,22 @@ func (t FirecrackerVersions) SandboxKernelDir() string {
 }
 
 func (t FirecrackerVersions) HostKernelPath() string {
-	return filepath.Join(HostKernelsDir, t.KernelVersion, SandboxKernelFile)
+	return archPath(HostKernelsDir, t.KernelVersion, SandboxKernelFile)
 }
 
 func (t FirecrackerVersions) FirecrackerPath() string {
-	return filepath.Join(FirecrackerVersionsDir, t.FirecrackerVersion, FirecrackerBinaryName)
+	return archPath(FirecrackerVersionsDir, t.FirecrackerVersion, FirecrackerBinaryName)
+}
+
+// archPath returns the path of the binary built for the host architecture (<dir>/<version>/<arch>/<file>),
+// the nodes with the binaries only for one architecture keep them directly in the version directory.
+func archPath(dir, version, file string) string {
+	path := filepath.Join(dir, version, HostArchitecture, file)
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+
+	return filepath.Join(dir, version, file)
 }
 
 type RootfsPaths struct {
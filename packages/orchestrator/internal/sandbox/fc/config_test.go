@@ -0,0 +1,23 @@
+package fc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchPath(t *testing.T) {
+	dir := t.TempDir()
+
+	legacyPath := filepath.Join(dir, "v1", FirecrackerBinaryName)
+	assert.Equal(t, legacyPath, archPath(dir, "v1", FirecrackerBinaryName))
+
+	hostPath := filepath.Join(dir, "v1", HostArchitecture, FirecrackerBinaryName)
+	require.NoError(t, os.MkdirAll(filepath.Dir(hostPath), 0o755))
+	require.NoError(t, os.WriteFile(hostPath, nil, 0o755))
+
+	assert.Equal(t, hostPath, archPath(dir, "v1", FirecrackerBinaryName))
+}
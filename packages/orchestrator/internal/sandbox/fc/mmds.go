@@ -12,6 +12,28 @@ type MmdsMetadata struct {
 	TeamID     string `json:"teamID"`
 
 	LogsCollectorAddress string `json:"address"`
+
+	DNS *MmdsDNS `json:"dns,omitempty"`
+
+	Volumes []MmdsVolume `json:"volumes,omitempty"`
+}
+
+// MmdsVolume is the attached volume device mounted by envd during the init.
+type MmdsVolume struct {
+	Device string `json:"device"`
+	Path   string `json:"path"`
+}
+
+// MmdsDNS is the resolver config and the static host entries applied by envd during the init.
+type MmdsDNS struct {
+	Nameservers []string        `json:"nameservers,omitempty"`
+	Searches    []string        `json:"searches,omitempty"`
+	Hosts       []MmdsHostEntry `json:"hosts,omitempty"`
+}
+
+type MmdsHostEntry struct {
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
 }
 
 func (mm MmdsMetadata) LoggerMetadata() sbxlogger.SandboxMetadata {
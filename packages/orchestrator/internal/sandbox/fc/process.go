@@ -61,6 +61,7 @@ type Process struct {
 	providerRootfsPath string
 	rootfsPath         string
 	kernelPath         string
+	volumePaths        []string
 	files              *storage.SandboxFiles
 
 	Exit *utils.ErrorOnce
@@ -125,8 +126,9 @@ func NewProcess(
 		files:                 files,
 		slot:                  slot,
 
-		kernelPath: startScript.KernelPath,
-		rootfsPath: startScript.RootfsPath,
+		kernelPath:  startScript.KernelPath,
+		rootfsPath:  startScript.RootfsPath,
+		volumePaths: startScript.VolumePaths,
 	}, nil
 }
 
@@ -158,6 +160,14 @@ func (p *Process) configure(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
+	// The spare volume drives are empty until a volume is attached
+	for i := range p.volumePaths {
+		err = utils.SymlinkForce("/dev/null", p.files.SandboxCacheVolumeLinkPath(i))
+		if err != nil {
+			return fmt.Errorf("error symlinking volume drive: %w", err)
+		}
+	}
+
 	err = p.cmd.Start()
 	if err != nil {
 		return fmt.Errorf("error starting fc process: %w", err)
@@ -295,6 +305,16 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(ctx, "set fc drivers config")
 
+	for i, volumePath := range p.volumePaths {
+		err = p.client.setVolumeDrive(ctx, VolumeDriveID(i), volumePath)
+		if err != nil {
+			fcStopErr := p.Stop(ctx)
+
+			return errors.Join(fmt.Errorf("error setting fc volume drives config: %w", err), fcStopErr)
+		}
+	}
+	telemetry.ReportEvent(ctx, "set fc volume drives config")
+
 	// Network
 	err = p.client.setNetworkInterface(ctx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC())
 	if err != nil {
@@ -312,6 +332,15 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(ctx, "set fc machine config")
 
+	// The balloon is deflated at the start, it's inflated only when the sandbox memory is limited.
+	err = p.client.setBalloon(ctx, 0)
+	if err != nil {
+		fcStopErr := p.Stop(ctx)
+
+		return errors.Join(fmt.Errorf("error setting fc balloon: %w", err), fcStopErr)
+	}
+	telemetry.ReportEvent(ctx, "set fc balloon")
+
 	err = p.client.startVM(ctx)
 	if err != nil {
 		fcStopErr := p.Stop(ctx)
@@ -329,10 +358,15 @@ func (p *Process) Resume(
 	uffdSocketPath string,
 	snapfile template.File,
 	uffdReady chan struct{},
+	volumeDevices []string,
 ) error {
 	ctx, span := tracer.Start(ctx, "resume-fc")
 	defer span.End()
 
+	if len(volumeDevices) > len(p.volumePaths) {
+		return fmt.Errorf("the template supports %d volumes, %d requested", len(p.volumePaths), len(volumeDevices))
+	}
+
 	err := p.configure(
 		ctx,
 		mmdsMetadata,
@@ -352,6 +386,13 @@ func (p *Process) Resume(
 
 	telemetry.ReportEvent(ctx, "symlinked rootfs")
 
+	for i, device := range volumeDevices {
+		err = utils.SymlinkForce(device, p.files.SandboxCacheVolumeLinkPath(i))
+		if err != nil {
+			return fmt.Errorf("error symlinking volume: %w", err)
+		}
+	}
+
 	err = p.client.loadSnapshot(
 		ctx,
 		uffdSocketPath,
@@ -371,6 +412,16 @@ func (p *Process) Resume(
 		return errors.Join(fmt.Errorf("error resuming vm: %w", err), fcStopErr)
 	}
 
+	// The spare drives were snapshotted empty, reopening them notifies the guest about the volume size.
+	for i := range volumeDevices {
+		err = p.client.updateDrive(ctx, VolumeDriveID(i), p.volumePaths[i])
+		if err != nil {
+			fcStopErr := p.Stop(ctx)
+
+			return errors.Join(fmt.Errorf("error attaching volume: %w", err), fcStopErr)
+		}
+	}
+
 	err = p.client.setMmds(ctx, mmdsMetadata)
 	if err != nil {
 		fcStopErr := p.Stop(ctx)
@@ -387,6 +438,12 @@ func (p *Process) Resume(
 	return nil
 }
 
+// SetBalloon inflates the balloon to the amount of memory taken from the guest, zero gives all the memory back.
+// The balloon device is part of the snapshot, so it's available only for the templates built with it.
+func (p *Process) SetBalloon(ctx context.Context, amountMiB int64) error {
+	return p.client.updateBalloon(ctx, amountMiB)
+}
+
 func (p *Process) Pid() (int, error) {
 	if p.cmd.Process == nil {
 		return 0, fmt.Errorf("fc process not started")
@@ -21,11 +21,19 @@ type startScriptArgs struct {
 	DeprecatedSandboxRootfsDir string
 	SandboxRootfsFile          string
 
+	Volumes []volumeLink
+
 	NamespaceID       string
 	FirecrackerPath   string
 	FirecrackerSocket string
 }
 
+// volumeLink links the spare drive in the sandbox dir to its host path
+type volumeLink struct {
+	HostPath    string
+	SandboxPath string
+}
+
 // StartScriptResult contains the generated script and computed paths
 type StartScriptResult struct {
 	// Value is the generated firecracker start script
@@ -36,6 +44,9 @@ type StartScriptResult struct {
 
 	// KernelPath is the computed kernel path
 	KernelPath string
+
+	// VolumePaths are the computed paths of the spare volume drives
+	VolumePaths []string
 }
 
 const startScriptV1 = `mount --make-rprivate / &&
@@ -52,7 +63,8 @@ const startScriptV2 = `mount --make-rprivate / &&
 mount -t tmpfs tmpfs {{ .SandboxDir }} -o X-mount.mkdir &&
 
 ln -s {{ .HostRootfsPath }} {{ .SandboxDir }}/{{ .SandboxRootfsFile }} &&
-
+{{ range .Volumes }}ln -s {{ .HostPath }} {{ .SandboxPath }} &&
+{{ end }}
 mkdir -p {{ .SandboxDir }}/{{ .SandboxKernelDir }} &&
 ln -s {{ .HostKernelPath }} {{ .SandboxDir }}/{{ .SandboxKernelDir }}/{{ .SandboxKernelFile }} &&
 
@@ -81,6 +93,14 @@ func (sb *StartScriptBuilder) buildArgs(
 	rootfsPaths RootfsPaths,
 	namespaceID string,
 ) startScriptArgs {
+	volumes := make([]volumeLink, 0, VolumeDrives)
+	for i := range VolumeDrives {
+		volumes = append(volumes, volumeLink{
+			HostPath:    files.SandboxCacheVolumeLinkPath(i),
+			SandboxPath: filepath.Join(SandboxDir, VolumeDriveID(i)),
+		})
+	}
+
 	return startScriptArgs{
 		// General
 		SandboxDir: SandboxDir,
@@ -95,6 +115,9 @@ func (sb *StartScriptBuilder) buildArgs(
 		DeprecatedSandboxRootfsDir: rootfsPaths.DeprecatedSandboxRootfsDir(),
 		SandboxRootfsFile:          SandboxRootfsFile,
 
+		// Volumes
+		Volumes: volumes,
+
 		// FC
 		NamespaceID:       namespaceID,
 		FirecrackerPath:   versions.FirecrackerPath(),
@@ -140,9 +163,10 @@ func (sb *StartScriptBuilder) Build(
 	kernelPath := sb.getKernelPath(args)
 
 	return &StartScriptResult{
-		Value:      script,
-		RootfsPath: rootfsPath,
-		KernelPath: kernelPath,
+		Value:       script,
+		RootfsPath:  rootfsPath,
+		KernelPath:  kernelPath,
+		VolumePaths: sb.getVolumePaths(args, rootfsPaths),
 	}, nil
 }
 
@@ -159,3 +183,17 @@ func (sb *StartScriptBuilder) getRootfsPath(args startScriptArgs, rootfsPaths Ro
 func (sb *StartScriptBuilder) getKernelPath(args startScriptArgs) string {
 	return filepath.Join(args.SandboxDir, args.SandboxKernelDir, args.SandboxKernelFile)
 }
+
+// getVolumePaths returns the spare volume drive paths, the drives are not available for the v1 rootfs paths
+func (sb *StartScriptBuilder) getVolumePaths(args startScriptArgs, rootfsPaths RootfsPaths) []string {
+	if rootfsPaths.TemplateVersion <= 1 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(args.Volumes))
+	for _, volume := range args.Volumes {
+		paths = append(paths, volume.SandboxPath)
+	}
+
+	return paths
+}
@@ -40,6 +40,8 @@ func TestStartScriptBuilder_Build(t *testing.T) {
 				"mount --make-rprivate /",
 				"mount -t tmpfs tmpfs /fc-vm -o X-mount.mkdir",
 				"ln -s /orchestrator/sandbox/rootfs-test-sandbox-static-id.link /fc-vm/rootfs.ext4",
+				"ln -s /orchestrator/sandbox/volume0-test-sandbox-static-id.link /fc-vm/volume0",
+				"ln -s /orchestrator/sandbox/volume3-test-sandbox-static-id.link /fc-vm/volume3",
 				"mkdir -p /fc-vm/6.1.0",
 				"ln -s /fc-kernels/6.1.0/vmlinux.bin /fc-vm/6.1.0/vmlinux.bin",
 				"ip netns exec ns-789 /fc-versions/1.4.0/firecracker --api-sock",
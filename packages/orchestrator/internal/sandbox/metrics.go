@@ -22,6 +22,8 @@ type Metrics struct {
 	DiskUsed  int64 `json:"disk_used"`  // Used disk space in bytes
 	DiskTotal int64 `json:"disk_total"` // Total disk space in bytes
 
+	LastProcessActivity int64 `json:"last_process_activity"` // Unix Timestamp in UTC of the last process start, input or output
+
 	// Deprecated
 	MemTotalMiB int64 `json:"mem_total_mib"` // Total virtual memory in MiB
 
@@ -0,0 +1,130 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// bandwidthBurstDuration is how long can the sandbox send at the full link speed before the limit is applied.
+	bandwidthBurstDuration = 100 // ms
+	// bandwidthLatency is the maximum time a packet can wait in the queue before it is dropped.
+	bandwidthLatency = 50 // ms
+
+	minBandwidthBurst = 16 * 1024 // bytes
+)
+
+type tbfParams struct {
+	rate  uint64 // bytes per second
+	burst uint32 // bytes
+	limit uint32 // bytes
+}
+
+func newTbfParams(bandwidthMbps int64) tbfParams {
+	rate := uint64(bandwidthMbps) * 1_000_000 / 8
+
+	burst := max(rate*bandwidthBurstDuration/1000, minBandwidthBurst)
+	limit := burst + rate*bandwidthLatency/1000
+
+	return tbfParams{
+		rate:  rate,
+		burst: uint32(min(burst, uint64(^uint32(0)))),
+		limit: uint32(min(limit, uint64(^uint32(0)))),
+	}
+}
+
+// ConfigureBandwidth limits the outbound traffic of the sandbox with a token bucket filter on the vpeer device.
+// Zero bandwidth means the traffic is not limited.
+func (s *Slot) ConfigureBandwidth(ctx context.Context, bandwidthMbps int64) error {
+	_, span := tracer.Start(ctx, "slot-bandwidth-configure", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+		attribute.Int64("bandwidth_mbps", bandwidthMbps),
+	))
+	defer span.End()
+
+	if bandwidthMbps <= 0 {
+		return nil
+	}
+
+	params := newTbfParams(bandwidthMbps)
+
+	s.bandwidthLimited.Store(true)
+
+	return s.doInNamespace(func() error {
+		vpeer, err := netlink.LinkByName(s.VpeerName())
+		if err != nil {
+			return fmt.Errorf("error finding vpeer: %w", err)
+		}
+
+		err = netlink.QdiscReplace(&netlink.Tbf{
+			QdiscAttrs: netlink.QdiscAttrs{
+				LinkIndex: vpeer.Attrs().Index,
+				Handle:    netlink.MakeHandle(1, 0),
+				Parent:    netlink.HANDLE_ROOT,
+			},
+			Rate:   params.rate,
+			Buffer: netlink.Xmittime(params.rate, params.burst),
+			Limit:  params.limit,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting bandwidth limit: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ResetBandwidth removes the bandwidth limit from the slot, so it can be reused.
+func (s *Slot) ResetBandwidth(ctx context.Context) error {
+	_, span := tracer.Start(ctx, "slot-bandwidth-reset", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+	))
+	defer span.End()
+
+	if !s.bandwidthLimited.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	return s.doInNamespace(func() error {
+		vpeer, err := netlink.LinkByName(s.VpeerName())
+		if err != nil {
+			return fmt.Errorf("error finding vpeer: %w", err)
+		}
+
+		err = netlink.QdiscDel(&netlink.Tbf{
+			QdiscAttrs: netlink.QdiscAttrs{
+				LinkIndex: vpeer.Attrs().Index,
+				Handle:    netlink.MakeHandle(1, 0),
+				Parent:    netlink.HANDLE_ROOT,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error removing bandwidth limit: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Slot) doInNamespace(fn func() error) error {
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		return fn()
+	})
+	if err != nil {
+		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+	}
+
+	return nil
+}
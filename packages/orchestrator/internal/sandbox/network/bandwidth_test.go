@@ -0,0 +1,31 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTbfParams(t *testing.T) {
+	params := newTbfParams(100)
+
+	assert.Equal(t, uint64(12_500_000), params.rate)
+	assert.Equal(t, uint32(1_250_000), params.burst)
+	assert.Equal(t, uint32(1_875_000), params.limit)
+}
+
+func TestNewTbfParams_MinBurst(t *testing.T) {
+	params := newTbfParams(1)
+
+	assert.Equal(t, uint64(125_000), params.rate)
+	assert.Equal(t, uint32(minBandwidthBurst), params.burst)
+	assert.Equal(t, uint32(minBandwidthBurst+6_250), params.limit)
+}
+
+func TestStatsSub(t *testing.T) {
+	current := Stats{RxBytes: 1500, TxBytes: 700}
+
+	assert.Equal(t, Stats{RxBytes: 500, TxBytes: 200}, current.sub(Stats{RxBytes: 1000, TxBytes: 500}))
+	// Counters lower than the baseline are returned as they are
+	assert.Equal(t, current, current.sub(Stats{RxBytes: 2000, TxBytes: 500}))
+}
@@ -0,0 +1,300 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// domainRefreshInterval is how often are the egress domains resolved again, so the firewall follows the DNS changes.
+	domainRefreshInterval = 30 * time.Second
+	domainResolveTimeout  = 5 * time.Second
+
+	// sandboxNameserver is the resolver configured in the sandbox rootfs (/etc/resolv.conf).
+	// It has to be reachable when the egress is allowed only for domains.
+	sandboxNameserver = "8.8.8.8/32"
+
+	allTraffic = "0.0.0.0/0"
+)
+
+// Egress is the outbound traffic policy of the sandbox.
+// Each entry is an IPv4 address, CIDR or a domain. The allowed entries take precedence over the denied ones.
+type Egress struct {
+	AllowOut []string
+	DenyOut  []string
+	// Nameservers are the custom resolvers of the sandbox, they are reachable like the template resolver.
+	Nameservers []string
+}
+
+func (e Egress) IsEmpty() bool {
+	return len(e.AllowOut) == 0 && len(e.DenyOut) == 0
+}
+
+// egressEntries are the egress entries split into CIDRs and domains.
+type egressEntries struct {
+	cidrs   []string
+	domains []string
+}
+
+// parseEgressEntries splits the entries into CIDRs and domains, IP addresses are converted to /32 CIDRs.
+func parseEgressEntries(entries []string) (egressEntries, error) {
+	var parsed egressEntries
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			if !prefix.Addr().Is4() {
+				return egressEntries{}, fmt.Errorf("only IPv4 CIDRs are supported: '%s'", entry)
+			}
+
+			parsed.cidrs = append(parsed.cidrs, prefix.Masked().String())
+
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			if !addr.Is4() {
+				return egressEntries{}, fmt.Errorf("only IPv4 addresses are supported: '%s'", entry)
+			}
+
+			parsed.cidrs = append(parsed.cidrs, netip.PrefixFrom(addr, 32).String())
+
+			continue
+		}
+
+		if !isDomain(entry) {
+			return egressEntries{}, fmt.Errorf("invalid egress entry '%s', expected IPv4 address, CIDR or a domain", entry)
+		}
+
+		parsed.domains = append(parsed.domains, strings.ToLower(strings.TrimSuffix(entry, ".")))
+	}
+
+	return parsed, nil
+}
+
+func isDomain(entry string) bool {
+	entry = strings.TrimSuffix(entry, ".")
+	if len(entry) == 0 || len(entry) > 253 || !strings.Contains(entry, ".") {
+		return false
+	}
+
+	for _, label := range strings.Split(entry, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// mergeCIDRs removes the duplicate CIDRs and the CIDRs contained in the other ones,
+// the nftables interval sets don't allow overlapping elements.
+func mergeCIDRs(cidrs []string) []string {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	slices.SortFunc(prefixes, func(a, b netip.Prefix) int {
+		if a.Bits() != b.Bits() {
+			return a.Bits() - b.Bits()
+		}
+
+		return a.Addr().Compare(b.Addr())
+	})
+
+	merged := make([]netip.Prefix, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		contained := slices.ContainsFunc(merged, func(m netip.Prefix) bool {
+			return m.Contains(prefix.Addr())
+		})
+		if !contained {
+			merged = append(merged, prefix)
+		}
+	}
+
+	result := make([]string, 0, len(merged))
+	for _, prefix := range merged {
+		result = append(result, prefix.String())
+	}
+
+	return result
+}
+
+// withoutBlockedRanges removes the blocked ranges from the allowed CIDRs.
+// The allow set is checked before the block set, so the allowed CIDRs can't overlap the private and link-local ranges.
+func withoutBlockedRanges(cidrs []string) []string {
+	var result []string
+
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+
+		remaining := []netip.Prefix{prefix.Masked()}
+		for _, blockedRange := range blockedRanges {
+			blocked := netip.MustParsePrefix(blockedRange)
+
+			var next []netip.Prefix
+			for _, r := range remaining {
+				next = append(next, subtractPrefix(r, blocked)...)
+			}
+
+			remaining = next
+		}
+
+		for _, r := range remaining {
+			result = append(result, r.String())
+		}
+	}
+
+	return result
+}
+
+// subtractPrefix returns the parts of the IPv4 prefix that are not in the blocked prefix.
+func subtractPrefix(prefix, blocked netip.Prefix) []netip.Prefix {
+	if !prefix.Overlaps(blocked) {
+		return []netip.Prefix{prefix}
+	}
+
+	if blocked.Bits() <= prefix.Bits() {
+		// The whole prefix is blocked
+		return nil
+	}
+
+	// Split the prefix in halves until the blocked part is separated
+	lower := netip.PrefixFrom(prefix.Addr(), prefix.Bits()+1)
+
+	addr := prefix.Addr().As4()
+	upperAddr := binary.BigEndian.Uint32(addr[:]) | 1<<(31-prefix.Bits())
+	binary.BigEndian.PutUint32(addr[:], upperAddr)
+	upper := netip.PrefixFrom(netip.AddrFrom4(addr), prefix.Bits()+1)
+
+	return append(subtractPrefix(lower, blocked), subtractPrefix(upper, blocked)...)
+}
+
+// resolveDomains resolves the domains to IPv4 /32 CIDRs on the host.
+// Domains that fail to resolve are skipped, so a temporary DNS failure doesn't fail the whole policy.
+func resolveDomains(ctx context.Context, domains []string) []string {
+	ctx, cancel := context.WithTimeout(ctx, domainResolveTimeout)
+	defer cancel()
+
+	var cidrs []string
+	for _, domain := range domains {
+		ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip4", domain)
+		if err != nil {
+			zap.L().Warn("failed to resolve egress domain", zap.String("domain", domain), zap.Error(err))
+
+			continue
+		}
+
+		for _, ip := range ips {
+			cidrs = append(cidrs, netip.PrefixFrom(ip.Unmap(), 32).String())
+		}
+	}
+
+	return cidrs
+}
+
+// egressRules are the firewall rules computed from the sandbox internet access and the egress policy.
+type egressRules struct {
+	blockAll bool
+	allowAll bool
+
+	allow egressEntries
+	deny  egressEntries
+}
+
+func newEgressRules(allowInternet bool, egress Egress) (*egressRules, error) {
+	allow, err := parseEgressEntries(egress.AllowOut)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed egress: %w", err)
+	}
+
+	deny, err := parseEgressEntries(egress.DenyOut)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied egress: %w", err)
+	}
+
+	rules := &egressRules{
+		blockAll: !allowInternet || slices.Contains(deny.cidrs, allTraffic),
+		allowAll: slices.Contains(allow.cidrs, allTraffic),
+		allow:    allow,
+		deny:     deny,
+	}
+
+	rules.allow.cidrs = withoutBlockedRanges(rules.allow.cidrs)
+
+	// The sandbox has to be able to resolve the allowed domains itself.
+	if rules.blockAll && len(allow.domains) > 0 {
+		rules.allow.cidrs = append(rules.allow.cidrs, sandboxNameserver)
+		rules.allow.cidrs = append(rules.allow.cidrs, withoutBlockedRanges(nameserverCIDRs(egress.Nameservers))...)
+	}
+
+	return rules, nil
+}
+
+// nameserverCIDRs returns the IPv4 nameservers as /32 CIDRs, the firewall filters only the IPv4 traffic.
+func nameserverCIDRs(nameservers []string) []string {
+	var cidrs []string
+	for _, nameserver := range nameservers {
+		ip, err := netip.ParseAddr(nameserver)
+		if err != nil || !ip.Unmap().Is4() {
+			continue
+		}
+
+		cidrs = append(cidrs, netip.PrefixFrom(ip.Unmap(), 32).String())
+	}
+
+	return cidrs
+}
+
+func (r *egressRules) hasDomains() bool {
+	return len(r.allow.domains) > 0 || len(r.deny.domains) > 0
+}
+
+// apply sets the firewall sets, the domains have to be already resolved. Must be called in the slot namespace.
+func (r *egressRules) apply(fw *Firewall, allowResolved, denyResolved []string) error {
+	if r.allowAll {
+		// Everything except the blocked ranges is allowed, the deny entries don't have any effect.
+		return fw.ResetAllCustom()
+	}
+
+	// The domains can resolve to the blocked ranges too
+	allowed := mergeCIDRs(append(slices.Clone(r.allow.cidrs), withoutBlockedRanges(allowResolved)...))
+
+	if r.blockAll {
+		err := fw.AddBlockedIP(allTraffic)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := fw.ReplaceBlockedCustom(append(slices.Clone(r.deny.cidrs), denyResolved...))
+		if err != nil {
+			return err
+		}
+	}
+
+	return fw.ReplaceAllowedCustom(allowed)
+}
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEgressEntries(t *testing.T) {
+	entries, err := parseEgressEntries([]string{"1.2.3.4", "10.0.0.1/8", "Mirror.Example.com.", " api.internal.dev "})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1.2.3.4/32", "10.0.0.0/8"}, entries.cidrs)
+	assert.Equal(t, []string{"mirror.example.com", "api.internal.dev"}, entries.domains)
+}
+
+func TestParseEgressEntries_Invalid(t *testing.T) {
+	for _, entry := range []string{"::1", "2001:db8::/32", "localhost", "-bad.example.com", "bad_domain.com", ""} {
+		_, err := parseEgressEntries([]string{entry})
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestMergeCIDRs(t *testing.T) {
+	merged := mergeCIDRs([]string{"10.1.0.0/16", "10.0.0.0/8", "1.2.3.4/32", "1.2.3.4/32", "192.168.1.0/24"})
+
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.0/24", "1.2.3.4/32"}, merged)
+}
+
+func TestNewEgressRules(t *testing.T) {
+	t.Run("domains allowed with internet blocked", func(t *testing.T) {
+		rules, err := newEgressRules(true, Egress{AllowOut: []string{"mirror.example.com"}, DenyOut: []string{allTraffic}})
+		require.NoError(t, err)
+
+		assert.True(t, rules.blockAll)
+		assert.True(t, rules.hasDomains())
+		assert.Contains(t, rules.allow.cidrs, sandboxNameserver)
+	})
+
+	t.Run("custom nameservers allowed with internet blocked", func(t *testing.T) {
+		rules, err := newEgressRules(true, Egress{
+			AllowOut:    []string{"mirror.example.com"},
+			DenyOut:     []string{allTraffic},
+			Nameservers: []string{"1.1.1.1", "10.0.0.53", "2606:4700:4700::1111"},
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, rules.allow.cidrs, sandboxNameserver)
+		assert.Contains(t, rules.allow.cidrs, "1.1.1.1/32")
+		// The nameservers in the blocked ranges stay blocked
+		assert.NotContains(t, rules.allow.cidrs, "10.0.0.53/32")
+	})
+
+	t.Run("cidrs denied with internet allowed", func(t *testing.T) {
+		rules, err := newEgressRules(true, Egress{DenyOut: []string{"1.1.1.1"}})
+		require.NoError(t, err)
+
+		assert.False(t, rules.blockAll)
+		assert.False(t, rules.hasDomains())
+		assert.Empty(t, rules.allow.cidrs)
+		assert.Equal(t, []string{"1.1.1.1/32"}, rules.deny.cidrs)
+	})
+
+	t.Run("internet access disabled", func(t *testing.T) {
+		rules, err := newEgressRules(false, Egress{AllowOut: []string{"1.2.0.0/16"}})
+		require.NoError(t, err)
+
+		assert.True(t, rules.blockAll)
+		assert.Equal(t, []string{"1.2.0.0/16"}, rules.allow.cidrs)
+	})
+
+	t.Run("blocked ranges removed from the allowed cidrs", func(t *testing.T) {
+		rules, err := newEgressRules(false, Egress{AllowOut: []string{"10.2.0.0/16", "169.254.169.254", "8.8.4.4"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"8.8.4.4/32"}, rules.allow.cidrs)
+	})
+}
+
+func TestWithoutBlockedRanges(t *testing.T) {
+	allowed := withoutBlockedRanges([]string{"0.0.0.0/0", "169.254.169.254/32", "1.1.1.1/32"})
+
+	assert.Contains(t, allowed, "1.1.1.1/32")
+	assert.NotContains(t, allowed, "169.254.169.254/32")
+
+	for _, cidr := range allowed {
+		prefix := netip.MustParsePrefix(cidr)
+
+		for _, blockedRange := range blockedRanges {
+			assert.False(t, prefix.Overlaps(netip.MustParsePrefix(blockedRange)), "%s overlaps %s", cidr, blockedRange)
+		}
+	}
+
+	// The rest of the address space stays allowed
+	for _, addr := range []string{"0.0.0.1", "9.255.255.255", "11.0.0.0", "172.15.255.255", "172.32.0.0", "192.167.0.1", "255.255.255.255"} {
+		ip := netip.MustParseAddr(addr)
+		assert.True(t, slices.ContainsFunc(allowed, func(cidr string) bool {
+			return netip.MustParsePrefix(cidr).Contains(ip)
+		}), addr)
+	}
+}
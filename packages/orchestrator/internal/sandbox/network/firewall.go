@@ -3,6 +3,7 @@ package network
 import (
 	"fmt"
 	"net/netip"
+	"slices"
 
 	"github.com/google/nftables"
 	"github.com/google/nftables/expr"
@@ -219,18 +220,42 @@ func (fw *Firewall) ResetAllCustom() error {
 
 // ResetBlockedCustom resets the block set back to original ranges.
 func (fw *Firewall) ResetBlockedCustom() error {
-	initData, err := set.AddressStringsToSetData(blockedRanges)
+	return fw.ReplaceBlockedCustom(nil)
+}
+
+// ResetAllowedCustom resets allow set back to original ranges.
+func (fw *Firewall) ResetAllowedCustom() error {
+	return fw.ReplaceAllowedCustom(nil)
+}
+
+// ReplaceBlockedCustom replaces the block set with the original ranges and the given CIDRs.
+func (fw *Firewall) ReplaceBlockedCustom(cidrs []string) error {
+	data, err := set.AddressStringsToSetData(mergeCIDRs(append(slices.Clone(blockedRanges), cidrs...)))
 	if err != nil {
-		return fmt.Errorf("parse initial block CIDRs: %w", err)
+		return fmt.Errorf("parse block CIDRs: %w", err)
 	}
 
-	if err := fw.blockSet.ClearAndAddElements(fw.conn, initData); err != nil {
+	if err := fw.blockSet.ClearAndAddElements(fw.conn, data); err != nil {
 		return err
 	}
 	return fw.conn.Flush()
 }
 
-// ResetAllowedCustom resets allow set back to original ranges.
-func (fw *Firewall) ResetAllowedCustom() error {
-	return nil
+// ReplaceAllowedCustom replaces the allow set with the given CIDRs.
+func (fw *Firewall) ReplaceAllowedCustom(cidrs []string) error {
+	if len(cidrs) == 0 {
+		fw.conn.FlushSet(fw.allowSet.Set())
+
+		return fw.conn.Flush()
+	}
+
+	data, err := set.AddressStringsToSetData(mergeCIDRs(cidrs))
+	if err != nil {
+		return fmt.Errorf("parse allow CIDRs: %w", err)
+	}
+
+	if err := fw.allowSet.ClearAndAddElements(fw.conn, data); err != nil {
+		return err
+	}
+	return fw.conn.Flush()
 }
@@ -111,7 +111,7 @@ func (p *Pool) populate(ctx context.Context) error {
 	}
 }
 
-func (p *Pool) Get(ctx context.Context, allowInternet bool) (*Slot, error) {
+func (p *Pool) Get(ctx context.Context, allowInternet bool, egress Egress, bandwidthMbps int64) (*Slot, error) {
 	var slot *Slot
 
 	select {
@@ -132,11 +132,21 @@ func (p *Pool) Get(ctx context.Context, allowInternet bool) (*Slot, error) {
 		}
 	}
 
-	err := slot.ConfigureInternet(ctx, allowInternet)
+	err := slot.ConfigureInternet(ctx, allowInternet, egress)
 	if err != nil {
 		return nil, fmt.Errorf("error setting slot internet access: %w", err)
 	}
 
+	err = slot.ConfigureBandwidth(ctx, bandwidthMbps)
+	if err != nil {
+		return nil, fmt.Errorf("error setting slot bandwidth limit: %w", err)
+	}
+
+	err = slot.resetStats()
+	if err != nil {
+		return nil, fmt.Errorf("error resetting slot network stats: %w", err)
+	}
+
 	return slot, nil
 }
 
@@ -151,6 +161,16 @@ func (p *Pool) Return(ctx context.Context, slot *Slot) error {
 		return fmt.Errorf("error resetting slot internet access: %w", err)
 	}
 
+	err = slot.ResetBandwidth(ctx)
+	if err != nil {
+		// Cleanup the slot if resetting bandwidth fails
+		if cerr := p.cleanup(slot); cerr != nil {
+			return fmt.Errorf("reset bandwidth: %w; cleanup: %w", err, cerr)
+		}
+
+		return fmt.Errorf("error resetting slot bandwidth limit: %w", err)
+	}
+
 	select {
 	case p.reusedSlots <- slot:
 		p.reusedSlotCounter.Add(context.Background(), 1) //nolint:contextcheck // TODO: fix this later
@@ -6,12 +6,15 @@ import (
 	"log"
 	"net"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/containernetworking/plugins/pkg/ns"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	netutils "k8s.io/utils/net"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal"
@@ -62,6 +65,13 @@ type Slot struct {
 
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
+	// egressRefreshCancel stops the periodic resolving of the egress domains.
+	egressRefreshCancel context.CancelFunc
+	egressMu            sync.Mutex
+	// bandwidthLimited is used to track if the bandwidth limit is set for the slot and needs a cleanup.
+	bandwidthLimited atomic.Bool
+	// statsBaseline are the veth counters when the slot was acquired.
+	statsBaseline atomic.Pointer[Stats]
 
 	vPeerIp net.IP
 	vEthIp  net.IP
@@ -246,20 +256,46 @@ func (s *Slot) CloseFirewall() error {
 	return nil
 }
 
-func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool) (e error) {
-	_, span := tracer.Start(ctx, "slot-internet-configure", trace.WithAttributes(
+func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool, egress Egress) (e error) {
+	ctx, span := tracer.Start(ctx, "slot-internet-configure", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 		attribute.Bool("allow_internet", allowInternet),
+		attribute.Int("egress.allow_out", len(egress.AllowOut)),
+		attribute.Int("egress.deny_out", len(egress.DenyOut)),
 	))
 	defer span.End()
 
-	if allowInternet {
+	if allowInternet && egress.IsEmpty() {
 		// Internet access is allowed by default.
 		return nil
 	}
 
+	rules, err := newEgressRules(allowInternet, egress)
+	if err != nil {
+		return err
+	}
+
 	s.firewallCustomRules.Store(true)
 
+	err = s.applyEgress(ctx, rules)
+	if err != nil {
+		return err
+	}
+
+	if rules.hasDomains() {
+		refreshCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		s.egressRefreshCancel = cancel
+
+		go s.refreshEgressDomains(refreshCtx, rules)
+	}
+
+	return nil
+}
+
+func (s *Slot) applyEgress(ctx context.Context, rules *egressRules) error {
+	allowResolved := resolveDomains(ctx, rules.allow.domains)
+	denyResolved := resolveDomains(ctx, rules.deny.domains)
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -267,7 +303,7 @@ func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool) (e err
 	defer n.Close()
 
 	err = n.Do(func(_ ns.NetNS) error {
-		err = s.Firewall.AddBlockedIP("0.0.0.0/0")
+		err = rules.apply(s.Firewall, allowResolved, denyResolved)
 		if err != nil {
 			return fmt.Errorf("error setting firewall rules: %w", err)
 		}
@@ -281,6 +317,33 @@ func (s *Slot) ConfigureInternet(ctx context.Context, allowInternet bool) (e err
 	return nil
 }
 
+// refreshEgressDomains periodically resolves the egress domains again and updates the firewall until the slot internet is reset.
+func (s *Slot) refreshEgressDomains(ctx context.Context, rules *egressRules) {
+	ticker := time.NewTicker(domainRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.egressMu.Lock()
+			// The internet could have been reset while waiting for the lock.
+			if ctx.Err() != nil {
+				s.egressMu.Unlock()
+
+				return
+			}
+
+			err := s.applyEgress(ctx, rules)
+			s.egressMu.Unlock()
+			if err != nil {
+				zap.L().Error("failed to refresh egress domains", zap.String("namespace_id", s.NamespaceID()), zap.Error(err))
+			}
+		}
+	}
+}
+
 func (s *Slot) ResetInternet(ctx context.Context) error {
 	_, span := tracer.Start(ctx, "slot-internet-reset", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
@@ -291,6 +354,14 @@ func (s *Slot) ResetInternet(ctx context.Context) error {
 		return nil
 	}
 
+	if s.egressRefreshCancel != nil {
+		s.egressRefreshCancel()
+		s.egressRefreshCancel = nil
+	}
+
+	s.egressMu.Lock()
+	defer s.egressMu.Unlock()
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -0,0 +1,72 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+)
+
+// Stats is the network traffic of the sandbox in bytes.
+type Stats struct {
+	// RxBytes is the traffic received by the sandbox.
+	RxBytes uint64
+	// TxBytes is the traffic sent by the sandbox.
+	TxBytes uint64
+}
+
+func (s Stats) sub(other Stats) Stats {
+	// The counters can be lower than the baseline if the device was recreated.
+	if s.RxBytes < other.RxBytes || s.TxBytes < other.TxBytes {
+		return s
+	}
+
+	return Stats{
+		RxBytes: s.RxBytes - other.RxBytes,
+		TxBytes: s.TxBytes - other.TxBytes,
+	}
+}
+
+func (s *Slot) readVethStats() (Stats, error) {
+	veth, err := netlink.LinkByName(s.VethName())
+	if err != nil {
+		return Stats{}, fmt.Errorf("error finding veth: %w", err)
+	}
+
+	statistics := veth.Attrs().Statistics
+	if statistics == nil {
+		return Stats{}, fmt.Errorf("veth '%s' has no statistics", s.VethName())
+	}
+
+	// The veth is in the host namespace, so the directions are reversed from the sandbox perspective.
+	return Stats{
+		RxBytes: statistics.TxBytes,
+		TxBytes: statistics.RxBytes,
+	}, nil
+}
+
+// resetStats sets the stats baseline, the slots are reused and the veth counters are not reset between sandboxes.
+func (s *Slot) resetStats() error {
+	baseline, err := s.readVethStats()
+	if err != nil {
+		return err
+	}
+
+	s.statsBaseline.Store(&baseline)
+
+	return nil
+}
+
+// Stats returns the network traffic of the sandbox since the slot was acquired.
+func (s *Slot) Stats() (Stats, error) {
+	current, err := s.readVethStats()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	baseline := s.statsBaseline.Load()
+	if baseline == nil {
+		return current, nil
+	}
+
+	return current.sub(*baseline), nil
+}
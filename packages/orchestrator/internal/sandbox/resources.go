@@ -0,0 +1,29 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidMemoryLimit = errors.New("invalid memory limit")
+
+// SetMemoryLimit limits the memory available to the sandbox by inflating the balloon device, zero gives back all the memory of the VM.
+// The VM memory can't grow over the template memory, so the limit can be only lower.
+func (s *Sandbox) SetMemoryLimit(ctx context.Context, memoryLimitMB int64) error {
+	if memoryLimitMB < 0 || memoryLimitMB > s.Config.RamMB {
+		return fmt.Errorf("%w: %d MiB, the sandbox has %d MiB", ErrInvalidMemoryLimit, memoryLimitMB, s.Config.RamMB)
+	}
+
+	var balloonMiB int64
+	if memoryLimitMB > 0 {
+		balloonMiB = s.Config.RamMB - memoryLimitMB
+	}
+
+	err := s.process.SetBalloon(ctx, balloonMiB)
+	if err != nil {
+		return fmt.Errorf("failed to set the balloon size: %w", err)
+	}
+
+	return nil
+}
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	globalconfig "github.com/e2b-dev/infra/packages/orchestrator/internal/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
@@ -21,10 +24,13 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/rootfs"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/uffd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	reverseproxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/tracking"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -50,6 +56,15 @@ type Config struct {
 	HugePages       bool
 
 	AllowInternetAccess *bool
+	Egress              network.Egress
+	// NetworkBandwidthMbps limits the outbound traffic of the sandbox, zero means unlimited.
+	NetworkBandwidthMbps int64
+	// DNS is applied by envd in the sandbox, the template resolver config is kept when nil.
+	DNS *fc.MmdsDNS
+	// Volumes are attached in place of the spare template drives, they are closed with the sandbox.
+	Volumes []*volume.Volume
+	// IdleTimeout enables the activity checks of envd, zero means the sandbox isn't paused when idle.
+	IdleTimeout time.Duration
 
 	Envd EnvdMetadata
 }
@@ -98,7 +113,16 @@ type Sandbox struct {
 
 	// Deprecated: to be removed in the future
 	// It was used to store the config to allow API restarts
+	// It's replaced while the sandbox is running, use StoredConfig and UpdateStoredConfig to access it.
 	APIStoredConfig *orchestrator.SandboxConfig
+	// apiStoredConfigMu guards the replacing of the stored config
+	apiStoredConfigMu sync.RWMutex
+
+	// ingress is the inbound traffic policy checked by the proxy, it can be updated while the sandbox is running.
+	ingress atomic.Pointer[reverseproxy.Ingress]
+
+	// activity is marked by the proxy traffic and by the activity checks, the API pauses the sandbox when it's idle.
+	activity tracking.Activity
 
 	exit *utils.ErrorOnce
 }
@@ -149,7 +173,7 @@ func CreateSandbox(
 		allowInternet = *config.AllowInternetAccess
 	}
 
-	ipsCh := getNetworkSlotAsync(ctx, networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(ctx, networkPool, cleanup, allowInternet, config.Egress, config.NetworkBandwidthMbps)
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -328,12 +352,16 @@ func ResumeSandbox(
 		}
 	}()
 
+	for _, v := range config.Volumes {
+		cleanup.Add(v.Close)
+	}
+
 	allowInternet := globalconfig.AllowSandboxInternet
 	if config.AllowInternetAccess != nil {
 		allowInternet = *config.AllowInternetAccess
 	}
 
-	ipsCh := getNetworkSlotAsync(ctx, networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(ctx, networkPool, cleanup, allowInternet, config.Egress, config.NetworkBandwidthMbps)
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -431,6 +459,20 @@ func ResumeSandbox(
 
 	telemetry.ReportEvent(ctx, "got metadata")
 
+	if len(config.Volumes) > meta.VolumeDrives {
+		return nil, fmt.Errorf("the template supports %d volumes, %d requested, the template needs to be rebuilt to support more volumes", meta.VolumeDrives, len(config.Volumes))
+	}
+
+	volumeDevices := make([]string, 0, len(config.Volumes))
+	mmdsVolumes := make([]fc.MmdsVolume, 0, len(config.Volumes))
+	for i, v := range config.Volumes {
+		volumeDevices = append(volumeDevices, v.Path())
+		mmdsVolumes = append(mmdsVolumes, fc.MmdsVolume{
+			Device: fc.VolumeGuestDevice(i),
+			Path:   v.MountPath,
+		})
+	}
+
 	fcHandle, fcErr := fc.NewProcess(
 		ctx,
 		ips.slot,
@@ -470,10 +512,14 @@ func ResumeSandbox(
 			TraceID:    traceID,
 
 			LogsCollectorAddress: fmt.Sprintf("http://%s/logs", ips.slot.HyperloopIPString()),
+
+			DNS:     config.DNS,
+			Volumes: mmdsVolumes,
 		},
 		fcUffdPath,
 		snapfile,
 		fcUffd.Ready(),
+		volumeDevices,
 	)
 	if fcStartErr != nil {
 		return nil, fmt.Errorf("failed to start FC: %w", fcStartErr)
@@ -527,6 +573,9 @@ func ResumeSandbox(
 		return nil, fmt.Errorf("failed to wait for sandbox start: %w", err)
 	}
 
+	// The idle time is counted from the sandbox start.
+	sbx.activity.Mark()
+
 	go sbx.Checks.Start(ctx)
 
 	go func() {
@@ -613,10 +662,53 @@ func (s *Sandbox) Stop(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// WaitForVolumes waits until the attached volumes are persisted, the volumes are persisted when the sandbox is closed.
+func (s *Sandbox) WaitForVolumes(ctx context.Context) error {
+	for _, v := range s.Config.Volumes {
+		err := v.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to persist volume '%s': %w", v.ID, err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Sandbox) FirecrackerVersions() fc.FirecrackerVersions {
 	return s.process.Versions
 }
 
+// Ingress returns the inbound traffic policy, nil means all ports are public.
+func (s *Sandbox) Ingress() *reverseproxy.Ingress {
+	return s.ingress.Load()
+}
+
+func (s *Sandbox) SetIngress(ingress *reverseproxy.Ingress) {
+	s.ingress.Store(ingress)
+}
+
+// StoredConfig returns the config stored for the API, the returned config must not be modified.
+func (s *Sandbox) StoredConfig() *orchestrator.SandboxConfig {
+	s.apiStoredConfigMu.RLock()
+	defer s.apiStoredConfigMu.RUnlock()
+
+	return s.APIStoredConfig
+}
+
+// UpdateStoredConfig replaces the stored config with the updated copy, so the config returned before isn't mutated.
+func (s *Sandbox) UpdateStoredConfig(update func(config *orchestrator.SandboxConfig)) {
+	s.apiStoredConfigMu.Lock()
+	defer s.apiStoredConfigMu.Unlock()
+
+	if s.APIStoredConfig == nil {
+		return
+	}
+
+	config := proto.Clone(s.APIStoredConfig).(*orchestrator.SandboxConfig)
+	update(config)
+	s.APIStoredConfig = config
+}
+
 func (s *Sandbox) Pause(
 	ctx context.Context,
 	m metadata.Template,
@@ -712,6 +804,11 @@ func (s *Sandbox) Pause(
 		return nil, fmt.Errorf("error while post processing: %w", err)
 	}
 
+	err = s.WaitForVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	metadataFileLink := template.NewLocalFileLink(snapshotTemplateFiles.CacheMetadataPath())
 	err = m.ToFile(metadataFileLink.Path())
 	if err != nil {
@@ -854,6 +951,8 @@ func getNetworkSlotAsync(
 	networkPool *network.Pool,
 	cleanup *Cleanup,
 	allowInternet bool,
+	egress network.Egress,
+	bandwidthMbps int64,
 ) chan networkSlotRes {
 	ctx, span := tracer.Start(ctx, "get-network-slot")
 	defer span.End()
@@ -863,7 +962,7 @@ func getNetworkSlotAsync(
 	go func() {
 		defer close(r)
 
-		ips, err := networkPool.Get(ctx, allowInternet)
+		ips, err := networkPool.Get(ctx, allowInternet, egress, bandwidthMbps)
 		if err != nil {
 			r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
 			return
@@ -190,6 +190,26 @@ func (c *Cache) AddSnapshot(
 	return nil
 }
 
+// VolumeStorage returns the storage of the volume layers, the layers are cached in the same store as the template diffs.
+func (c *Cache) VolumeStorage(
+	ctx context.Context,
+	persistence storage.StorageProvider,
+	volumeID string,
+	h *header.Header,
+) (*Storage, error) {
+	return NewStorage(ctx, c.buildStore, volumeID, build.Volume, h, persistence, c.blockMetrics)
+}
+
+// AddVolumeLayer adds the locally exported volume layer to the cache, so it's not downloaded again when the volume is attached on this node.
+func (c *Cache) AddVolumeLayer(diff build.Diff) {
+	switch diff.(type) {
+	case *build.NoDiff:
+		return
+	default:
+		c.buildStore.Add(diff)
+	}
+}
+
 func (c *Cache) useNFSCache(ctx context.Context, isBuilding bool, isSnapshot bool) bool {
 	if isBuilding {
 		// caching this layer doesn't speed up the next sandbox launch,
@@ -42,6 +42,8 @@ func Serve(
 	var eg errgroup.Group
 
 	missingPagesBeingHandled := map[int64]struct{}{}
+	// removedPages were released by the guest through the balloon device, they are served as zero pages when faulted again.
+	removedPages := map[int64]struct{}{}
 
 outerLoop:
 	for {
@@ -122,13 +124,36 @@ outerLoop:
 		}
 
 		msg := *(*userfaultfd.UffdMsg)(unsafe.Pointer(&buf[0]))
+		arg := userfaultfd.GetMsgArg(&msg)
+
+		if userfaultfd.GetMsgEvent(&msg) == userfaultfd.UFFD_EVENT_REMOVE {
+			remove := (*(*userfaultfd.UffdRemove)(unsafe.Pointer(&arg[0])))
+			start, end := userfaultfd.GetRemoveRange(&remove)
+
+			for addr := uintptr(start); addr < uintptr(end); {
+				offset, pagesize, err := mappings.GetRange(addr)
+				if err != nil {
+					logger.Error("UFFD serve get mapping error for removed range", zap.Error(err))
+
+					return fmt.Errorf("failed to map removed range: %w", err)
+				}
+
+				// The page must be served again when the guest faults on it.
+				delete(missingPagesBeingHandled, offset)
+				removedPages[offset] = struct{}{}
+
+				addr = (addr &^ uintptr(pagesize-1)) + uintptr(pagesize)
+			}
+
+			continue
+		}
+
 		if userfaultfd.GetMsgEvent(&msg) != userfaultfd.UFFD_EVENT_PAGEFAULT {
 			logger.Error("UFFD serve unexpected event type", zap.Any("event_type", userfaultfd.GetMsgEvent(&msg)))
 
 			return ErrUnexpectedEventType
 		}
 
-		arg := userfaultfd.GetMsgArg(&msg)
 		pagefault := (*(*userfaultfd.UffdPagefault)(unsafe.Pointer(&arg[0])))
 
 		addr := userfaultfd.GetPagefaultAddress(&pagefault)
@@ -146,6 +171,9 @@ outerLoop:
 
 		missingPagesBeingHandled[offset] = struct{}{}
 
+		_, removed := removedPages[offset]
+		delete(removedPages, offset)
+
 		eg.Go(func() error {
 			defer func() {
 				if r := recover(); r != nil {
@@ -153,7 +181,13 @@ outerLoop:
 				}
 			}()
 
-			b, err := src.Slice(ctx, offset, pagesize)
+			var b []byte
+			var err error
+			if removed {
+				b = make([]byte, pagesize)
+			} else {
+				b, err = src.Slice(ctx, offset, pagesize)
+			}
 			if err != nil {
 				signalErr := fdExit.SignalExit()
 
@@ -16,6 +16,11 @@ struct uffd_pagefault {
 	__u64	address;
 	__u32 ptid;
 };
+
+struct uffd_remove {
+	__u64	start;
+	__u64	end;
+};
 */
 import "C"
 import "unsafe"
@@ -25,6 +30,7 @@ const (
 
 	UFFD_API             = C.UFFD_API
 	UFFD_EVENT_PAGEFAULT = C.UFFD_EVENT_PAGEFAULT
+	UFFD_EVENT_REMOVE    = C.UFFD_EVENT_REMOVE
 
 	UFFDIO_REGISTER_MODE_MISSING = C.UFFDIO_REGISTER_MODE_MISSING
 	UFFDIO_REGISTER_MODE_WP      = C.UFFDIO_REGISTER_MODE_WP
@@ -51,6 +57,7 @@ type (
 
 	UffdMsg       = C.struct_uffd_msg
 	UffdPagefault = C.struct_uffd_pagefault
+	UffdRemove    = C.struct_uffd_remove
 
 	UffdioAPI          = C.struct_uffdio_api
 	UffdioRegister     = C.struct_uffdio_register
@@ -115,3 +122,7 @@ func IsWritePageFault(pagefault *UffdPagefault) bool {
 func IsWriteProtectPageFault(pagefault *UffdPagefault) bool {
 	return pagefault.flags&UFFD_PAGEFAULT_FLAG_WP != 0
 }
+
+func GetRemoveRange(remove *UffdRemove) (CULong, CULong) {
+	return remove.start, remove.end
+}
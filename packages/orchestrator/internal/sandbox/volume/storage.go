@@ -0,0 +1,111 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const (
+	storagePrefix = "volumes"
+
+	blockSize = 4096
+
+	// headerPath is the header of the latest volume state, the layers referenced by it are stored under their own IDs.
+	headerPath = string(build.Volume) + storage.HeaderSuffix
+)
+
+// prefixedStorage keeps all the volume objects under the volume prefix, so the layers can't collide with the template builds.
+type prefixedStorage struct {
+	storage.StorageProvider
+
+	prefix string
+}
+
+func newPrefixedStorage(persistence storage.StorageProvider, volumeID string) *prefixedStorage {
+	return &prefixedStorage{
+		StorageProvider: persistence,
+		prefix:          fmt.Sprintf("%s/%s/", storagePrefix, volumeID),
+	}
+}
+
+func (p *prefixedStorage) DeleteObjectsWithPrefix(ctx context.Context, prefix string) error {
+	return p.StorageProvider.DeleteObjectsWithPrefix(ctx, p.prefix+prefix)
+}
+
+func (p *prefixedStorage) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	paths, err := p.StorageProvider.ListObjectsWithPrefix(ctx, p.prefix+prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, path := range paths {
+		paths[i] = strings.TrimPrefix(path, p.prefix)
+	}
+
+	return paths, nil
+}
+
+func (p *prefixedStorage) UploadSignedURL(ctx context.Context, path string, ttl time.Duration) (string, error) {
+	return p.StorageProvider.UploadSignedURL(ctx, p.prefix+path, ttl)
+}
+
+func (p *prefixedStorage) OpenObject(ctx context.Context, path string) (storage.StorageObjectProvider, error) {
+	return p.StorageProvider.OpenObject(ctx, p.prefix+path)
+}
+
+// loadHeader returns the header of the latest volume state, volumes that were never written to get an empty header.
+func loadHeader(ctx context.Context, persistence storage.StorageProvider, sizeMB int64) (*header.Header, error) {
+	object, err := persistence.OpenObject(ctx, headerPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open volume header: %w", err)
+	}
+
+	h, err := header.Deserialize(ctx, object)
+	if err == nil {
+		return h, nil
+	}
+
+	if !errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, fmt.Errorf("failed to deserialize volume header: %w", err)
+	}
+
+	if sizeMB <= 0 {
+		return nil, fmt.Errorf("invalid volume size: %d MB", sizeMB)
+	}
+
+	// The nil build ID maps all the blocks as empty.
+	return header.NewHeader(header.NewTemplateMetadata(uuid.Nil, blockSize, uint64(sizeMB)<<20), nil)
+}
+
+func saveHeader(ctx context.Context, persistence storage.StorageProvider, h *header.Header) error {
+	serialized, err := header.Serialize(h.Metadata, h.Mapping)
+	if err != nil {
+		return fmt.Errorf("failed to serialize volume header: %w", err)
+	}
+
+	object, err := persistence.OpenObject(ctx, headerPath)
+	if err != nil {
+		return fmt.Errorf("failed to open volume header: %w", err)
+	}
+
+	_, err = object.Write(ctx, serialized)
+	if err != nil {
+		return fmt.Errorf("failed to write volume header: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes all the layers of the volume from the storage.
+func Delete(ctx context.Context, persistence storage.StorageProvider, volumeID string) error {
+	return newPrefixedStorage(persistence, volumeID).DeleteObjectsWithPrefix(ctx, "")
+}
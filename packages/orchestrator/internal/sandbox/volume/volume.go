@@ -0,0 +1,234 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/nbd"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume")
+
+// Volume is a persistent block device attached to the sandbox.
+// The volume is served through NBD from its layers in the storage, the writes are kept in a local cache
+// and exported as a new layer when the volume is closed.
+type Volume struct {
+	ID string
+	// MountPath is the path in the sandbox where the volume is mounted.
+	MountPath string
+
+	templateCache *template.Cache
+	persistence   storage.StorageProvider
+	header        *header.Header
+
+	overlay    *block.Overlay
+	mnt        *nbd.DirectPathMount
+	devicePath string
+
+	persisted *utils.ErrorOnce
+}
+
+// Open attaches the volume to an NBD device, the size is used only when the volume was never written to.
+// IMPORTANT: You must Close() the volume after the sandbox using it is stopped.
+func Open(
+	ctx context.Context,
+	templateCache *template.Cache,
+	persistence storage.StorageProvider,
+	devicePool *nbd.DevicePool,
+	volumeID string,
+	sizeMB int64,
+	mountPath string,
+) (*Volume, error) {
+	ctx, span := tracer.Start(ctx, "open-volume")
+	defer span.End()
+
+	volumeStorage := newPrefixedStorage(persistence, volumeID)
+
+	h, err := loadHeader(ctx, volumeStorage, sizeMB)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := templateCache.VolumeStorage(ctx, volumeStorage, volumeID, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume storage: %w", err)
+	}
+
+	size, err := device.Size()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume size: %w", err)
+	}
+
+	cachePath := filepath.Join(build.DefaultCachePath, fmt.Sprintf("volume-%s-%s.cow", volumeID, id.Generate()))
+
+	cache, err := block.NewCache(size, device.BlockSize(), cachePath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volume cache: %w", err)
+	}
+
+	overlay := block.NewOverlay(device, cache, device.BlockSize())
+	mnt := nbd.NewDirectPathMount(overlay, devicePool)
+
+	deviceIndex, err := mnt.Open(ctx)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to mount volume: %w", err), overlay.Close())
+	}
+
+	telemetry.ReportEvent(ctx, "mounted volume")
+
+	return &Volume{
+		ID:            volumeID,
+		MountPath:     mountPath,
+		templateCache: templateCache,
+		persistence:   volumeStorage,
+		header:        h,
+		overlay:       overlay,
+		mnt:           mnt,
+		devicePath:    nbd.GetDevicePath(deviceIndex),
+		persisted:     utils.NewErrorOnce(),
+	}, nil
+}
+
+// Path returns the NBD device serving the volume.
+func (v *Volume) Path() string {
+	return v.devicePath
+}
+
+// Wait waits until the volume is closed and its changes are persisted.
+func (v *Volume) Wait(ctx context.Context) error {
+	return v.persisted.WaitWithContext(ctx)
+}
+
+// Close releases the NBD device and uploads the changes as a new layer of the volume.
+func (v *Volume) Close(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "close-volume")
+	defer span.End()
+
+	var errs []error
+
+	err := v.flush()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error flushing volume device: %w", err))
+	}
+
+	err = v.mnt.Close(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error closing volume mount: %w", err))
+	}
+
+	err = v.persist(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error persisting volume: %w", err))
+	}
+
+	err = errors.Join(errs...)
+	if err != nil {
+		zap.L().Error("error closing volume", zap.String("volume_id", v.ID), zap.Error(err))
+	}
+
+	return v.persisted.SetError(err)
+}
+
+func (v *Volume) persist(ctx context.Context) error {
+	cache, err := v.overlay.EjectCache()
+	if err != nil {
+		return fmt.Errorf("error ejecting cache: %w", err)
+	}
+	defer cache.Close()
+
+	layerID := uuid.New()
+
+	diffFile, err := build.NewLocalDiffFile(build.DefaultCachePath, layerID.String(), build.Volume)
+	if err != nil {
+		return fmt.Errorf("failed to create volume diff file: %w", err)
+	}
+
+	m, err := cache.ExportToDiff(diffFile)
+	if err != nil {
+		return errors.Join(fmt.Errorf("error exporting cache: %w", err), diffFile.Close(), os.Remove(diffFile.Name()))
+	}
+
+	if m.Dirty.None() && m.Empty.None() {
+		telemetry.ReportEvent(ctx, "volume not changed")
+
+		return errors.Join(diffFile.Close(), os.Remove(diffFile.Name()))
+	}
+
+	if m.Dirty.Any() {
+		object, err := v.persistence.OpenObject(ctx, fmt.Sprintf("%s/%s", layerID, build.Volume))
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to open volume layer object: %w", err), diffFile.Close())
+		}
+
+		err = object.WriteFromFileSystem(ctx, diffFile.Name())
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to upload volume layer: %w", err), diffFile.Close())
+		}
+
+		telemetry.ReportEvent(ctx, "uploaded volume layer")
+	}
+
+	mapping, err := m.CreateMapping(ctx, layerID)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to create volume mapping: %w", err), diffFile.Close())
+	}
+
+	mappings := header.NormalizeMappings(header.MergeMappings(v.header.Mapping, mapping))
+
+	h, err := header.NewHeader(v.header.Metadata.NextGeneration(layerID), mappings)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to create volume header: %w", err), diffFile.Close())
+	}
+
+	// The header is written last, so the volume is never pointing to a layer that wasn't uploaded.
+	err = saveHeader(ctx, v.persistence, h)
+	if err != nil {
+		return errors.Join(err, diffFile.Close())
+	}
+
+	if m.Dirty.None() {
+		return errors.Join(diffFile.Close(), os.Remove(diffFile.Name()))
+	}
+
+	diff, err := diffFile.CloseToDiff(int64(h.Metadata.BlockSize))
+	if err != nil {
+		zap.L().Warn("failed to cache volume layer", zap.String("volume_id", v.ID), zap.Error(err))
+
+		return nil
+	}
+
+	v.templateCache.AddVolumeLayer(diff)
+
+	return nil
+}
+
+// flush flushes the data written by the sandbox to the NBD device.
+func (v *Volume) flush() error {
+	file, err := os.Open(v.devicePath)
+	if err != nil {
+		return fmt.Errorf("failed to open volume device: %w", err)
+	}
+	defer file.Close()
+
+	if err := unix.IoctlSetInt(int(file.Fd()), unix.BLKFLSBUF, 0); err != nil {
+		return fmt.Errorf("ioctl BLKFLSBUF failed: %w", err)
+	}
+
+	return file.Sync()
+}
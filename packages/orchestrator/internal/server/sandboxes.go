@@ -19,11 +19,15 @@ import (
 
 	clickhouse "github.com/e2b-dev/infra/packages/clickhouse/pkg"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/events/event"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	reverseproxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
@@ -37,6 +41,20 @@ const (
 )
 
 func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequest) (*orchestrator.SandboxCreateResponse, error) {
+	err := s.createSandbox(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId: s.info.ClientId,
+	}, nil
+}
+
+// createSandbox starts the sandbox on this node and registers it in the sandbox map.
+// When enforceLimits is false, the per-node limits for running and starting sandboxes are not checked,
+// this is used when the sandbox already counted towards the limits (e.g. resuming the original sandbox after a fork).
+func (s *server) createSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest, enforceLimits bool) error {
 	// set max request timeout for this request
 	ctx, cancel := context.WithTimeoutCause(ctx, requestTimeout, fmt.Errorf("request timed out"))
 	defer cancel()
@@ -67,25 +85,35 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			Build(),
 	)
 
-	maxRunningSandboxesPerNode, err := s.featureFlags.IntFlag(ctx, featureflags.MaxSandboxesPerNode)
-	if err != nil {
-		zap.L().Error("Failed to get MaxSandboxesPerNode flag", zap.Error(err))
+	// The sandboxes can't be emulated, the template must be built for the node architecture
+	cpuArchitecture := consts.CPUArchitectureOrDefault(req.GetSandbox().GetCpuArchitecture())
+	if cpuArchitecture != fc.HostArchitecture {
+		telemetry.ReportEvent(ctx, "sandbox architecture doesn't match the node")
+
+		return status.Errorf(codes.FailedPrecondition, "sandbox architecture %s doesn't match the node architecture %s", cpuArchitecture, fc.HostArchitecture)
 	}
 
-	runningSandboxes := s.sandboxes.Count()
-	if runningSandboxes >= maxRunningSandboxesPerNode {
-		telemetry.ReportEvent(ctx, "max number of running sandboxes reached")
+	if enforceLimits {
+		maxRunningSandboxesPerNode, err := s.featureFlags.IntFlag(ctx, featureflags.MaxSandboxesPerNode)
+		if err != nil {
+			zap.L().Error("Failed to get MaxSandboxesPerNode flag", zap.Error(err))
+		}
 
-		return nil, status.Errorf(codes.ResourceExhausted, "max number of running sandboxes on node reached (%d), please retry", maxRunningSandboxesPerNode)
-	}
+		runningSandboxes := s.sandboxes.Count()
+		if runningSandboxes >= maxRunningSandboxesPerNode {
+			telemetry.ReportEvent(ctx, "max number of running sandboxes reached")
 
-	// Check if we've reached the max number of starting instances on this node
-	acquired := s.startingSandboxes.TryAcquire(1)
-	if !acquired {
-		telemetry.ReportEvent(ctx, "too many starting sandboxes on node")
-		return nil, status.Errorf(codes.ResourceExhausted, "too many sandboxes starting on this node, please retry")
+			return status.Errorf(codes.ResourceExhausted, "max number of running sandboxes on node reached (%d), please retry", maxRunningSandboxesPerNode)
+		}
+
+		// Check if we've reached the max number of starting instances on this node
+		acquired := s.startingSandboxes.TryAcquire(1)
+		if !acquired {
+			telemetry.ReportEvent(ctx, "too many starting sandboxes on node")
+			return status.Errorf(codes.ResourceExhausted, "too many sandboxes starting on this node, please retry")
+		}
+		defer s.startingSandboxes.Release(1)
 	}
-	defer s.startingSandboxes.Release(1)
 
 	metricsWriteFlag, flagErr := s.featureFlags.BoolFlag(ctx, featureflags.MetricsWriteFlagName)
 	if flagErr != nil {
@@ -101,7 +129,13 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		false,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get template snapshot data: %w", err)
+		return fmt.Errorf("failed to get template snapshot data: %w", err)
+	}
+
+	volumes, err := s.openVolumes(ctx, req.GetSandbox().GetVolumes())
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to open sandbox volumes", err)
+		return status.Errorf(codes.Internal, "failed to open sandbox volumes: %s", err)
 	}
 
 	sbx, err := sandbox.ResumeSandbox(
@@ -117,6 +151,16 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 			HugePages:       req.Sandbox.HugePages,
 
 			AllowInternetAccess: req.Sandbox.AllowInternetAccess,
+			Egress: network.Egress{
+				AllowOut: req.Sandbox.GetNetwork().GetAllowOut(),
+				DenyOut:  req.Sandbox.GetNetwork().GetDenyOut(),
+
+				Nameservers: req.Sandbox.GetNetwork().GetDns().GetNameservers(),
+			},
+			NetworkBandwidthMbps: req.Sandbox.GetNetworkBandwidthMbps(),
+			DNS:                  dnsFromConfig(req.Sandbox.GetNetwork().GetDns()),
+			Volumes:              volumes,
+			IdleTimeout:          time.Duration(req.Sandbox.GetIdleTimeout()) * time.Second,
 
 			Envd: sandbox.EnvdMetadata{
 				Version:     req.Sandbox.EnvdVersion,
@@ -140,7 +184,22 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 	if err != nil {
 		err := errors.Join(err, context.Cause(ctx))
 		telemetry.ReportCriticalError(ctx, "failed to create sandbox", err)
-		return nil, status.Errorf(codes.Internal, "failed to create sandbox: %s", err)
+		return status.Errorf(codes.Internal, "failed to create sandbox: %s", err)
+	}
+
+	sbx.SetIngress(reverseproxy.NewIngressFromConfig(req.Sandbox.GetNetwork().GetIngress()))
+
+	// The balloon is part of the snapshot, it's set again so the sandbox has the memory limit the API knows about.
+	// The sandbox isn't started with a limit it doesn't have, the API would report it as applied.
+	if req.Sandbox.GetMemoryLimitMb() > 0 {
+		err = sbx.SetMemoryLimit(ctx, req.Sandbox.GetMemoryLimitMb())
+		if err != nil {
+			closeErr := sbx.Close(context.WithoutCancel(ctx))
+			err = errors.Join(err, closeErr)
+			telemetry.ReportCriticalError(ctx, "failed to apply the sandbox memory limit", err)
+
+			return status.Errorf(codes.FailedPrecondition, "failed to apply the sandbox memory limit: %s", err)
+		}
 	}
 
 	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
@@ -198,9 +257,7 @@ func (s *server) Create(ctx context.Context, req *orchestrator.SandboxCreateRequ
 		EventData:          eventData,
 	})
 
-	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.info.ClientId,
-	}, nil
+	return nil
 }
 
 func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
@@ -219,6 +276,24 @@ func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequ
 		return nil, status.Error(codes.NotFound, "sandbox not found")
 	}
 
+	if req.MemoryLimitMb != nil {
+		err := updateMemoryLimit(ctx, sbx, req.GetMemoryLimitMb())
+		if err != nil {
+			telemetry.ReportError(ctx, "failed to update sandbox memory limit", err)
+
+			return nil, err
+		}
+	}
+
+	if req.Ingress != nil {
+		updateIngress(sbx, req.Ingress)
+	}
+
+	// Only the ingress or the memory limit can be updated, the end time is kept then
+	if req.EndTime == nil {
+		return &emptypb.Empty{}, nil
+	}
+
 	sbx.EndAt = req.EndTime.AsTime()
 
 	teamID, buildId, eventData := s.prepareSandboxEventData(sbx)
@@ -252,15 +327,18 @@ func (s *server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.Sand
 			continue
 		}
 
-		if sbx.APIStoredConfig == nil {
+		config := sbx.StoredConfig()
+		if config == nil {
 			continue
 		}
 
 		sandboxes = append(sandboxes, &orchestrator.RunningSandbox{
-			Config:    sbx.APIStoredConfig,
+			Config:    config,
 			ClientId:  s.info.ClientId,
 			StartTime: timestamppb.New(sbx.StartedAt),
 			EndTime:   timestamppb.New(sbx.EndAt),
+
+			LastActivity: timestamppb.New(sbx.Activity().Last()),
 		})
 	}
 
@@ -321,6 +399,14 @@ func (s *server) Delete(ctxConn context.Context, in *orchestrator.SandboxDeleteR
 		EventData:          eventData,
 	})
 
+	// The volumes can be attached to another sandbox right after the kill, so the request waits until they are persisted.
+	err := sbx.WaitForVolumes(ctx)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error persisting sandbox volumes", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error persisting sandbox '%s' volumes: %s", in.SandboxId, err)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -328,27 +414,38 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 	ctx, childSpan := tracer.Start(ctx, "sandbox-pause")
 	defer childSpan.End()
 
+	err := s.pauseSandbox(ctx, in.SandboxId, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// pauseSandbox snapshots the sandbox, stops it and adds the snapshot to the local template cache.
+// The snapshot is uploaded to the persistent storage in the background.
+func (s *server) pauseSandbox(ctx context.Context, sandboxID, templateID, buildID string) error {
 	// setup launch darkly
 	ctx = featureflags.SetContext(
 		ctx,
-		ldcontext.NewBuilder(in.SandboxId).
+		ldcontext.NewBuilder(sandboxID).
 			Kind(featureflags.SandboxKind).
-			SetString(featureflags.SandboxTemplateAttribute, in.TemplateId).
+			SetString(featureflags.SandboxTemplateAttribute, templateID).
 			Build(),
 	)
 
 	s.pauseMu.Lock()
 
-	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	sbx, ok := s.sandboxes.Get(sandboxID)
 	if !ok {
 		s.pauseMu.Unlock()
 
 		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
 
-		return nil, status.Error(codes.NotFound, "sandbox not found")
+		return status.Error(codes.NotFound, "sandbox not found")
 	}
 
-	s.sandboxes.Remove(in.SandboxId)
+	s.sandboxes.Remove(sandboxID)
 
 	s.pauseMu.Unlock()
 
@@ -361,27 +458,27 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 			err := sbx.Stop(ctx)
 			if err != nil {
-				sbxlogger.I(sbx).Error("error stopping sandbox after snapshot", logger.WithSandboxID(in.SandboxId), zap.Error(err))
+				sbxlogger.I(sbx).Error("error stopping sandbox after snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
 			}
 		}()
 	}(context.WithoutCancel(ctx))
 
 	meta, err := sbx.Template.Metadata()
 	if err != nil {
-		return nil, fmt.Errorf("no metadata found in template: %w", err)
+		return fmt.Errorf("no metadata found in template: %w", err)
 	}
 
 	fcVersions := sbx.FirecrackerVersions()
 	meta = meta.SameVersionTemplate(storage.TemplateFiles{
-		BuildID:            in.BuildId,
+		BuildID:            buildID,
 		KernelVersion:      fcVersions.KernelVersion,
 		FirecrackerVersion: fcVersions.FirecrackerVersion,
 	})
 	snapshot, err := sbx.Pause(ctx, meta)
 	if err != nil {
-		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(in.SandboxId))
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(sandboxID))
 
-		return nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", in.SandboxId, err)
+		return status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", sandboxID, err)
 	}
 
 	err = s.templateCache.AddSnapshot(
@@ -399,7 +496,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
 
-		return nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+		return status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
 	}
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
@@ -427,7 +524,7 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 		EventData:          eventData,
 	})
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 // Extracts common data needed for sandbox events
@@ -439,11 +536,11 @@ func (s *server) prepareSandboxEventData(sbx *sandbox.Sandbox) (uuid.UUID, strin
 
 	buildId := ""
 	eventData := make(map[string]any)
-	if sbx.APIStoredConfig != nil {
-		buildId = sbx.APIStoredConfig.BuildId
-		if sbx.APIStoredConfig.Metadata != nil {
+	if config := sbx.StoredConfig(); config != nil {
+		buildId = config.BuildId
+		if config.Metadata != nil {
 			// Copy the map to avoid race conditions
-			eventData["sandbox_metadata"] = utils.ShallowCopyMap(sbx.APIStoredConfig.Metadata)
+			eventData["sandbox_metadata"] = utils.ShallowCopyMap(config.Metadata)
 		}
 	}
 
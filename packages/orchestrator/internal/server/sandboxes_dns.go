@@ -0,0 +1,24 @@
+package server
+
+import (
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// dnsFromConfig converts the sandbox DNS config to the metadata read by envd, nil config keeps the template resolver config.
+func dnsFromConfig(config *orchestrator.SandboxDNSConfig) *fc.MmdsDNS {
+	if config == nil {
+		return nil
+	}
+
+	dns := &fc.MmdsDNS{
+		Nameservers: config.GetNameservers(),
+		Searches:    config.GetSearches(),
+	}
+
+	for _, host := range config.GetHosts() {
+		dns.Hosts = append(dns.Hosts, fc.MmdsHostEntry{IP: host.GetIp(), Hostname: host.GetHostname()})
+	}
+
+	return dns
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// Exec runs the command in the running sandbox and returns after the command exits.
+func (s *server) Exec(ctx context.Context, in *orchestrator.SandboxExecRequest) (*orchestrator.SandboxExecResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-exec")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.GetSandboxId()),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.Int64("exec.timeout_seconds", in.GetTimeoutSeconds()),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.GetSandboxId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "sandbox '%s' not found", in.GetSandboxId())
+	}
+
+	if in.GetTimeoutSeconds() > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(in.GetTimeoutSeconds())*time.Second)
+		defer cancel()
+	}
+
+	result, err := sbx.Exec(ctx, in.GetCommand(), in.GetUser())
+	if err != nil {
+		telemetry.ReportError(ctx, "error running command in sandbox", err)
+
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "command in sandbox '%s' timed out", in.GetSandboxId())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to run command in sandbox '%s': %s", in.GetSandboxId(), err)
+	}
+
+	return &orchestrator.SandboxExecResponse{
+		ExitCode: result.ExitCode,
+		Output:   result.Output,
+	}, nil
+}
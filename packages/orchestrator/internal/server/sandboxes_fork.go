@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// Fork snapshots the running sandbox and starts the original sandbox and all the forks from the snapshot.
+// The snapshot is added to the local template cache, so the sandboxes are started from the local diffs
+// and share the base pages with the original sandbox without waiting for the snapshot upload.
+func (s *server) Fork(ctx context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.Int("fork.count", len(in.Forks)),
+	)
+
+	if in.Original == nil || in.Original.Sandbox == nil {
+		return nil, status.Error(codes.InvalidArgument, "original sandbox config is required")
+	}
+
+	err := s.pauseSandbox(ctx, in.SandboxId, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.ReportEvent(ctx, "created fork snapshot")
+
+	// The original sandbox was already counted towards the node limits, so we don't want to fail on them here.
+	err = s.createSandbox(ctx, in.Original, false)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error resuming original sandbox after fork", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, status.Errorf(codes.Internal, "error resuming sandbox '%s' after fork: %s", in.SandboxId, err)
+	}
+
+	telemetry.ReportEvent(ctx, "resumed original sandbox")
+
+	// The forks are started one by one, so a single fork request doesn't take all the starting slots on the node.
+	sandboxIDs := make([]string, 0, len(in.Forks))
+	for _, fork := range in.Forks {
+		if fork == nil || fork.Sandbox == nil {
+			continue
+		}
+
+		err := s.createSandbox(ctx, fork, true)
+		if err != nil {
+			zap.L().Error("failed to start sandbox fork",
+				logger.WithSandboxID(fork.Sandbox.SandboxId),
+				zap.String("forked_sandbox_id", in.SandboxId),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		sandboxIDs = append(sandboxIDs, fork.Sandbox.SandboxId)
+	}
+
+	telemetry.SetAttributes(ctx, attribute.Int("fork.started", len(sandboxIDs)))
+
+	return &orchestrator.SandboxForkResponse{
+		ClientId:   s.info.ClientId,
+		SandboxIds: sandboxIDs,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	reverseproxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+)
+
+// updateIngress replaces the ingress of the running sandbox.
+// The stored config is replaced with a copy, so the sandbox list returns the updated ingress without mutating the shared config.
+func updateIngress(sbx *sandbox.Sandbox, config *orchestrator.SandboxIngressConfig) {
+	sbx.SetIngress(reverseproxy.NewIngressFromConfig(config))
+
+	sbx.UpdateStoredConfig(func(storedConfig *orchestrator.SandboxConfig) {
+		if storedConfig.Network == nil {
+			storedConfig.Network = &orchestrator.SandboxNetworkConfig{}
+		}
+
+		storedConfig.Network.Ingress = config
+	})
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// updateMemoryLimit resizes the memory available to the running sandbox.
+// The stored config is replaced with a copy, so the sandbox list returns the updated limit without mutating the shared config.
+func updateMemoryLimit(ctx context.Context, sbx *sandbox.Sandbox, memoryLimitMB int64) error {
+	err := sbx.SetMemoryLimit(ctx, memoryLimitMB)
+	if errors.Is(err, sandbox.ErrInvalidMemoryLimit) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		// The templates built before the balloon device was added can't be resized.
+		return status.Errorf(codes.FailedPrecondition, "failed to resize the sandbox memory: %s", err)
+	}
+
+	sbx.UpdateStoredConfig(func(storedConfig *orchestrator.SandboxConfig) {
+		storedConfig.MemoryLimitMb = memoryLimitMB
+	})
+
+	return nil
+}
@@ -2,9 +2,12 @@ package server
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -59,6 +62,8 @@ func Test_server_List(t *testing.T) {
 						// ClientId:  "client-id",
 						StartTime: timestamppb.New(startTime),
 						EndTime:   timestamppb.New(endTime),
+						// The sandbox in the test isn't started, so its activity was never marked
+						LastActivity: timestamppb.New(time.Unix(0, 0)),
 					},
 				},
 			},
@@ -85,3 +90,42 @@ func Test_server_List(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateIngress_StoredConfig(t *testing.T) {
+	sbx := &sandbox.Sandbox{
+		APIStoredConfig: &orchestrator.SandboxConfig{TemplateId: "template-id"},
+		Metadata: &sandbox.Metadata{
+			Runtime: sandbox.RuntimeMetadata{SandboxID: id.Generate()},
+		},
+	}
+
+	s := &server{
+		sandboxes: smap.New[*sandbox.Sandbox](),
+		info:      &service.ServiceInfo{},
+	}
+	s.sandboxes.Insert(sbx.Runtime.SandboxID, sbx)
+
+	before := sbx.StoredConfig()
+
+	// The config is replaced while the sandboxes are listed
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			updateIngress(sbx, &orchestrator.SandboxIngressConfig{DefaultAccess: orchestrator.SandboxPortAccess_PortToken})
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := s.List(t.Context(), &emptypb.Empty{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Nil(t, before.GetNetwork(), "the config returned before the update was mutated")
+
+	after := sbx.StoredConfig()
+	require.NotNil(t, after.GetNetwork())
+	assert.Equal(t, orchestrator.SandboxPortAccess_PortToken, after.GetNetwork().GetIngress().GetDefaultAccess())
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/volume"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// openVolumes attaches the sandbox volumes to NBD devices, the volumes are closed with the sandbox.
+func (s *server) openVolumes(ctx context.Context, mounts []*orchestrator.SandboxVolumeMount) ([]*volume.Volume, error) {
+	volumes := make([]*volume.Volume, 0, len(mounts))
+
+	for _, mount := range mounts {
+		v, err := volume.Open(
+			ctx,
+			s.templateCache,
+			s.persistence,
+			s.devicePool,
+			mount.GetVolumeId(),
+			mount.GetSizeMb(),
+			mount.GetPath(),
+		)
+		if err != nil {
+			errs := []error{fmt.Errorf("failed to open volume '%s': %w", mount.GetVolumeId(), err)}
+			for _, opened := range volumes {
+				errs = append(errs, opened.Close(ctx))
+			}
+
+			return nil, errors.Join(errs...)
+		}
+
+		volumes = append(volumes, v)
+	}
+
+	return volumes, nil
+}
+
+func (s *server) VolumeDelete(ctx context.Context, in *orchestrator.VolumeDeleteRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := tracer.Start(ctx, "volume-delete")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		attribute.String("volume.id", in.GetVolumeId()),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	err := volume.Delete(ctx, s.persistence, in.GetVolumeId())
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error deleting volume", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to delete volume '%s': %s", in.GetVolumeId(), err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	orchestratorinfo "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
@@ -72,8 +73,9 @@ func (s *Server) ServiceInfo(_ context.Context, _ *emptypb.Empty) (*orchestrator
 		ServiceVersion: info.SourceVersion,
 		ServiceCommit:  info.SourceCommit,
 
-		ServiceStartup: timestamppb.New(info.Startup),
-		ServiceRoles:   info.Roles,
+		ServiceStartup:         timestamppb.New(info.Startup),
+		ServiceRoles:           info.Roles,
+		ServiceCpuArchitecture: fc.HostArchitecture,
 
 		// Allocated resources to sandboxes
 		MetricCpuAllocated:         sandboxVCpuAllocated,
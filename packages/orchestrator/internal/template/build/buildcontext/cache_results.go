@@ -0,0 +1,41 @@
+package buildcontext
+
+import (
+	"slices"
+	"sync"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+// CacheResults collects the layer cache results of the build steps, they are reported in the build status.
+type CacheResults struct {
+	mu      sync.Mutex
+	results []*templatemanager.TemplateBuildStepCacheResult
+}
+
+func NewCacheResults() *CacheResults {
+	return &CacheResults{}
+}
+
+// Add records the cache result of the step, it's a no-op for builds that don't report the results.
+func (c *CacheResults) Add(result *templatemanager.TemplateBuildStepCacheResult) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results = append(c.results, result)
+}
+
+func (c *CacheResults) List() []*templatemanager.TemplateBuildStepCacheResult {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.results)
+}
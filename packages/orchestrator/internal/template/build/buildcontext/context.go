@@ -1,6 +1,8 @@
 package buildcontext
 
 import (
+	"fmt"
+
 	"golang.org/x/sync/errgroup"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
@@ -14,4 +16,17 @@ type BuildContext struct {
 	EnvdVersion    string
 	CacheScope     string
 	IsV1Build      bool
+	// Stage is the name of the build stage being built, empty for the template itself.
+	Stage string
+	// CacheResults collects the layer cache results of the steps, nil when they are not reported.
+	CacheResults *CacheResults
+}
+
+// Prefix adds the stage name to the log prefix of the build phase.
+func (bc BuildContext) Prefix(prefix string) string {
+	if bc.Stage == "" {
+		return prefix
+	}
+
+	return fmt.Sprintf("stage %s %s", bc.Stage, prefix)
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
@@ -26,10 +28,13 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/base"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/finalize"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/steps"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/provenance"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -98,7 +103,10 @@ type Result struct {
 //
 // 8. Snapshot
 // 9. Upload template (and all not yet uploaded layers)
-func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, config config.TemplateConfig, logsCore zapcore.Core) (r *Result, e error) {
+// 10. Upload the provenance document of the build
+//
+// The cache results of the steps are collected in cacheResults, it can be nil if they are not reported.
+func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, config config.TemplateConfig, logsCore zapcore.Core, cacheResults *buildcontext.CacheResults) (r *Result, e error) {
 	ctx, childSpan := tracer.Start(ctx, "build")
 	defer childSpan.End()
 
@@ -120,6 +128,11 @@ func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, con
 
 	cacheScope := config.CacheScope
 
+	// The cache results are needed for the build provenance even when they are not reported
+	if cacheResults == nil {
+		cacheResults = buildcontext.NewCacheResults()
+	}
+
 	// Validate template, update force layers if needed
 	config = forceSteps(config)
 
@@ -176,6 +189,7 @@ func (b *Builder) Build(ctx context.Context, template storage.TemplateFiles, con
 		EnvdVersion:    envdVersion,
 		CacheScope:     cacheScope,
 		IsV1Build:      isV1Build,
+		CacheResults:   cacheResults,
 	}
 
 	return runBuild(ctx, logger, buildContext, b)
@@ -214,18 +228,32 @@ func runBuild(
 		builder.metrics,
 	)
 
+	buildStages := stages.New(bc, builder.proxy, layerExecutor)
+
 	commandExecutor := commands.NewCommandExecutor(
 		bc,
 		builder.buildStorage,
 		builder.proxy,
+		buildStages,
 	)
 
+	// Build the stages first, so the template steps can copy the files from them
+	for _, stage := range bc.Config.Stages {
+		stageResult, err := runStage(ctx, userLogger, bc, builder, stage, layerExecutor, commandExecutor, buildStages, index)
+		if err != nil {
+			return nil, err
+		}
+
+		buildStages.Add(stage.GetName(), stageResult)
+	}
+
 	stepBuilders := steps.CreateStepPhases(
 		bc,
 		builder.logger,
 		builder.proxy,
 		layerExecutor,
 		commandExecutor,
+		buildStages,
 		index,
 		builder.metrics,
 	)
@@ -264,16 +292,93 @@ func runBuild(
 	}
 	zap.L().Info("rootfs size", zap.Uint64("size", rootfsSize))
 
+	err = provenance.Upload(ctx, builder.templateStorage, buildProvenance(bc, buildStages, lastLayerResult))
+	if err != nil {
+		return nil, fmt.Errorf("error uploading build provenance: %w", err)
+	}
+
 	return &Result{
 		EnvdVersion:  bc.EnvdVersion,
 		RootfsSizeMB: int64(rootfsSize >> constants.ToMBShift),
 	}, nil
 }
 
+// runStage builds the base and the step layers of the stage, the stage is not finalized as no sandbox is started from it.
+func runStage(
+	ctx context.Context,
+	userLogger *zap.Logger,
+	bc buildcontext.BuildContext,
+	builder *Builder,
+	stage *templatemanager.TemplateStage,
+	layerExecutor *layer.LayerExecutor,
+	commandExecutor *commands.CommandExecutor,
+	buildStages *stages.Stages,
+	index cache.Index,
+) (phases.LayerResult, error) {
+	ctx, span := tracer.Start(ctx, "run stage", trace.WithAttributes(
+		attribute.String("stage", stage.GetName()),
+	))
+	defer span.End()
+
+	stageContext := bc
+	stageContext.Stage = stage.GetName()
+	stageContext.Config.FromImage = stage.GetFromImage()
+	stageContext.Config.FromTemplate = nil
+	stageContext.Config.Steps = stage.GetSteps()
+	stageContext.Config.Stages = nil
+
+	baseBuilder := base.New(
+		stageContext,
+		builder.logger,
+		builder.proxy,
+		builder.templateStorage,
+		builder.devicePool,
+		builder.networkPool,
+		builder.artifactRegistry,
+		layerExecutor,
+		index,
+		builder.metrics,
+	)
+
+	stepBuilders := steps.CreateStepPhases(
+		stageContext,
+		builder.logger,
+		builder.proxy,
+		layerExecutor,
+		commandExecutor,
+		buildStages,
+		index,
+		builder.metrics,
+	)
+
+	builders := []phases.BuilderPhase{
+		baseBuilder,
+	}
+	builders = append(builders, stepBuilders...)
+
+	result, err := phases.Run(ctx, userLogger, stageContext, builder.metrics, builders)
+	if err != nil {
+		return phases.LayerResult{}, fmt.Errorf("error building stage %s: %w", stage.GetName(), err)
+	}
+
+	return result, nil
+}
+
 // forceSteps sets force for all steps after the first encounter.
+// The stages are independent layer chains, so they are forced separately.
 func forceSteps(template config.TemplateConfig) config.TemplateConfig {
-	shouldRebuild := template.Force != nil && *template.Force
-	for _, step := range template.Steps {
+	for _, stage := range template.Stages {
+		forceStepsChain(template.Force, stage.GetSteps())
+	}
+
+	forceStepsChain(template.Force, template.Steps)
+
+	return template
+}
+
+func forceStepsChain(force *bool, steps []*templatemanager.TemplateStep) {
+	shouldRebuild := force != nil && *force
+	for _, step := range steps {
 		// Force rebuild if the step has a Force flag set to true
 		if step.Force != nil && *step.Force {
 			shouldRebuild = true
@@ -283,11 +388,9 @@ func forceSteps(template config.TemplateConfig) config.TemplateConfig {
 			continue
 		}
 
-		force := true
-		step.Force = &force
+		forceStep := true
+		step.Force = &forceStep
 	}
-
-	return template
 }
 
 func getRootfsSize(
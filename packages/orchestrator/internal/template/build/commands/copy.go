@@ -11,6 +11,7 @@ import (
 	txtTemplate "text/template"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
@@ -21,9 +22,15 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
+// StageFiles exports the files from the finished build stages.
+type StageFiles interface {
+	ExportFiles(ctx context.Context, stage string, sourcePath string, targetPath string) error
+}
+
 type Copy struct {
 	FilesStorage storage.StorageProvider
 	CacheScope   string
+	Stages       StageFiles
 }
 
 var _ Command = (*Copy)(nil)
@@ -49,13 +56,22 @@ fi
 
 cd "$sourceFolder" || exit 1
 
-entry=$(ls -A | head -n 1)
+# Prefer the exact source entry, the tar can contain the whole build context
+entry="$(basename "{{ .SourcePath }}")"
+if [ ! -e "$entry" ] && [ ! -L "$entry" ]; then
+ entry=$(ls -A | head -n 1)
+fi
 
 if [ -z "$entry" ]; then
  echo "Error: sourceFolder is empty"
  exit 1
 fi
 
+# A target ending with a slash is a directory, files are moved into it keeping their name
+if [[ "$inputPath" = */ ]] && { [ -L "$entry" ] || [ -f "$entry" ]; }; then
+ targetPath="$targetPath$(basename "$entry")"
+fi
+
 if [ -L "$entry" ]; then
  # It's a symlink file – create parent folders and move+rename it to the exact path
  mkdir -p "$(dirname "$targetPath")"
@@ -76,7 +92,7 @@ fi
 
 // Execute implements the Copy command.
 // It works in the following steps:
-// 1) Downloads the layer tar file from the storage to the local filesystem
+// 1) Downloads the layer tar file from the storage (or exports it from the build stage) to the local filesystem
 // 2) Copies the file to the sandbox's /tmp directory
 // 3) Extracts it (still in the /tmp directory)
 // 4) Moves the extracted files to the target path in the sandbox
@@ -101,25 +117,11 @@ func (c *Copy) Execute(
 		return metadata.Context{}, fmt.Errorf("%s requires a local path and a container path argument", cmdType)
 	}
 
-	if step.FilesHash == nil || *step.FilesHash == "" {
+	fromStage := step.FromStage != nil && *step.FromStage != ""
+	if !fromStage && (step.FilesHash == nil || *step.FilesHash == "") {
 		return metadata.Context{}, fmt.Errorf("%s requires files hash to be set", cmdType)
 	}
 
-	// 1) Download the layer tar file from the storage to the local filesystem
-	obj, err := c.FilesStorage.OpenObject(ctx, paths.GetLayerFilesCachePath(c.CacheScope, *step.FilesHash))
-	if err != nil {
-		return metadata.Context{}, fmt.Errorf("failed to open files object from storage: %w", err)
-	}
-
-	pr, pw := io.Pipe()
-	// Start writing tar data to the pipe writer in a goroutine
-	go func() {
-		defer pw.Close()
-		if _, err := obj.WriteTo(ctx, pw); err != nil {
-			pw.CloseWithError(err)
-		}
-	}()
-
 	tmpFile, err := os.CreateTemp("", "layer-file-*.tar")
 	if err != nil {
 		return metadata.Context{}, fmt.Errorf("failed to create temporary file for layer tar: %w", err)
@@ -127,21 +129,36 @@ func (c *Copy) Execute(
 	defer os.Remove(tmpFile.Name())
 	defer tmpFile.Close()
 
-	_, err = io.Copy(tmpFile, pr)
-	if err != nil {
-		return metadata.Context{}, fmt.Errorf("failed to copy layer tar data to temporary file: %w", err)
+	// 1) Download the layer tar file to the local filesystem
+	var filesID string
+	if fromStage {
+		if c.Stages == nil {
+			return metadata.Context{}, fmt.Errorf("%s from a stage is not supported in this build", cmdType)
+		}
+
+		filesID = fmt.Sprintf("stage-%s", uuid.NewString())
+		err = c.Stages.ExportFiles(ctx, *step.FromStage, args[0], tmpFile.Name())
+		if err != nil {
+			return metadata.Context{}, fmt.Errorf("failed to export files from stage %s: %w", *step.FromStage, err)
+		}
+	} else {
+		filesID = *step.FilesHash
+		err = c.download(ctx, filesID, tmpFile)
+		if err != nil {
+			return metadata.Context{}, err
+		}
 	}
 
 	// The file is automatically cleaned up by the sandbox restart in the last step.
 	// This is happening because the /tmp is mounted as a tmpfs and deleted on restart.
-	sbxTargetPath := filepath.Join("/tmp", fmt.Sprintf("%s.tar", *step.FilesHash))
+	sbxTargetPath := filepath.Join("/tmp", fmt.Sprintf("%s.tar", filesID))
 	// 2) Copy the tar file to the sandbox
 	err = sandboxtools.CopyFile(ctx, proxy, sandboxID, cmdMetadata.User, tmpFile.Name(), sbxTargetPath)
 	if err != nil {
 		return metadata.Context{}, fmt.Errorf("failed to copy layer tar data to sandbox: %w", err)
 	}
 
-	sbxUnpackPath := filepath.Join("/tmp", *step.FilesHash)
+	sbxUnpackPath := filepath.Join("/tmp", filesID)
 
 	// 3) Extract the tar file in the sandbox's /tmp directory
 	err = sandboxtools.RunCommand(
@@ -220,6 +237,30 @@ func (c *Copy) Execute(
 	return cmdMetadata, nil
 }
 
+// download writes the layer tar file from the storage to the local file.
+func (c *Copy) download(ctx context.Context, filesHash string, file *os.File) error {
+	obj, err := c.FilesStorage.OpenObject(ctx, paths.GetLayerFilesCachePath(c.CacheScope, filesHash))
+	if err != nil {
+		return fmt.Errorf("failed to open files object from storage: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	// Start writing tar data to the pipe writer in a goroutine
+	go func() {
+		defer pw.Close()
+		if _, err := obj.WriteTo(ctx, pw); err != nil {
+			pw.CloseWithError(err)
+		}
+	}()
+
+	_, err = io.Copy(file, pr)
+	if err != nil {
+		return fmt.Errorf("failed to copy layer tar data to temporary file: %w", err)
+	}
+
+	return nil
+}
+
 func ensureTrailingSlash(s string) string {
 	if strings.HasSuffix(s, "/") {
 		return s
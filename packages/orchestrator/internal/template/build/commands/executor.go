@@ -26,18 +26,21 @@ type CommandExecutor struct {
 
 	buildStorage storage.StorageProvider
 	proxy        *proxy.SandboxProxy
+	stages       StageFiles
 }
 
 func NewCommandExecutor(
 	buildContext buildcontext.BuildContext,
 	buildStorage storage.StorageProvider,
 	proxy *proxy.SandboxProxy,
+	stages StageFiles,
 ) *CommandExecutor {
 	return &CommandExecutor{
 		BuildContext: buildContext,
 
 		buildStorage: buildStorage,
 		proxy:        proxy,
+		stages:       stages,
 	}
 }
 
@@ -52,9 +55,14 @@ func (ce *CommandExecutor) getCommand(
 		cmd = &Copy{
 			FilesStorage: ce.buildStorage,
 			CacheScope:   ce.CacheScope,
+			Stages:       ce.stages,
 		}
 	case "RUN":
-		cmd = &Run{}
+		cmd = &Run{
+			FilesStorage: ce.buildStorage,
+			CacheScope:   ce.CacheScope,
+			Secrets:      ce.Config.Secrets,
+		}
 	case "USER":
 		cmd = &User{}
 	case "WORKDIR":
@@ -84,6 +92,7 @@ func (ce *CommandExecutor) Execute(
 		attribute.String("step.type", step.Type),
 		attribute.StringSlice("step.args", step.Args),
 		attribute.String("step.files.hash", utils.Sprintp(step.FilesHash)),
+		attribute.String("step.from.stage", utils.Sprintp(step.FromStage)),
 	))
 	defer span.End()
 
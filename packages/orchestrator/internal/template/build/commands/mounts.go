@@ -0,0 +1,327 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/paths"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	mountTypeSecret = "secret"
+	mountTypeCache  = "cache"
+
+	secretsDir = "/run/secrets"
+)
+
+// unmount removes the mount from the sandbox, so its content doesn't end up in the layer.
+type unmount func(ctx context.Context) error
+
+var rootContext = metadata.Context{User: "root"}
+
+// secretsTmpfsDir is where the secrets are stored while the command runs.
+// Each secret has its own tmpfs, so the value is never written to the rootfs.
+const secretsTmpfsDir = "/run/e2b-build-secrets"
+
+// scrubMemoryMarginMB is the free memory left for the kernel when the free memory is overwritten with zeros.
+const scrubMemoryMarginMB = 32
+
+// mountSecret stores the secret on a tmpfs and bind mounts it to the target file,
+// the secret for the environment variable is read from the tmpfs by the command itself.
+// It returns the shell prefix of the command that exports the environment variable.
+// The secret value is never part of the step arguments or the process configuration,
+// so it's not logged, included in the layer hash or kept by envd.
+func (r *Run) mountSecret(
+	ctx context.Context,
+	proxy *proxy.SandboxProxy,
+	sandboxID string,
+	mount *templatemanager.TemplateStepMount,
+	cmdMetadata metadata.Context,
+) (string, unmount, error) {
+	value, ok := r.Secrets[mount.GetId()]
+	if !ok {
+		if mount.GetRequired() {
+			return "", nil, fmt.Errorf("secret %s is required but it's not provided", mount.GetId())
+		}
+
+		return "", nil, nil
+	}
+
+	dir := path.Join(secretsTmpfsDir, uuid.NewString())
+	secretPath := path.Join(dir, "secret")
+
+	err := sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf(`mkdir -p "%[1]s" && mount -t tmpfs -o size=1m,mode=0755,nosuid,nodev,noexec tmpfs "%[1]s"`, dir),
+		rootContext,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create tmpfs for secret %s: %w", mount.GetId(), err)
+	}
+
+	removeTmpfs := func(ctx context.Context) error {
+		err := sandboxtools.RunCommand(ctx, proxy, sandboxID, fmt.Sprintf(`umount "%[1]s" && rmdir "%[1]s"`, dir), rootContext)
+		if err != nil {
+			return fmt.Errorf("failed to remove secret %s: %w", mount.GetId(), err)
+		}
+
+		return nil
+	}
+
+	tmpFile, err := os.CreateTemp("", "secret-*")
+	if err != nil {
+		return "", nil, errors.Join(fmt.Errorf("failed to create temporary file for secret: %w", err), removeTmpfs(ctx))
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	_, err = tmpFile.WriteString(value)
+	if err != nil {
+		return "", nil, errors.Join(fmt.Errorf("failed to write secret %s: %w", mount.GetId(), err), removeTmpfs(ctx))
+	}
+
+	err = sandboxtools.CopyFile(ctx, proxy, sandboxID, rootContext.User, tmpFile.Name(), secretPath)
+	if err != nil {
+		return "", nil, errors.Join(fmt.Errorf("failed to copy secret %s to sandbox: %w", mount.GetId(), err), removeTmpfs(ctx))
+	}
+
+	// The secret is readable only by the user running the command
+	err = sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf(`chown "%[1]s" "%[2]s" && chmod 0400 "%[2]s"`, cmdMetadata.User, secretPath),
+		rootContext,
+	)
+	if err != nil {
+		return "", nil, errors.Join(fmt.Errorf("failed to set secret %s permissions: %w", mount.GetId(), err), removeTmpfs(ctx))
+	}
+
+	var envPrefix string
+	if mount.Env != nil {
+		envPrefix = fmt.Sprintf(`%[1]s="$(cat "%[2]s")"; export %[1]s; `, mount.GetEnv(), secretPath)
+
+		// The secret is mounted as a file only if the target is explicitly set
+		if mount.GetTarget() == "" {
+			return envPrefix, removeTmpfs, nil
+		}
+	}
+
+	target := mount.GetTarget()
+	if target == "" {
+		target = path.Join(secretsDir, mount.GetId())
+	}
+	target = resolvePath(target, cmdMetadata.WorkDir)
+
+	// The empty file and the directories created for the bind mount are removed, the existing file is only hidden while the command runs.
+	// The markers are kept on the secret's tmpfs, so they are discarded with it.
+	existingMarker := path.Join(dir, "existing")
+	createdDirs := path.Join(dir, "created-dirs")
+
+	err = sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf(`if [ -e "%[1]s" ]; then
+  touch "%[3]s"
+else
+  parent="$(dirname "%[1]s")"
+  while [ ! -e "$parent" ]; do echo "$parent" >> "%[4]s"; parent="$(dirname "$parent")"; done
+  mkdir -p "$(dirname "%[1]s")" && touch "%[1]s"
+fi
+mount --bind -o ro "%[2]s" "%[1]s"`, target, secretPath, existingMarker, createdDirs),
+		rootContext,
+	)
+	if err != nil {
+		return "", nil, errors.Join(fmt.Errorf("failed to mount secret %s to %s: %w", mount.GetId(), target, err), removeTmpfs(ctx))
+	}
+
+	return envPrefix, func(ctx context.Context) error {
+		// The created directories are listed from the deepest one, those the command wrote other files to are kept
+		err := sandboxtools.RunCommand(
+			ctx,
+			proxy,
+			sandboxID,
+			fmt.Sprintf(`umount "%[1]s" || exit 1
+if [ ! -e "%[2]s" ]; then rm -f "%[1]s"; fi
+if [ -f "%[3]s" ]; then
+  while read -r created; do rmdir "$created" 2>/dev/null || break; done < "%[3]s"
+fi`, target, existingMarker, createdDirs),
+			rootContext,
+		)
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to unmount secret %s: %w", mount.GetId(), err), removeTmpfs(ctx))
+		}
+
+		return removeTmpfs(ctx)
+	}, nil
+}
+
+// scrubFreeMemory drops the page cache and overwrites the free memory with zeros,
+// so the secrets used by the command don't end up in the memory snapshot of the layer.
+func scrubFreeMemory(ctx context.Context, proxy *proxy.SandboxProxy, sandboxID string) error {
+	err := sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf(`sync
+echo 3 > /proc/sys/vm/drop_caches
+scrub_mb=$(( $(awk '/^MemFree:/ {print $2}' /proc/meminfo) / 1024 - %[1]d ))
+if [ "$scrub_mb" -gt 0 ]; then
+  dir=$(mktemp -d)
+  mount -t tmpfs -o size=${scrub_mb}m tmpfs "$dir"
+  dd if=/dev/zero of="$dir/scrub" bs=1M count="$scrub_mb" 2>/dev/null || true
+  umount "$dir"
+  rmdir "$dir"
+fi`, scrubMemoryMarginMB),
+		rootContext,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to scrub free memory: %w", err)
+	}
+
+	return nil
+}
+
+// mountCache restores the cache directory saved by the previous builds of the team to the target.
+// The content of the target is moved aside while the command runs and put back after the cache is saved,
+// so only the changes made outside the cache directory are saved in the layer.
+// Concurrent builds using the same cache overwrite each other's changes, the last finished one wins.
+func (r *Run) mountCache(
+	ctx context.Context,
+	logger *zap.Logger,
+	proxy *proxy.SandboxProxy,
+	sandboxID string,
+	mount *templatemanager.TemplateStepMount,
+	cmdMetadata metadata.Context,
+) (unmount, error) {
+	target := resolvePath(mount.GetTarget(), cmdMetadata.WorkDir)
+	if target == "/" {
+		return nil, fmt.Errorf("cache mount target must not be the root directory")
+	}
+
+	cacheID := mount.GetId()
+	if cacheID == "" {
+		cacheID = target
+	}
+
+	obj, err := r.FilesStorage.OpenObject(ctx, paths.GetCacheMountPath(r.CacheScope, cacheID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cache object from storage: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp("", "cache-mount-*.tar.gz")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file for cache: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	restored := true
+	_, err = obj.WriteTo(ctx, tmpFile)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		restored = false
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to download cache %s: %w", cacheID, err)
+	}
+
+	id := uuid.NewString()
+	backupPath := fmt.Sprintf("%s.%s", target, id)
+	archivePath := filepath.Join("/tmp", fmt.Sprintf("cache-%s.tar.gz", id))
+
+	if restored {
+		err = sandboxtools.CopyFile(ctx, proxy, sandboxID, rootContext.User, tmpFile.Name(), archivePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy cache %s to sandbox: %w", cacheID, err)
+		}
+	}
+
+	err = sandboxtools.RunCommand(
+		ctx,
+		proxy,
+		sandboxID,
+		fmt.Sprintf(`if [ -e "%[1]s" ] || [ -L "%[1]s" ]; then mv "%[1]s" "%[2]s"; fi
+mkdir -p "%[1]s"
+if [ -f "%[3]s" ]; then tar -xzf "%[3]s" -C "%[1]s" && rm -f "%[3]s"; fi
+chown "%[4]s" "%[1]s"`, target, backupPath, archivePath, cmdMetadata.User),
+		rootContext,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mount cache %s: %w", target, err)
+	}
+
+	if restored {
+		logger.Debug(fmt.Sprintf("Restored cache mount %s", target))
+	}
+
+	return func(ctx context.Context) error {
+		cacheFile, err := os.CreateTemp("", "cache-mount-*.tar.gz")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary file for cache: %w", err)
+		}
+		defer os.Remove(cacheFile.Name())
+		defer cacheFile.Close()
+
+		saveErr := sandboxtools.RunCommand(
+			ctx,
+			proxy,
+			sandboxID,
+			fmt.Sprintf(`tar -czf "%s" -C "%s" .`, archivePath, target),
+			rootContext,
+		)
+		if saveErr == nil {
+			saveErr = sandboxtools.DownloadFile(ctx, proxy, sandboxID, rootContext.User, archivePath, cacheFile.Name())
+		}
+
+		err = sandboxtools.RunCommand(
+			ctx,
+			proxy,
+			sandboxID,
+			fmt.Sprintf(`rm -rf "%[1]s" "%[3]s"
+if [ -e "%[2]s" ] || [ -L "%[2]s" ]; then mv "%[2]s" "%[1]s"; fi`, target, backupPath, archivePath),
+			rootContext,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to unmount cache %s: %w", target, err)
+		}
+
+		if saveErr == nil {
+			saveErr = obj.WriteFromFileSystem(ctx, cacheFile.Name())
+		}
+
+		// The cache is only an optimization, the build continues without it
+		if saveErr != nil {
+			logger.Warn(fmt.Sprintf("Failed to save cache mount %s: %v", target, saveErr))
+		}
+
+		return nil
+	}, nil
+}
+
+// resolvePath makes the path absolute, relative paths are relative to the working directory.
+func resolvePath(p string, workDir *string) string {
+	if path.IsAbs(p) {
+		return path.Clean(p)
+	}
+
+	base := "/"
+	if workDir != nil {
+		base = *workDir
+	}
+
+	return path.Join(base, p)
+}
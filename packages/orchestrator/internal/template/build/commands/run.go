@@ -2,7 +2,9 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -11,9 +13,14 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
-type Run struct{}
+type Run struct {
+	FilesStorage storage.StorageProvider
+	CacheScope   string
+	Secrets      map[string]string
+}
 
 var _ Command = (*Run)(nil)
 
@@ -39,6 +46,44 @@ func (r *Run) Execute(
 		cmdMetadata.User = args[1]
 	}
 
+	// Mounts are available only while the command runs, they are removed before the layer is saved
+	var unmounts []unmount
+	defer func() {
+		// Unmounted already after the successful run
+		for i := len(unmounts) - 1; i >= 0; i-- {
+			if err := unmounts[i](ctx); err != nil {
+				logger.Warn(fmt.Sprintf("Failed to unmount: %v", err))
+			}
+		}
+	}()
+
+	var envPrefix strings.Builder
+	usesSecrets := false
+
+	for _, mount := range step.GetMounts() {
+		var unmountFn unmount
+		var err error
+
+		switch mount.GetType() {
+		case mountTypeSecret:
+			var prefix string
+			prefix, unmountFn, err = r.mountSecret(ctx, proxy, sandboxID, mount, cmdMetadata)
+			envPrefix.WriteString(prefix)
+			usesSecrets = usesSecrets || unmountFn != nil
+		case mountTypeCache:
+			unmountFn, err = r.mountCache(ctx, logger, proxy, sandboxID, mount, cmdMetadata)
+		default:
+			err = fmt.Errorf("mount type %s is not supported", mount.GetType())
+		}
+		if err != nil {
+			return metadata.Context{}, err
+		}
+
+		if unmountFn != nil {
+			unmounts = append(unmounts, unmountFn)
+		}
+	}
+
 	cmd := args[0]
 	err := sandboxtools.RunCommandWithLogger(
 		ctx,
@@ -47,12 +92,30 @@ func (r *Run) Execute(
 		zapcore.InfoLevel,
 		prefix,
 		sandboxID,
-		cmd,
+		envPrefix.String()+cmd,
 		cmdMetadata,
 	)
 	if err != nil {
 		return metadata.Context{}, fmt.Errorf("failed to run command '%s': %w", cmd, err)
 	}
 
+	var unmountErrs []error
+	for i := len(unmounts) - 1; i >= 0; i-- {
+		unmountErrs = append(unmountErrs, unmounts[i](ctx))
+	}
+	unmounts = nil
+
+	if err := errors.Join(unmountErrs...); err != nil {
+		return metadata.Context{}, err
+	}
+
+	// The secrets were in the memory of the command, the layer is saved with a memory snapshot
+	if usesSecrets {
+		err = scrubFreeMemory(ctx, proxy, sandboxID)
+		if err != nil {
+			return metadata.Context{}, err
+		}
+	}
+
 	return originalMetadata, nil
 }
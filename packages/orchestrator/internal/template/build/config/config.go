@@ -27,6 +27,12 @@ type TemplateConfig struct {
 	// The amount of RAM memory to allocate to the VM, in MiB.
 	MemoryMB int64
 
+	// The number of vCPUs of the build sandboxes, VCpuCount is used when zero.
+	BuildVCpuCount int64
+
+	// The amount of RAM memory of the build sandboxes, in MiB. MemoryMB is used when zero.
+	BuildMemoryMB int64
+
 	// The amount of free disk to allocate to the VM, in MiB.
 	DiskSizeMB int64
 
@@ -50,6 +56,15 @@ type TemplateConfig struct {
 
 	// Steps to build the template.
 	Steps []*templatemanager.TemplateStep
+
+	// Stages are built before the template, the steps can copy files from them.
+	Stages []*templatemanager.TemplateStage
+
+	// Secrets mounted to the RUN steps, they are never persisted.
+	Secrets map[string]string
+
+	// CPUArchitecture of the template, the image is pulled for this architecture.
+	CPUArchitecture string
 }
 
 func MemfilePageSize(hugePages bool) int64 {
@@ -63,3 +78,25 @@ func MemfilePageSize(hugePages bool) int64 {
 func (e TemplateConfig) RootfsBlockSize() int64 {
 	return header.RootfsBlockSize
 }
+
+// BuildResources returns the vCPUs and the RAM of the sandbox building the step.
+// The step overrides the build resources of the template, which override the runtime ones.
+// The nil step returns the build resources of the template.
+func (e TemplateConfig) BuildResources(step *templatemanager.TemplateStep) (vCpuCount int64, memoryMB int64) {
+	vCpuCount, memoryMB = e.VCpuCount, e.MemoryMB
+	if e.BuildVCpuCount > 0 {
+		vCpuCount = e.BuildVCpuCount
+	}
+	if e.BuildMemoryMB > 0 {
+		memoryMB = e.BuildMemoryMB
+	}
+
+	if step.GetVCpuCount() > 0 {
+		vCpuCount = int64(step.GetVCpuCount())
+	}
+	if step.GetMemoryMB() > 0 {
+		memoryMB = int64(step.GetMemoryMB())
+	}
+
+	return vCpuCount, memoryMB
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func TestBuildResources(t *testing.T) {
+	vCpuCount := int32(8)
+	memoryMB := int32(8192)
+
+	tests := []struct {
+		name           string
+		config         TemplateConfig
+		step           *templatemanager.TemplateStep
+		expectedVCpu   int64
+		expectedMemory int64
+	}{
+		{
+			name:           "runtime resources",
+			config:         TemplateConfig{VCpuCount: 2, MemoryMB: 512},
+			expectedVCpu:   2,
+			expectedMemory: 512,
+		},
+		{
+			name:           "build resources override runtime resources",
+			config:         TemplateConfig{VCpuCount: 2, MemoryMB: 512, BuildVCpuCount: 4, BuildMemoryMB: 4096},
+			step:           &templatemanager.TemplateStep{Type: "RUN"},
+			expectedVCpu:   4,
+			expectedMemory: 4096,
+		},
+		{
+			name:           "partial build resources",
+			config:         TemplateConfig{VCpuCount: 2, MemoryMB: 512, BuildMemoryMB: 4096},
+			expectedVCpu:   2,
+			expectedMemory: 4096,
+		},
+		{
+			name:           "step resources override build resources",
+			config:         TemplateConfig{VCpuCount: 2, MemoryMB: 512, BuildVCpuCount: 4, BuildMemoryMB: 4096},
+			step:           &templatemanager.TemplateStep{Type: "RUN", VCpuCount: &vCpuCount, MemoryMB: &memoryMB},
+			expectedVCpu:   8,
+			expectedMemory: 8192,
+		},
+		{
+			name:           "step cpu only",
+			config:         TemplateConfig{VCpuCount: 2, MemoryMB: 512},
+			step:           &templatemanager.TemplateStep{Type: "RUN", VCpuCount: &vCpuCount},
+			expectedVCpu:   8,
+			expectedMemory: 512,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vCpu, memory := tt.config.BuildResources(tt.step)
+
+			assert.Equal(t, tt.expectedVCpu, vCpu)
+			assert.Equal(t, tt.expectedMemory, memory)
+		})
+	}
+}
@@ -60,10 +60,19 @@ func Make(ctx context.Context, rootfsPath string, sizeMb int64, blockSize int64)
 }
 
 func Mount(ctx context.Context, rootfsPath string, mountPoint string) error {
+	return mount(ctx, "loop", rootfsPath, mountPoint)
+}
+
+// MountReadOnly mounts the filesystem for inspection, the journal isn't replayed and nothing from it can be executed.
+func MountReadOnly(ctx context.Context, rootfsPath string, mountPoint string) error {
+	return mount(ctx, "loop,ro,noload,nosuid,nodev,noexec", rootfsPath, mountPoint)
+}
+
+func mount(ctx context.Context, options string, rootfsPath string, mountPoint string) error {
 	ctx, mountSpan := tracer.Start(ctx, "mount-ext4")
 	defer mountSpan.End()
 
-	cmd := exec.CommandContext(ctx, "mount", "-o", "loop", rootfsPath, mountPoint)
+	cmd := exec.CommandContext(ctx, "mount", "-o", options, rootfsPath, mountPoint)
 
 	mountStdoutWriter := telemetry.NewEventWriter(ctx, "stdout")
 	cmd.Stdout = mountStdoutWriter
@@ -21,6 +21,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/filesystem"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/oci/auth"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -32,12 +33,17 @@ const (
 	tarballExportUpdates = 10
 )
 
-var DefaultPlatform = containerregistry.Platform{
-	OS:           "linux",
-	Architecture: "amd64",
+var DefaultPlatform = Platform(consts.DefaultCPUArchitecture)
+
+// Platform returns the image platform of the template CPU architecture.
+func Platform(cpuArchitecture string) containerregistry.Platform {
+	return containerregistry.Platform{
+		OS:           "linux",
+		Architecture: consts.CPUArchitectureOrDefault(cpuArchitecture),
+	}
 }
 
-func GetPublicImage(ctx context.Context, tag string, authProvider auth.RegistryAuthProvider) (containerregistry.Image, error) {
+func GetPublicImage(ctx context.Context, tag string, authProvider auth.RegistryAuthProvider, platform containerregistry.Platform) (containerregistry.Image, error) {
 	childCtx, childSpan := tracer.Start(ctx, "pull-public-docker-image")
 	defer childSpan.End()
 
@@ -47,7 +53,7 @@ func GetPublicImage(ctx context.Context, tag string, authProvider auth.RegistryA
 	}
 
 	// Build authentication options
-	opts := []remote.Option{remote.WithPlatform(DefaultPlatform)}
+	opts := []remote.Option{remote.WithPlatform(platform)}
 
 	// Use the auth provider if provided
 	if authProvider != nil {
@@ -69,11 +75,11 @@ func GetPublicImage(ctx context.Context, tag string, authProvider auth.RegistryA
 	return img, nil
 }
 
-func GetImage(ctx context.Context, artifactRegistry artifactsregistry.ArtifactsRegistry, templateId string, buildId string) (containerregistry.Image, error) {
+func GetImage(ctx context.Context, artifactRegistry artifactsregistry.ArtifactsRegistry, templateId string, buildId string, platform containerregistry.Platform) (containerregistry.Image, error) {
 	childCtx, childSpan := tracer.Start(ctx, "pull-docker-image")
 	defer childSpan.End()
 
-	img, err := artifactRegistry.GetImage(childCtx, templateId, buildId, DefaultPlatform)
+	img, err := artifactRegistry.GetImage(childCtx, templateId, buildId, platform)
 	if err != nil {
 		return nil, fmt.Errorf("error pulling image: %w", err)
 	}
@@ -185,7 +185,7 @@ func TestGetPublicImageWithGeneralAuth(t *testing.T) {
 		require.NotNil(t, authOption)
 
 		// Now test GetPublicImage
-		img, err := GetPublicImage(ctx, imageRef, authProvider)
+		img, err := GetPublicImage(ctx, imageRef, authProvider, DefaultPlatform)
 		require.NoError(t, err)
 		require.NotNil(t, img)
 
@@ -235,7 +235,7 @@ func TestGetPublicImageWithGeneralAuth(t *testing.T) {
 		require.NotNil(t, authOption)
 
 		// Now test GetPublicImage
-		img, err := GetPublicImage(ctx, imageRef, authProvider)
+		img, err := GetPublicImage(ctx, imageRef, authProvider, DefaultPlatform)
 		require.Error(t, err)
 		require.Nil(t, img)
 	})
@@ -260,7 +260,7 @@ func TestGetPublicImageWithGeneralAuth(t *testing.T) {
 		require.NoError(t, err)
 
 		// Get image without auth provider (nil)
-		img, err := GetPublicImage(ctx, imageRef, nil)
+		img, err := GetPublicImage(ctx, imageRef, nil, DefaultPlatform)
 		require.NoError(t, err)
 		require.NotNil(t, img)
 
@@ -67,13 +67,20 @@ func New(
 	}
 }
 
+// BaseImage is the image the rootfs is created from.
+type BaseImage struct {
+	Config containerregistry.Config
+	// Digest of the pulled image, before the system layers are added
+	Digest string
+}
+
 func (r *Rootfs) CreateExt4Filesystem(
 	ctx context.Context,
 	logger *zap.Logger,
 	rootfsPath string,
 	provisionScript string,
 	provisionLogPrefix string,
-) (c containerregistry.Config, e error) {
+) (b BaseImage, e error) {
 	childCtx, childSpan := tracer.Start(ctx, "create-ext4-file")
 	defer childSpan.End()
 
@@ -87,36 +94,42 @@ func (r *Rootfs) CreateExt4Filesystem(
 
 	var img containerregistry.Image
 	var err error
+	platform := oci.Platform(r.template.CPUArchitecture)
 	if r.template.FromImage != "" {
-		img, err = oci.GetPublicImage(childCtx, r.template.FromImage, r.template.RegistryAuthProvider)
+		img, err = oci.GetPublicImage(childCtx, r.template.FromImage, r.template.RegistryAuthProvider, platform)
 	} else {
-		img, err = oci.GetImage(childCtx, r.artifactRegistry, r.template.TemplateID, r.metadata.BuildID)
+		img, err = oci.GetImage(childCtx, r.artifactRegistry, r.template.TemplateID, r.metadata.BuildID, platform)
+	}
+	if err != nil {
+		return BaseImage{}, fmt.Errorf("error requesting docker image: %w", err)
 	}
+
+	digest, err := img.Digest()
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error requesting docker image: %w", err)
+		return BaseImage{}, fmt.Errorf("error getting image digest: %w", err)
 	}
 
 	imageSize, err := oci.GetImageSize(img)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting image size: %w", err)
+		return BaseImage{}, fmt.Errorf("error getting image size: %w", err)
 	}
 	logger.Info(fmt.Sprintf("Base Docker image size: %s", humanize.Bytes(uint64(imageSize))))
 
 	logger.Debug("Setting up system files")
 	layers, err := additionalOCILayers(childCtx, r.template, provisionScript, provisionLogPrefix)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error populating filesystem: %w", err)
+		return BaseImage{}, fmt.Errorf("error populating filesystem: %w", err)
 	}
 	img, err = mutate.AppendLayers(img, layers...)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error appending layers: %w", err)
+		return BaseImage{}, fmt.Errorf("error appending layers: %w", err)
 	}
 	telemetry.ReportEvent(childCtx, "set up filesystem")
 
 	logger.Info("Creating file system and pulling Docker image")
 	ext4Size, err := oci.ToExt4(ctx, logger, img, rootfsPath, maxRootfsSize, r.template.RootfsBlockSize())
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error creating ext4 filesystem: %w", err)
+		return BaseImage{}, fmt.Errorf("error creating ext4 filesystem: %w", err)
 	}
 	telemetry.ReportEvent(childCtx, "created rootfs ext4 file")
 
@@ -124,13 +137,13 @@ func (r *Rootfs) CreateExt4Filesystem(
 	// Make rootfs writable, be default it's readonly
 	err = filesystem.MakeWritable(ctx, rootfsPath)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error making rootfs file writable: %w", err)
+		return BaseImage{}, fmt.Errorf("error making rootfs file writable: %w", err)
 	}
 
 	// Resize rootfs
 	rootfsFreeSpace, err := filesystem.GetFreeSpace(ctx, rootfsPath, r.template.RootfsBlockSize())
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting free space: %w", err)
+		return BaseImage{}, fmt.Errorf("error getting free space: %w", err)
 	}
 	// We need to remove the remaining free space from the ext4 file size
 	// This is a residual space that could not be shrunk when creating the filesystem,
@@ -144,7 +157,7 @@ func (r *Rootfs) CreateExt4Filesystem(
 	if diskAdd > 0 {
 		_, err := filesystem.Enlarge(ctx, rootfsPath, diskAdd)
 		if err != nil {
-			return containerregistry.Config{}, fmt.Errorf("error enlarging rootfs: %w", err)
+			return BaseImage{}, fmt.Errorf("error enlarging rootfs: %w", err)
 		}
 	}
 
@@ -155,15 +168,18 @@ func (r *Rootfs) CreateExt4Filesystem(
 		zap.Error(err),
 	)
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error checking ext4 filesystem integrity: %w", err)
+		return BaseImage{}, fmt.Errorf("error checking ext4 filesystem integrity: %w", err)
 	}
 
 	config, err := img.ConfigFile()
 	if err != nil {
-		return containerregistry.Config{}, fmt.Errorf("error getting image config file: %w", err)
+		return BaseImage{}, fmt.Errorf("error getting image config file: %w", err)
 	}
 
-	return config.Config, nil
+	return BaseImage{
+		Config: config.Config,
+		Digest: digest.String(),
+	}, nil
 }
 
 func additionalOCILayers(
@@ -8,7 +8,6 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
-	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	sbxtemplate "github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
@@ -48,13 +47,9 @@ func (cs *CreateSandbox) Sandbox(
 ) (s *sandbox.Sandbox, err error) {
 	// Create new memfile with the size of the sandbox RAM, this updates the underlying memfile.
 	// This is ok as the sandbox is started from the beginning.
-	memfile, err := block.NewEmpty(
-		cs.config.RamMB<<constants.ToMBShift,
-		config.MemfilePageSize(cs.config.HugePages),
-		uuid.MustParse(sourceTemplate.Files().BuildID),
-	)
+	memfile, err := newSandboxMemfile(cs.config, uuid.MustParse(sourceTemplate.Files().BuildID))
 	if err != nil {
-		return nil, fmt.Errorf("create memfile: %w", err)
+		return nil, err
 	}
 
 	template := sbxtemplate.NewMaskTemplate(sourceTemplate, sbxtemplate.WithMemfile(memfile))
@@ -37,6 +37,8 @@ type LayerBuildCommand struct {
 	SourceTemplate SourceTemplateProvider
 	CurrentLayer   metadata.Template
 	Hash           string
+	// Step is the description of the build step saved with the layer in the cache index.
+	Step           string
 	UpdateEnvd     bool
 	SandboxCreator SandboxCreator
 	ActionExecutor ActionExecutor
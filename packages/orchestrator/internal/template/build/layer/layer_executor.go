@@ -3,6 +3,7 @@ package layer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -120,6 +121,7 @@ func (lb *LayerExecutor) BuildLayer(
 		userLogger,
 		sbx,
 		cmd.Hash,
+		cmd.Step,
 		meta,
 	)
 	if err != nil {
@@ -129,6 +131,37 @@ func (lb *LayerExecutor) BuildLayer(
 	return meta, nil
 }
 
+// RunInSandbox runs the function in a sandbox created from the source template without saving any layer.
+func (lb *LayerExecutor) RunInSandbox(
+	ctx context.Context,
+	sourceTemplate SourceTemplateProvider,
+	sandboxCreator SandboxCreator,
+	fn func(ctx context.Context, sbx *sandbox.Sandbox) error,
+) error {
+	ctx, childSpan := tracer.Start(ctx, "run-in-discarded-sandbox")
+	defer childSpan.End()
+
+	localTemplate, err := sourceTemplate.Get(ctx, lb.templateCache)
+	if err != nil {
+		return fmt.Errorf("get template snapshot: %w", err)
+	}
+
+	sbx, err := sandboxCreator.Sandbox(ctx, lb, localTemplate)
+	if err != nil {
+		return err
+	}
+	defer sbx.Close(ctx)
+
+	// Add to proxy so we can call envd commands
+	lb.sandboxes.Insert(sbx.Runtime.SandboxID, sbx)
+	defer func() {
+		lb.sandboxes.Remove(sbx.Runtime.SandboxID)
+		lb.proxy.RemoveFromPool(sbx.Runtime.ExecutionID)
+	}()
+
+	return fn(ctx, sbx)
+}
+
 // updateEnvdInSandbox updates the envd binary in the sandbox to the latest version.
 func (lb *LayerExecutor) updateEnvdInSandbox(
 	ctx context.Context,
@@ -206,6 +239,7 @@ func (lb *LayerExecutor) PauseAndUpload(
 	userLogger *zap.Logger,
 	sbx *sandbox.Sandbox,
 	hash string,
+	step string,
 	meta metadata.Template,
 ) error {
 	ctx, childSpan := tracer.Start(ctx, "pause-and-upload")
@@ -254,6 +288,9 @@ func (lb *LayerExecutor) PauseAndUpload(
 			Template: cache.Template{
 				BuildID: meta.Template.BuildID,
 			},
+			TemplateID: lb.Config.TemplateID,
+			Step:       step,
+			CreatedAt:  time.Now(),
 		})
 		if err != nil {
 			return fmt.Errorf("error saving UUID to hash mapping: %w", err)
@@ -0,0 +1,34 @@
+package layer
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
+)
+
+// newSandboxMemfile creates the empty memfile with the size of the sandbox RAM.
+// The created sandbox boots from the beginning, so the memfile of the source layer isn't used
+// and the build and runtime RAM can differ between the layers.
+func newSandboxMemfile(sbxConfig sandbox.Config, buildID uuid.UUID) (*block.Empty, error) {
+	memfile, err := block.NewEmpty(
+		sbxConfig.RamMB<<constants.ToMBShift,
+		config.MemfilePageSize(sbxConfig.HugePages),
+		buildID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create memfile: %w", err)
+	}
+
+	return memfile, nil
+}
+
+// snapshotRamMB returns the RAM of the sandbox the memfile was snapshotted from.
+// The resumed sandbox always has the RAM of the snapshot, it can't be changed by the sandbox config.
+func snapshotRamMB(memfile block.ReadonlyDevice) int64 {
+	return int64(memfile.Header().Metadata.Size >> constants.ToMBShift)
+}
@@ -0,0 +1,37 @@
+package layer
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/constants"
+)
+
+func TestNewSandboxMemfile_RamDiffersFromSource(t *testing.T) {
+	buildID := uuid.New()
+
+	// The source layer was built with more RAM than the runtime one
+	buildConfig := config.TemplateConfig{VCpuCount: 2, MemoryMB: 512, BuildMemoryMB: 4096}
+	_, buildMemoryMB := buildConfig.BuildResources(nil)
+
+	source, err := newSandboxMemfile(sandbox.Config{RamMB: buildMemoryMB}, buildID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4096), snapshotRamMB(source))
+
+	for _, ramMB := range []int64{buildConfig.MemoryMB, 8192} {
+		memfile, err := newSandboxMemfile(sandbox.Config{RamMB: ramMB}, buildID)
+		require.NoError(t, err)
+
+		size, err := memfile.Size()
+		require.NoError(t, err)
+
+		assert.Equal(t, ramMB<<constants.ToMBShift, size)
+		assert.Equal(t, uint64(ramMB<<constants.ToMBShift), memfile.Header().Metadata.Size)
+		assert.Equal(t, ramMB, snapshotRamMB(memfile))
+	}
+}
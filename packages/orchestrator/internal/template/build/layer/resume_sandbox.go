@@ -30,11 +30,20 @@ func (rs *ResumeSandbox) Sandbox(
 	layerExecutor *LayerExecutor,
 	template sbxtemplate.Template,
 ) (*sandbox.Sandbox, error) {
+	memfile, err := template.Memfile()
+	if err != nil {
+		return nil, fmt.Errorf("get memfile: %w", err)
+	}
+
+	// The sandbox is resumed with the RAM of the snapshot, the memory diff must match the memfile header
+	sbxConfig := rs.config
+	sbxConfig.RamMB = snapshotRamMB(memfile)
+
 	sbx, err := sandbox.ResumeSandbox(
 		ctx,
 		layerExecutor.networkPool,
 		template,
-		rs.config,
+		sbxConfig,
 		sandbox.RuntimeMetadata{
 			TemplateID:  layerExecutor.Config.TemplateID,
 			SandboxID:   config.InstanceBuildPrefix + id.Generate(),
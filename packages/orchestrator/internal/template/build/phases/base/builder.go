@@ -96,7 +96,7 @@ func New(
 }
 
 func (bb *BaseBuilder) Prefix() string {
-	return "base"
+	return bb.BuildContext.Prefix("base")
 }
 
 func (bb *BaseBuilder) String(ctx context.Context) (string, error) {
@@ -174,7 +174,7 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 	// Created here to be able to pass it to CreateSandbox for populating COW cache
 	rootfsPath := filepath.Join(templateBuildDir, rootfsBuildFileName)
 
-	rootfs, memfile, envsImg, err := constructLayerFilesFromOCI(
+	rootfs, memfile, baseImage, err := constructLayerFilesFromOCI(
 		ctx,
 		userLogger,
 		bb.BuildContext,
@@ -188,7 +188,8 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 	}
 
 	// Env variables from the Docker image
-	baseMetadata.Context.EnvVars = oci.ParseEnvs(envsImg.Env)
+	baseMetadata.Context.EnvVars = oci.ParseEnvs(baseImage.Config.Env)
+	baseMetadata.FromImageDigest = &baseImage.Digest
 
 	cacheFiles, err := baseMetadata.Template.CacheFiles()
 	if err != nil {
@@ -210,9 +211,10 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 	// Allow sandbox internet access during provisioning
 	allowInternetAccess := true
 
+	vCpuCount, memoryMB := bb.Config.BuildResources(nil)
 	baseSbxConfig := sandbox.Config{
-		Vcpu:      bb.Config.VCpuCount,
-		RamMB:     bb.Config.MemoryMB,
+		Vcpu:      vCpuCount,
+		RamMB:     memoryMB,
 		HugePages: bb.Config.HugePages,
 
 		AllowInternetAccess: &allowInternetAccess,
@@ -291,10 +293,16 @@ func (bb *BaseBuilder) buildLayerFromOCI(
 
 	templateProvider := layer.NewDirectSourceTemplateProvider(localTemplate)
 
+	description, err := bb.String(ctx)
+	if err != nil {
+		return metadata.Template{}, fmt.Errorf("error getting step description: %w", err)
+	}
+
 	baseLayer, err := bb.layerExecutor.BuildLayer(ctx, userLogger, layer.LayerBuildCommand{
 		SourceTemplate: templateProvider,
 		CurrentLayer:   baseMetadata,
 		Hash:           hash,
+		Step:           description,
 		UpdateEnvd:     false,
 		SandboxCreator: sandboxCreator,
 		ActionExecutor: actionExecutor,
@@ -359,6 +367,7 @@ func (bb *BaseBuilder) Layer(
 			FromImage:    &bb.Config.FromImage,
 			FromTemplate: nil,
 			Start:        nil,
+			VolumeDrives: fc.VolumeDrives,
 		}
 
 		notCachedResult := phases.LayerResult{
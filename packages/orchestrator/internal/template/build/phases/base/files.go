@@ -5,7 +5,6 @@ import (
 	_ "embed"
 	"fmt"
 
-	containerregistry "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
@@ -26,7 +25,7 @@ func constructLayerFilesFromOCI(
 	artifactRegistry artifactsregistry.ArtifactsRegistry,
 	templateBuildDir string,
 	rootfsPath string,
-) (r *block.Local, m block.ReadonlyDevice, c containerregistry.Config, e error) {
+) (r *block.Local, m block.ReadonlyDevice, b rootfs.BaseImage, e error) {
 	childCtx, childSpan := tracer.Start(ctx, "template-build")
 	defer childSpan.End()
 
@@ -41,32 +40,33 @@ func constructLayerFilesFromOCI(
 		ResultPath: provisionScriptResultPath,
 	})
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error getting provision script: %w", err)
+		return nil, nil, rootfs.BaseImage{}, fmt.Errorf("error getting provision script: %w", err)
 	}
-	imgConfig, err := rtfs.CreateExt4Filesystem(childCtx, userLogger, rootfsPath, provisionScript, provisionLogPrefix)
+	baseImage, err := rtfs.CreateExt4Filesystem(childCtx, userLogger, rootfsPath, provisionScript, provisionLogPrefix)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error creating ext4 filesystem: %w", err)
+		return nil, nil, rootfs.BaseImage{}, fmt.Errorf("error creating ext4 filesystem: %w", err)
 	}
 
 	buildIDParsed, err := uuid.Parse(baseBuildID)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("failed to parse build id: %w", err)
+		return nil, nil, rootfs.BaseImage{}, fmt.Errorf("failed to parse build id: %w", err)
 	}
 
-	rootfs, err := block.NewLocal(rootfsPath, buildContext.Config.RootfsBlockSize(), buildIDParsed)
+	rootfsDevice, err := block.NewLocal(rootfsPath, buildContext.Config.RootfsBlockSize(), buildIDParsed)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error reading rootfs blocks: %w", err)
+		return nil, nil, rootfs.BaseImage{}, fmt.Errorf("error reading rootfs blocks: %w", err)
 	}
 
-	// Create empty memfile
+	// Create empty memfile with the size of the build sandbox RAM
+	_, memoryMB := buildContext.Config.BuildResources(nil)
 	memfile, err := block.NewEmpty(
-		buildContext.Config.MemoryMB<<constants.ToMBShift,
+		memoryMB<<constants.ToMBShift,
 		config.MemfilePageSize(buildContext.Config.HugePages),
 		buildIDParsed,
 	)
 	if err != nil {
-		return nil, nil, containerregistry.Config{}, fmt.Errorf("error creating memfile: %w", err)
+		return nil, nil, rootfs.BaseImage{}, fmt.Errorf("error creating memfile: %w", err)
 	}
 
-	return rootfs, memfile, imgConfig, nil
+	return rootfsDevice, memfile, baseImage, nil
 }
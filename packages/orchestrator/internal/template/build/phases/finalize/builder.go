@@ -127,7 +127,8 @@ func (ppb *PostProcessingBuilder) Build(
 		},
 	}
 
-	// Always restart the sandbox for the final layer to properly wire the rootfs path for the final template
+	// Always restart the sandbox for the final layer to properly wire the rootfs path for the final template.
+	// The restarted sandbox gets a new memfile with the runtime RAM, the steps could be built with other RAM.
 	sandboxCreator := layer.NewCreateSandbox(
 		sbxConfig,
 		finalizeTimeout,
@@ -141,10 +142,16 @@ func (ppb *PostProcessingBuilder) Build(
 
 	templateProvider := layer.NewCacheSourceTemplateProvider(sourceLayer.Metadata.Template)
 
+	description, err := ppb.String(ctx)
+	if err != nil {
+		return phases.LayerResult{}, fmt.Errorf("error getting step description: %w", err)
+	}
+
 	finalLayer, err := ppb.layerExecutor.BuildLayer(ctx, userLogger, layer.LayerBuildCommand{
 		SourceTemplate: templateProvider,
 		CurrentLayer:   currentLayer.Metadata,
 		Hash:           currentLayer.Hash,
+		Step:           description,
 		UpdateEnvd:     sourceLayer.Cached,
 		SandboxCreator: sandboxCreator,
 		ActionExecutor: actionExecutor,
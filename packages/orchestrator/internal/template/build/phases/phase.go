@@ -14,8 +14,10 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases")
@@ -92,6 +94,7 @@ func Run(
 			return LayerResult{}, fmt.Errorf("getting layer: %w", err)
 		}
 		metrics.RecordCacheResult(ctx, meta.Phase, meta.StepType, currentLayer.Cached)
+		bc.CacheResults.Add(cacheResult(bc, meta, currentLayer))
 
 		prefix := builder.Prefix()
 		source, err := builder.String(ctx)
@@ -128,6 +131,23 @@ func Run(
 	return sourceLayer, nil
 }
 
+func cacheResult(bc buildcontext.BuildContext, meta PhaseMeta, layer LayerResult) *templatemanager.TemplateBuildStepCacheResult {
+	result := &templatemanager.TemplateBuildStepCacheResult{
+		Phase:    string(meta.Phase),
+		StepType: meta.StepType,
+		Hash:     layer.Hash,
+		Cached:   layer.Cached,
+	}
+	if meta.StepNumber != nil {
+		result.StepNumber = utils.ToPtr(int32(*meta.StepNumber))
+	}
+	if bc.Stage != "" {
+		result.Stage = utils.ToPtr(bc.Stage)
+	}
+
+	return result
+}
+
 func validateLayer(
 	layer LayerResult,
 ) (err error) {
@@ -2,6 +2,7 @@ package steps
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -22,13 +23,18 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
-const layerTimeout = time.Hour
+const (
+	layerTimeout = time.Hour
+	// layerTimeoutMargin is added to the step timeout, so the sandbox outlives the step and its layer can be saved
+	layerTimeoutMargin = 10 * time.Minute
+)
 
 var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases/steps")
 
@@ -43,6 +49,7 @@ type StepBuilder struct {
 
 	layerExecutor   *layer.LayerExecutor
 	commandExecutor *commands.CommandExecutor
+	stages          *stages.Stages
 	index           cache.Index
 	metrics         *metrics.BuildMetrics
 }
@@ -53,6 +60,7 @@ func New(
 	proxy *proxy.SandboxProxy,
 	layerExecutor *layer.LayerExecutor,
 	commandExecutor *commands.CommandExecutor,
+	stages *stages.Stages,
 	index cache.Index,
 	metrics *metrics.BuildMetrics,
 	step *templatemanager.TemplateStep,
@@ -69,17 +77,45 @@ func New(
 
 		layerExecutor:   layerExecutor,
 		commandExecutor: commandExecutor,
+		stages:          stages,
 		index:           index,
 		metrics:         metrics,
 	}
 }
 
 func (sb *StepBuilder) Prefix() string {
-	return fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps))
+	return sb.BuildContext.Prefix(fmt.Sprintf("builder %d/%d", sb.stepNumber, len(sb.Config.Steps)))
 }
 
 func (sb *StepBuilder) String(ctx context.Context) (string, error) {
-	return fmt.Sprintf("%s %s", strings.ToUpper(sb.step.Type), strings.Join(sb.step.Args, " ")), nil
+	stepType := strings.ToUpper(sb.step.Type)
+	if sb.step.FromStage != nil {
+		stepType = fmt.Sprintf("%s --from=%s", stepType, *sb.step.FromStage)
+	}
+	for _, mount := range sb.step.GetMounts() {
+		stepType = fmt.Sprintf("%s --mount=%s", stepType, mountString(mount))
+	}
+
+	return fmt.Sprintf("%s %s", stepType, strings.Join(sb.step.Args, " ")), nil
+}
+
+// mountString formats the mount like the Dockerfile RUN --mount flag.
+func mountString(mount *templatemanager.TemplateStepMount) string {
+	options := []string{"type=" + mount.GetType()}
+	if mount.GetId() != "" {
+		options = append(options, "id="+mount.GetId())
+	}
+	if mount.GetTarget() != "" {
+		options = append(options, "target="+mount.GetTarget())
+	}
+	if mount.Env != nil {
+		options = append(options, "env="+mount.GetEnv())
+	}
+	if mount.GetRequired() {
+		options = append(options, "required=true")
+	}
+
+	return strings.Join(options, ",")
 }
 
 func (sb *StepBuilder) Metadata() phases.PhaseMeta {
@@ -152,9 +188,10 @@ func (sb *StepBuilder) Build(
 	prefix := sb.Prefix()
 	step := sb.step
 
+	vCpuCount, memoryMB := sb.Config.BuildResources(step)
 	sbxConfig := sandbox.Config{
-		Vcpu:      sb.Config.VCpuCount,
-		RamMB:     sb.Config.MemoryMB,
+		Vcpu:      vCpuCount,
+		RamMB:     memoryMB,
 		HugePages: sb.Config.HugePages,
 
 		AllowInternetAccess: &globalconfig.AllowSandboxInternet,
@@ -164,24 +201,36 @@ func (sb *StepBuilder) Build(
 		},
 	}
 
+	stepTimeout := time.Duration(step.GetTimeoutSeconds()) * time.Second
+	sandboxTimeout := max(layerTimeout, stepTimeout+layerTimeoutMargin)
+
 	// First not cached layer is create (to change CPU, Memory, etc), subsequent are layers are resumes.
+	// The resumed sandbox keeps the resources of the snapshot, so it's created again when the step resources change.
+	createSandbox := sourceLayer.Cached || sb.resourcesChanged()
 	var sandboxCreator layer.SandboxCreator
-	if sourceLayer.Cached {
+	if createSandbox {
 		sandboxCreator = layer.NewCreateSandbox(
 			sbxConfig,
-			layerTimeout,
+			sandboxTimeout,
 			fc.FirecrackerVersions{
 				KernelVersion:      sb.Template.KernelVersion,
 				FirecrackerVersion: sb.Template.FirecrackerVersion,
 			},
 		)
 	} else {
-		sandboxCreator = layer.NewResumeSandbox(sbxConfig, layerTimeout)
+		sandboxCreator = layer.NewResumeSandbox(sbxConfig, sandboxTimeout)
 	}
 
 	actionExecutor := layer.NewFunctionAction(func(ctx context.Context, sbx *sandbox.Sandbox, meta metadata.Template) (metadata.Template, error) {
+		cmdCtx := ctx
+		if stepTimeout > 0 {
+			var cancel context.CancelFunc
+			cmdCtx, cancel = context.WithTimeout(ctx, stepTimeout)
+			defer cancel()
+		}
+
 		cmdMeta, err := sb.commandExecutor.Execute(
-			ctx,
+			cmdCtx,
 			userLogger,
 			sbx,
 			prefix,
@@ -189,6 +238,10 @@ func (sb *StepBuilder) Build(
 			meta.Context,
 		)
 		if err != nil {
+			if ctx.Err() == nil && errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+				return metadata.Template{}, phases.NewPhaseBuildError(sb, fmt.Errorf("step exceeded the timeout of %s", stepTimeout))
+			}
+
 			return metadata.Template{}, phases.NewPhaseBuildError(sb, err)
 		}
 
@@ -207,11 +260,17 @@ func (sb *StepBuilder) Build(
 
 	templateProvider := layer.NewCacheSourceTemplateProvider(sourceLayer.Metadata.Template)
 
+	description, err := sb.String(ctx)
+	if err != nil {
+		return phases.LayerResult{}, fmt.Errorf("error getting step description: %w", err)
+	}
+
 	meta, err := sb.layerExecutor.BuildLayer(ctx, userLogger, layer.LayerBuildCommand{
 		SourceTemplate: templateProvider,
 		CurrentLayer:   currentLayer.Metadata,
 		Hash:           currentLayer.Hash,
-		UpdateEnvd:     sourceLayer.Cached,
+		Step:           description,
+		UpdateEnvd:     createSandbox,
 		SandboxCreator: sandboxCreator,
 		ActionExecutor: actionExecutor,
 	})
@@ -225,3 +284,16 @@ func (sb *StepBuilder) Build(
 		Hash:     currentLayer.Hash,
 	}, nil
 }
+
+// resourcesChanged reports whether the step runs with other resources than the previous step, or the base layer for the first step.
+func (sb *StepBuilder) resourcesChanged() bool {
+	var previous *templatemanager.TemplateStep
+	if sb.stepNumber > 1 && sb.stepNumber-2 < len(sb.Config.Steps) {
+		previous = sb.Config.Steps[sb.stepNumber-2]
+	}
+
+	vCpuCount, memoryMB := sb.Config.BuildResources(sb.step)
+	previousVCpuCount, previousMemoryMB := sb.Config.BuildResources(previous)
+
+	return vCpuCount != previousVCpuCount || memoryMB != previousMemoryMB
+}
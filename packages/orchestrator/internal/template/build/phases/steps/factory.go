@@ -9,6 +9,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
 )
 
@@ -18,6 +19,7 @@ func CreateStepPhases(
 	proxy *proxy.SandboxProxy,
 	layerExecutor *layer.LayerExecutor,
 	commandExecutor *commands.CommandExecutor,
+	stages *stages.Stages,
 	index cache.Index,
 	metrics *metrics.BuildMetrics,
 ) []phases.BuilderPhase {
@@ -31,6 +33,7 @@ func CreateStepPhases(
 				proxy,
 				layerExecutor,
 				commandExecutor,
+				stages,
 				index,
 				metrics,
 				step,
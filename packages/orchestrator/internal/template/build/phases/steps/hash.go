@@ -9,10 +9,26 @@ import (
 )
 
 func (sb *StepBuilder) Hash(sourceLayer phases.LayerResult) (string, error) {
-	return cache.HashKeys(
-		sourceLayer.Hash,
+	keys := []string{
 		sb.step.Type,
 		strings.Join(sb.step.Args, " "),
 		utils.Sprintp(sb.step.FilesHash),
-	), nil
+	}
+
+	// Files copied from a stage change with the stage layers
+	if sb.step.FromStage != nil {
+		stageHash, err := sb.stages.Hash(*sb.step.FromStage)
+		if err != nil {
+			return "", err
+		}
+
+		keys = append(keys, *sb.step.FromStage, stageHash)
+	}
+
+	// Only the mount definitions are hashed, the secret values and the cache content don't invalidate the layer
+	for _, mount := range sb.step.GetMounts() {
+		keys = append(keys, mountString(mount))
+	}
+
+	return cache.HashKeys(sourceLayer.Hash, keys...), nil
 }
@@ -0,0 +1,126 @@
+package build
+
+import (
+	"time"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/metrics"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/provenance"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+// buildProvenance describes the finished build by its configuration and the layer hashes collected in the cache results.
+func buildProvenance(bc buildcontext.BuildContext, buildStages *stages.Stages, result phases.LayerResult) provenance.Provenance {
+	results := bc.CacheResults.List()
+
+	p := provenance.Provenance{
+		Version:         provenance.Version,
+		TemplateID:      bc.Config.TemplateID,
+		BuildID:         bc.Template.BuildID,
+		CreatedAt:       time.Now(),
+		CPUArchitecture: bc.Config.CPUArchitecture,
+
+		EnvdVersion:        bc.EnvdVersion,
+		KernelVersion:      result.Metadata.Template.KernelVersion,
+		FirecrackerVersion: result.Metadata.Template.FirecrackerVersion,
+
+		Base:  provenanceBase(bc.Config.FromImage, bc.Config.FromTemplate, result.Metadata, results, ""),
+		Steps: provenanceSteps(bc.Config.Steps, results, ""),
+
+		FinalizeHash: result.Hash,
+	}
+
+	// The start command can be inherited from the base template
+	if start := result.Metadata.Start; start != nil {
+		p.StartCmd = start.StartCmd
+		p.ReadyCmd = start.ReadyCmd
+	}
+
+	for _, stage := range bc.Config.Stages {
+		stageResult, _ := buildStages.Result(stage.GetName())
+
+		p.Stages = append(p.Stages, provenance.Stage{
+			Name:  stage.GetName(),
+			Base:  provenanceBase(stage.GetFromImage(), nil, stageResult.Metadata, results, stage.GetName()),
+			Steps: provenanceSteps(stage.GetSteps(), results, stage.GetName()),
+		})
+	}
+
+	return p
+}
+
+func provenanceBase(
+	fromImage string,
+	fromTemplate *templatemanager.FromTemplateConfig,
+	meta metadata.Template,
+	results []*templatemanager.TemplateBuildStepCacheResult,
+	stage string,
+) provenance.Base {
+	base := provenance.Base{}
+	if fromTemplate != nil {
+		base.FromTemplate = fromTemplate.GetAlias()
+		base.FromBuildID = fromTemplate.GetBuildID()
+	} else {
+		base.FromImage = fromImage
+		base.FromImageDigest = utils.FromPtr(meta.FromImageDigest)
+	}
+
+	if result := findCacheResult(results, metrics.PhaseBase, stage, nil); result != nil {
+		base.Hash = result.GetHash()
+		base.Cached = result.GetCached()
+	}
+
+	return base
+}
+
+func provenanceSteps(
+	steps []*templatemanager.TemplateStep,
+	results []*templatemanager.TemplateBuildStepCacheResult,
+	stage string,
+) []provenance.Step {
+	p := make([]provenance.Step, 0, len(steps))
+	for i, step := range steps {
+		stepNumber := i + 1 // stepNumber starts from 1
+
+		s := provenance.Step{
+			Number:    stepNumber,
+			Type:      step.GetType(),
+			Args:      step.GetArgs(),
+			FilesHash: step.GetFilesHash(),
+			FromStage: step.GetFromStage(),
+		}
+		if result := findCacheResult(results, metrics.PhaseSteps, stage, &stepNumber); result != nil {
+			s.Hash = result.GetHash()
+			s.Cached = result.GetCached()
+		}
+
+		p = append(p, s)
+	}
+
+	return p
+}
+
+func findCacheResult(
+	results []*templatemanager.TemplateBuildStepCacheResult,
+	phase metrics.Phase,
+	stage string,
+	stepNumber *int,
+) *templatemanager.TemplateBuildStepCacheResult {
+	for _, result := range results {
+		if result.GetPhase() != string(phase) || result.GetStage() != stage {
+			continue
+		}
+
+		if stepNumber != nil && int(result.GetStepNumber()) != *stepNumber {
+			continue
+		}
+
+		return result
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	globalconfig "github.com/e2b-dev/infra/packages/orchestrator/internal/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/proxy"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/layer"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/phases"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/sandboxtools"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+)
+
+const exportTimeout = 30 * time.Minute
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/stages")
+
+// Stages keeps the last layers of the finished build stages, the later steps can copy files from them.
+type Stages struct {
+	buildcontext.BuildContext
+
+	proxy         *proxy.SandboxProxy
+	layerExecutor *layer.LayerExecutor
+
+	results map[string]phases.LayerResult
+}
+
+func New(
+	buildContext buildcontext.BuildContext,
+	proxy *proxy.SandboxProxy,
+	layerExecutor *layer.LayerExecutor,
+) *Stages {
+	return &Stages{
+		BuildContext: buildContext,
+
+		proxy:         proxy,
+		layerExecutor: layerExecutor,
+
+		results: make(map[string]phases.LayerResult),
+	}
+}
+
+// Add saves the last layer of the finished stage.
+func (s *Stages) Add(name string, result phases.LayerResult) {
+	s.results[name] = result
+}
+
+// Result returns the last layer of the stage.
+func (s *Stages) Result(name string) (phases.LayerResult, bool) {
+	result, ok := s.results[name]
+
+	return result, ok
+}
+
+// Hash returns the hash of the last layer of the stage, so the steps copying from it are rebuilt when it changes.
+func (s *Stages) Hash(name string) (string, error) {
+	result, ok := s.results[name]
+	if !ok {
+		return "", fmt.Errorf("stage %s is not built before it's used", name)
+	}
+
+	return result.Hash, nil
+}
+
+// ExportFiles packs the source path from the stage filesystem to a gzipped tar at the target path.
+// The paths in the tar are relative to the root of the stage.
+func (s *Stages) ExportFiles(ctx context.Context, name string, sourcePath string, targetPath string) error {
+	ctx, span := tracer.Start(ctx, "export-stage-files", trace.WithAttributes(
+		attribute.String("stage", name),
+		attribute.String("source.path", sourcePath),
+	))
+	defer span.End()
+
+	result, ok := s.results[name]
+	if !ok {
+		return fmt.Errorf("stage %s is not built before it's used", name)
+	}
+
+	vCpuCount, memoryMB := s.Config.BuildResources(nil)
+	sbxConfig := sandbox.Config{
+		Vcpu:      vCpuCount,
+		RamMB:     memoryMB,
+		HugePages: s.Config.HugePages,
+
+		AllowInternetAccess: &globalconfig.AllowSandboxInternet,
+
+		Envd: sandbox.EnvdMetadata{
+			Version: s.EnvdVersion,
+		},
+	}
+
+	// Relative paths are relative to the root of the stage
+	relativePath := strings.TrimPrefix(path.Clean("/"+sourcePath), "/")
+	if relativePath == "" {
+		relativePath = "."
+	}
+
+	return s.layerExecutor.RunInSandbox(
+		ctx,
+		layer.NewCacheSourceTemplateProvider(result.Metadata.Template),
+		layer.NewResumeSandbox(sbxConfig, exportTimeout),
+		func(ctx context.Context, sbx *sandbox.Sandbox) error {
+			archivePath := filepath.Join("/tmp", fmt.Sprintf("stage-%s.tar.gz", uuid.NewString()))
+
+			err := sandboxtools.RunCommand(
+				ctx,
+				s.proxy,
+				sbx.Runtime.SandboxID,
+				fmt.Sprintf(`tar -czf "%s" -C / "%s"`, archivePath, relativePath),
+				metadata.Context{User: "root"},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to pack %s in stage %s: %w", sourcePath, name, err)
+			}
+
+			err = sandboxtools.DownloadFile(ctx, s.proxy, sbx.Runtime.SandboxID, "root", archivePath, targetPath)
+			if err != nil {
+				return fmt.Errorf("failed to download files from stage %s: %w", name, err)
+			}
+
+			return nil
+		},
+	)
+}
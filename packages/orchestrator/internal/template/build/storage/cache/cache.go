@@ -5,7 +5,11 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"path"
+	"slices"
+	"time"
 
 	"go.opentelemetry.io/otel"
 
@@ -26,6 +30,21 @@ type Template struct {
 
 type LayerMetadata struct {
 	Template Template `json:"template"`
+
+	// TemplateID is the template whose build created the layer, the layer can be reused by any template in the cache scope.
+	TemplateID string `json:"template_id,omitempty"`
+	// Step is the description of the build step that created the layer.
+	Step      string    `json:"step,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CachedLayer is a layer saved in the cache index.
+type CachedLayer struct {
+	Hash string
+	LayerMetadata
+
+	// SizeBytes is the size of the layer diffs in the template storage.
+	SizeBytes int64
 }
 
 type Index interface {
@@ -106,6 +125,21 @@ func (h *HashIndex) SaveLayerMeta(ctx context.Context, hash string, template Lay
 		return fmt.Errorf("error writing layer metadata to object: %w", err)
 	}
 
+	// The layers in the template's own scope are listed from the index directly
+	if template.TemplateID == "" || template.TemplateID == h.cacheScope {
+		return nil
+	}
+
+	marker, err := h.indexStorage.OpenObject(ctx, paths.TemplateIndexPath(h.cacheScope, template.TemplateID, hash))
+	if err != nil {
+		return fmt.Errorf("error creating object for template layer marker: %w", err)
+	}
+
+	_, err = marker.Write(ctx, []byte{})
+	if err != nil {
+		return fmt.Errorf("error writing template layer marker: %w", err)
+	}
+
 	return nil
 }
 
@@ -139,3 +173,127 @@ func (h *HashIndex) Cached(
 	// If the metadata exists, the layer is cached
 	return tmpl, nil
 }
+
+// ListLayers returns the layers in the cache index created by the template builds.
+// In the template's own cache scope the whole index is listed, it's written only by the builds of the template,
+// so the layers saved before the template ID was recorded are included too.
+// In the shared cache scopes only the layers with the template marker are listed, the older layers of the template can't be told apart.
+func (h *HashIndex) ListLayers(ctx context.Context, templateID string) ([]CachedLayer, error) {
+	ctx, span := tracer.Start(ctx, "list layers")
+	defer span.End()
+
+	ownScope := templateID == h.cacheScope
+
+	prefix := paths.TemplateIndexPrefix(h.cacheScope, templateID)
+	if ownScope {
+		prefix = paths.IndexPrefix(h.cacheScope)
+	}
+
+	objectPaths, err := h.indexStorage.ListObjectsWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing layer index: %w", err)
+	}
+
+	layers := make([]CachedLayer, 0)
+	for _, objectPath := range objectPaths {
+		hash := path.Base(objectPath)
+
+		meta, err := h.LayerMetaFromHash(ctx, hash)
+		if err != nil {
+			// Invalid entries are not used by the builds either
+			continue
+		}
+
+		// The layer may be saved again by another template's build in the shared scope
+		if meta.TemplateID != templateID && (!ownScope || meta.TemplateID != "") {
+			continue
+		}
+
+		size, err := h.layerSize(ctx, meta.Template.BuildID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting size of layer %s: %w", hash, err)
+		}
+
+		layers = append(layers, CachedLayer{
+			Hash:          hash,
+			LayerMetadata: meta,
+			SizeBytes:     size,
+		})
+	}
+
+	return layers, nil
+}
+
+// InvalidateLayers removes the layers created by the template from the cache index, the next build of the steps rebuilds them.
+// The layer builds are kept, they are still used by the templates built from them.
+// If the hashes are empty, all the layers of the template are invalidated.
+func (h *HashIndex) InvalidateLayers(ctx context.Context, templateID string, hashes []string) ([]string, error) {
+	ctx, span := tracer.Start(ctx, "invalidate layers")
+	defer span.End()
+
+	layers, err := h.ListLayers(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownScope := templateID == h.cacheScope
+
+	invalidated := make([]string, 0)
+	for _, layer := range layers {
+		if len(hashes) > 0 && !slices.Contains(hashes, layer.Hash) {
+			continue
+		}
+
+		obj, err := h.indexStorage.OpenObject(ctx, paths.HashToPath(h.cacheScope, layer.Hash))
+		if err != nil {
+			return invalidated, fmt.Errorf("error opening layer %s: %w", layer.Hash, err)
+		}
+
+		err = obj.Delete(ctx)
+		if err != nil {
+			return invalidated, fmt.Errorf("error deleting layer %s: %w", layer.Hash, err)
+		}
+
+		if !ownScope {
+			marker, err := h.indexStorage.OpenObject(ctx, paths.TemplateIndexPath(h.cacheScope, templateID, layer.Hash))
+			if err != nil {
+				return invalidated, fmt.Errorf("error opening template marker of layer %s: %w", layer.Hash, err)
+			}
+
+			err = marker.Delete(ctx)
+			if err != nil {
+				return invalidated, fmt.Errorf("error deleting template marker of layer %s: %w", layer.Hash, err)
+			}
+		}
+
+		invalidated = append(invalidated, layer.Hash)
+	}
+
+	return invalidated, nil
+}
+
+func (h *HashIndex) layerSize(ctx context.Context, buildID string) (int64, error) {
+	files := storage.TemplateFiles{BuildID: buildID}
+
+	var size int64
+	for _, objectPath := range []string{files.StorageRootfsPath(), files.StorageMemfilePath()} {
+		obj, err := h.templateStorage.OpenObject(ctx, objectPath)
+		if err != nil {
+			return 0, err
+		}
+
+		objectSize, err := obj.Size(ctx)
+		if err != nil {
+			// The build may be already deleted, the layer is not usable then
+			if errors.Is(err, storage.ErrObjectNotExist) {
+				continue
+			}
+
+			return 0, err
+		}
+
+		size += objectSize
+	}
+
+	return size, nil
+}
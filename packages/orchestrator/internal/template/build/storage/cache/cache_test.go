@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+func newTestIndex(t *testing.T) (*HashIndex, storage.StorageProvider) {
+	t.Helper()
+
+	indexStorage, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	templateStorage, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	return NewHashIndex("team", indexStorage, templateStorage), templateStorage
+}
+
+func TestListLayers(t *testing.T) {
+	index, templateStorage := newTestIndex(t)
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	rootfs, err := templateStorage.OpenObject(t.Context(), storage.TemplateFiles{BuildID: "build-1"}.StorageRootfsPath())
+	require.NoError(t, err)
+	_, err = rootfs.Write(t.Context(), bytes.Repeat([]byte("r"), 1024))
+	require.NoError(t, err)
+
+	require.NoError(t, index.SaveLayerMeta(t.Context(), "hash-1", LayerMetadata{
+		Template:   Template{BuildID: "build-1"},
+		TemplateID: "template-1",
+		Step:       "RUN apt-get update",
+		CreatedAt:  createdAt,
+	}))
+	require.NoError(t, index.SaveLayerMeta(t.Context(), "hash-2", LayerMetadata{
+		Template:   Template{BuildID: "build-2"},
+		TemplateID: "template-2",
+	}))
+
+	layers, err := index.ListLayers(t.Context(), "template-1")
+	require.NoError(t, err)
+
+	require.Len(t, layers, 1)
+	assert.Equal(t, "hash-1", layers[0].Hash)
+	assert.Equal(t, "build-1", layers[0].Template.BuildID)
+	assert.Equal(t, "RUN apt-get update", layers[0].Step)
+	assert.True(t, createdAt.Equal(layers[0].CreatedAt))
+	assert.Equal(t, int64(1024), layers[0].SizeBytes)
+}
+
+func TestListLayersOwnScope(t *testing.T) {
+	indexStorage, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	index := NewHashIndex("template-1", indexStorage, indexStorage)
+
+	// Saved before the template ID was recorded
+	require.NoError(t, index.SaveLayerMeta(t.Context(), "hash-1", LayerMetadata{
+		Template: Template{BuildID: "build-1"},
+	}))
+	require.NoError(t, index.SaveLayerMeta(t.Context(), "hash-2", LayerMetadata{
+		Template:   Template{BuildID: "build-2"},
+		TemplateID: "template-1",
+	}))
+
+	layers, err := index.ListLayers(t.Context(), "template-1")
+	require.NoError(t, err)
+
+	hashes := make([]string, 0, len(layers))
+	for _, layer := range layers {
+		hashes = append(hashes, layer.Hash)
+	}
+	assert.ElementsMatch(t, []string{"hash-1", "hash-2"}, hashes)
+}
+
+func TestInvalidateLayers(t *testing.T) {
+	index, _ := newTestIndex(t)
+
+	for _, hash := range []string{"hash-1", "hash-2", "hash-3"} {
+		require.NoError(t, index.SaveLayerMeta(t.Context(), hash, LayerMetadata{
+			Template:   Template{BuildID: "build-" + hash},
+			TemplateID: "template-1",
+		}))
+	}
+
+	invalidated, err := index.InvalidateLayers(t.Context(), "template-1", []string{"hash-2", "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hash-2"}, invalidated)
+
+	_, err = index.LayerMetaFromHash(t.Context(), "hash-2")
+	require.Error(t, err)
+
+	invalidated, err = index.InvalidateLayers(t.Context(), "template-1", nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"hash-1", "hash-3"}, invalidated)
+
+	layers, err := index.ListLayers(t.Context(), "template-1")
+	require.NoError(t, err)
+	assert.Empty(t, layers)
+}
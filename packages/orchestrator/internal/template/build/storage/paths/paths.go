@@ -1,6 +1,8 @@
 package paths
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"path"
 )
 
@@ -15,3 +17,23 @@ func GetLayerFilesCachePath(cacheScope string, hash string) string {
 func HashToPath(cacheScope, hash string) string {
 	return buildStoragePath(cacheScope, "index", hash)
 }
+
+// IndexPrefix returns the prefix of all the layer index entries of the cache scope.
+func IndexPrefix(cacheScope string) string {
+	return buildStoragePath(cacheScope, "index", "") + "/"
+}
+
+// TemplateIndexPath returns the path of the marker of the layer created by the template, it's used to list the layers of one template.
+func TemplateIndexPath(cacheScope, templateID, hash string) string {
+	return path.Join(cacheScope, "templates", templateID, hash)
+}
+
+// TemplateIndexPrefix returns the prefix of the markers of all the layers created by the template.
+func TemplateIndexPrefix(cacheScope, templateID string) string {
+	return path.Join(cacheScope, "templates", templateID) + "/"
+}
+
+// GetCacheMountPath returns the path of the RUN cache mount archive, the mount ID is hashed to a safe object name.
+func GetCacheMountPath(cacheScope string, mountID string) string {
+	return buildStoragePath(cacheScope, "mounts", fmt.Sprintf("%x.tar.gz", sha256.Sum256([]byte(mountID))))
+}
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildcontext"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildlogger"
 	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -28,9 +29,10 @@ type BuildInfoResult struct {
 }
 
 type BuildInfo struct {
-	TeamID string
-	logs   *buildlogger.LogEntryLogger
-	Result *utils.SetOnce[BuildInfoResult]
+	TeamID       string
+	logs         *buildlogger.LogEntryLogger
+	Result       *utils.SetOnce[BuildInfoResult]
+	CacheResults *buildcontext.CacheResults
 }
 
 func (b *BuildInfo) IsRunning() bool {
@@ -132,9 +134,10 @@ func (c *BuildCache) Get(buildID string) (*BuildInfo, error) {
 // Create creates a new build if it doesn't exist in the cache or the build was already finished.
 func (c *BuildCache) Create(teamID string, buildID string, logs *buildlogger.LogEntryLogger) (*BuildInfo, error) {
 	info := &BuildInfo{
-		TeamID: teamID,
-		logs:   logs,
-		Result: utils.NewSetOnce[BuildInfoResult](),
+		TeamID:       teamID,
+		logs:         logs,
+		Result:       utils.NewSetOnce[BuildInfoResult](),
+		CacheResults: buildcontext.NewCacheResults(),
 	}
 
 	_, found := c.cache.GetOrSet(buildID, info,
@@ -0,0 +1,334 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/containers/storage/pkg/archive"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistry "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/filesystem"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/oci"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/metadata"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/export")
+
+const (
+	rootfsFileName = "rootfs.ext4"
+	layerFileName  = "rootfs.tar"
+
+	// readChunkSize is the maximum size of a single read from the storage.
+	readChunkSize = 4 << 20 // 4 MiB
+)
+
+// excludedPaths are not part of the exported image, they are created by the filesystem and not by the template.
+var excludedPaths = []string{"lost+found"}
+
+// ReassembleRootfs writes the full rootfs of the build to the file at the path.
+// The blocks are read from the builds referenced in the header mappings, so the rootfs is complete even for builds that store only a diff.
+func ReassembleRootfs(ctx context.Context, s storage.StorageProvider, buildID string, path string) error {
+	ctx, span := tracer.Start(ctx, "reassemble-rootfs")
+	defer span.End()
+
+	h, err := rootfsHeader(ctx, s, buildID)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create rootfs file: %w", err)
+	}
+	defer f.Close()
+
+	// The unmapped parts stay sparse, they are read as zeros
+	err = f.Truncate(int64(h.Metadata.Size))
+	if err != nil {
+		return fmt.Errorf("failed to resize rootfs file: %w", err)
+	}
+
+	objects := make(map[uuid.UUID]storage.StorageObjectProvider)
+	buf := make([]byte, readChunkSize)
+
+	for _, mapping := range h.Mapping {
+		if mapping.BuildId == uuid.Nil {
+			continue
+		}
+
+		obj, ok := objects[mapping.BuildId]
+		if !ok {
+			files := storage.TemplateFiles{BuildID: mapping.BuildId.String()}
+
+			obj, err = s.OpenObject(ctx, files.StorageRootfsPath())
+			if err != nil {
+				return fmt.Errorf("failed to open rootfs of build %s: %w", mapping.BuildId, err)
+			}
+			objects[mapping.BuildId] = obj
+		}
+
+		for copied := uint64(0); copied < mapping.Length; {
+			length := min(mapping.Length-copied, uint64(len(buf)))
+
+			err = readFull(ctx, obj, buf[:length], int64(mapping.BuildStorageOffset+copied))
+			if err != nil {
+				return fmt.Errorf("failed to read rootfs of build %s: %w", mapping.BuildId, err)
+			}
+
+			_, err = f.WriteAt(buf[:length], int64(mapping.Offset+copied))
+			if err != nil {
+				return fmt.Errorf("failed to write rootfs: %w", err)
+			}
+
+			copied += length
+		}
+	}
+
+	return f.Close()
+}
+
+// Image creates a single layer image from the rootfs of the build, the image config is set from the template metadata.
+// The working files are stored in the dir, it must exist until the image is written.
+func Image(ctx context.Context, s storage.StorageProvider, buildID string, cpuArchitecture string, dir string) (containerregistry.Image, error) {
+	ctx, span := tracer.Start(ctx, "export-image")
+	defer span.End()
+
+	rootfsPath := filepath.Join(dir, rootfsFileName)
+	err := ReassembleRootfs(ctx, s, buildID, rootfsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	layerPath := filepath.Join(dir, layerFileName)
+	err = archiveRootfs(ctx, rootfsPath, layerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The layer is already archived, the ext4 file is not needed anymore
+	err = os.Remove(rootfsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove rootfs file: %w", err)
+	}
+
+	layer, err := tarball.LayerFromFile(layerPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image layer: %w", err)
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to append image layer: %w", err)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image config: %w", err)
+	}
+
+	cfg = cfg.DeepCopy()
+	err = setImageConfig(ctx, s, buildID, cpuArchitecture, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err = mutate.ConfigFile(img, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set image config: %w", err)
+	}
+
+	return img, nil
+}
+
+// WriteTarball writes the image as a tarball loadable by `docker load`, the image is tagged with the tag.
+func WriteTarball(img containerregistry.Image, tag string, w io.Writer) error {
+	ref, err := name.NewTag(tag)
+	if err != nil {
+		return fmt.Errorf("invalid image tag: %w", err)
+	}
+
+	err = tarball.Write(ref, img, w)
+	if err != nil {
+		return fmt.Errorf("failed to write image tarball: %w", err)
+	}
+
+	return nil
+}
+
+// Push pushes the image to the registry, the credentials are taken from the default keychain (e.g. docker config).
+func Push(ctx context.Context, img containerregistry.Image, tag string) error {
+	ctx, span := tracer.Start(ctx, "push-image")
+	defer span.End()
+
+	ref, err := name.NewTag(tag)
+	if err != nil {
+		return fmt.Errorf("invalid image tag: %w", err)
+	}
+
+	err = remote.Write(ref, img, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return fmt.Errorf("failed to push image: %w", err)
+	}
+
+	return nil
+}
+
+// DefaultTag returns the tag of the exported image when no tag is requested.
+func DefaultTag(templateID string, buildID string) string {
+	return fmt.Sprintf("%s:%s", templateID, buildID)
+}
+
+func rootfsHeader(ctx context.Context, s storage.StorageProvider, buildID string) (*header.Header, error) {
+	files := storage.TemplateFiles{BuildID: buildID}
+
+	headerObj, err := s.OpenObject(ctx, files.StorageRootfsHeaderPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rootfs header: %w", err)
+	}
+
+	h, err := header.Deserialize(ctx, headerObj)
+	if err == nil {
+		return h, nil
+	}
+	if !errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, fmt.Errorf("failed to deserialize rootfs header: %w", err)
+	}
+
+	// Builds without a header store the whole rootfs
+	obj, err := s.OpenObject(ctx, files.StorageRootfsPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rootfs: %w", err)
+	}
+
+	size, err := obj.Size(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rootfs size: %w", err)
+	}
+
+	id, err := uuid.Parse(buildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	return header.NewHeader(header.NewTemplateMetadata(id, header.RootfsBlockSize, uint64(size)), nil)
+}
+
+func readFull(ctx context.Context, obj storage.StorageObjectProvider, p []byte, off int64) error {
+	for n := 0; n < len(p); {
+		read, err := obj.ReadAt(ctx, p[n:], off+int64(n))
+		n += read
+
+		if errors.Is(err, io.EOF) && n == len(p) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if read == 0 {
+			return io.ErrUnexpectedEOF
+		}
+	}
+
+	return nil
+}
+
+func archiveRootfs(ctx context.Context, rootfsPath string, layerPath string) (e error) {
+	ctx, span := tracer.Start(ctx, "archive-rootfs")
+	defer span.End()
+
+	mountPath, err := os.MkdirTemp(filepath.Dir(rootfsPath), "rootfs-mount")
+	if err != nil {
+		return fmt.Errorf("failed to create mount point: %w", err)
+	}
+	defer os.RemoveAll(mountPath)
+
+	err = filesystem.Mount(ctx, rootfsPath, mountPath)
+	if err != nil {
+		return fmt.Errorf("failed to mount rootfs: %w", err)
+	}
+	defer func() {
+		if unmountErr := filesystem.Unmount(context.WithoutCancel(ctx), mountPath); unmountErr != nil {
+			zap.L().Error("error unmounting rootfs", zap.Error(unmountErr))
+		}
+	}()
+
+	rc, err := archive.TarWithOptions(mountPath, &archive.TarOptions{
+		Compression:     archive.Uncompressed,
+		ExcludePatterns: excludedPaths,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive rootfs: %w", err)
+	}
+	defer rc.Close()
+
+	f, err := os.Create(layerPath)
+	if err != nil {
+		return fmt.Errorf("failed to create layer file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, rc)
+	if err != nil {
+		return fmt.Errorf("failed to write layer file: %w", err)
+	}
+
+	return f.Close()
+}
+
+func setImageConfig(ctx context.Context, s storage.StorageProvider, buildID string, cpuArchitecture string, cfg *containerregistry.ConfigFile) error {
+	platform := oci.Platform(cpuArchitecture)
+	cfg.Architecture = platform.Architecture
+	cfg.OS = platform.OS
+
+	tmpl, err := metadata.FromBuildID(ctx, s, buildID)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		// Old builds don't have the metadata, the image is exported without the run config
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get template metadata: %w", err)
+	}
+
+	cfg.Config.User = tmpl.Context.User
+	if tmpl.Context.WorkDir != nil {
+		cfg.Config.WorkingDir = *tmpl.Context.WorkDir
+	}
+	cfg.Config.Env = envList(tmpl.Context.EnvVars)
+
+	if tmpl.Start != nil && tmpl.Start.StartCmd != "" {
+		cfg.Config.Cmd = []string{"/bin/bash", "-l", "-c", tmpl.Start.StartCmd}
+	}
+
+	return nil
+}
+
+func envList(envVars map[string]string) []string {
+	keys := make([]string, 0, len(envVars))
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	envs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		envs = append(envs, fmt.Sprintf("%s=%s", key, envVars[key]))
+	}
+
+	return envs
+}
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = header.RootfsBlockSize
+
+func writeObject(t *testing.T, s storage.StorageProvider, path string, data []byte) {
+	t.Helper()
+
+	obj, err := s.OpenObject(t.Context(), path)
+	require.NoError(t, err)
+
+	_, err = obj.Write(t.Context(), data)
+	require.NoError(t, err)
+}
+
+func TestReassembleRootfs(t *testing.T) {
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	baseID := uuid.New()
+	diffID := uuid.New()
+
+	// The base build has 3 blocks, the diff build changes only the middle one
+	base := bytes.Join([][]byte{
+		bytes.Repeat([]byte("a"), testBlockSize),
+		bytes.Repeat([]byte("b"), testBlockSize),
+		bytes.Repeat([]byte("c"), testBlockSize),
+	}, nil)
+	diff := bytes.Repeat([]byte("d"), testBlockSize)
+
+	writeObject(t, s, storage.TemplateFiles{BuildID: baseID.String()}.StorageRootfsPath(), base)
+	writeObject(t, s, storage.TemplateFiles{BuildID: diffID.String()}.StorageRootfsPath(), diff)
+
+	mappings := []*header.BuildMap{
+		{Offset: 0, Length: testBlockSize, BuildId: baseID, BuildStorageOffset: 0},
+		{Offset: testBlockSize, Length: testBlockSize, BuildId: diffID, BuildStorageOffset: 0},
+		{Offset: 2 * testBlockSize, Length: testBlockSize, BuildId: baseID, BuildStorageOffset: 2 * testBlockSize},
+		{Offset: 3 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil, BuildStorageOffset: 0},
+	}
+	metadata := header.NewTemplateMetadata(baseID, testBlockSize, 4*testBlockSize).NextGeneration(diffID)
+
+	serialized, err := header.Serialize(metadata, mappings)
+	require.NoError(t, err)
+	writeObject(t, s, storage.TemplateFiles{BuildID: diffID.String()}.StorageRootfsHeaderPath(), serialized)
+
+	path := filepath.Join(t.TempDir(), "rootfs.ext4")
+	err = ReassembleRootfs(t.Context(), s, diffID.String(), path)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	expected := bytes.Join([][]byte{
+		bytes.Repeat([]byte("a"), testBlockSize),
+		bytes.Repeat([]byte("d"), testBlockSize),
+		bytes.Repeat([]byte("c"), testBlockSize),
+		make([]byte, testBlockSize),
+	}, nil)
+	assert.Equal(t, expected, data)
+}
+
+func TestReassembleRootfs_WithoutHeader(t *testing.T) {
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	buildID := uuid.New()
+	rootfs := bytes.Repeat([]byte("r"), 2*testBlockSize)
+	writeObject(t, s, storage.TemplateFiles{BuildID: buildID.String()}.StorageRootfsPath(), rootfs)
+
+	path := filepath.Join(t.TempDir(), "rootfs.ext4")
+	err = ReassembleRootfs(t.Context(), s, buildID.String(), path)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, rootfs, data)
+}
+
+func TestEnvList(t *testing.T) {
+	envs := envList(map[string]string{"B": "2", "A": "1"})
+
+	assert.Equal(t, []string{"A=1", "B=2"}, envs)
+}
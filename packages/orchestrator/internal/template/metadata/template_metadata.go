@@ -50,6 +50,10 @@ type Template struct {
 	Start        *Start                `json:"start,omitempty"`
 	FromImage    *string               `json:"from_image,omitempty"`
 	FromTemplate *FromTemplate         `json:"from_template,omitempty"`
+	// VolumeDrives is the number of spare drives the template was booted with, volumes can be attached only to them.
+	VolumeDrives int `json:"volume_drives,omitempty"`
+	// FromImageDigest is the digest of the pulled base image, the tag of FromImage can later point to another image.
+	FromImageDigest *string `json:"from_image_digest,omitempty"`
 }
 
 func V1TemplateVersion() Template {
@@ -68,28 +72,33 @@ func (t Template) BasedOn(
 		Start:        t.Start,
 		FromTemplate: &ft,
 		FromImage:    nil,
+		VolumeDrives: t.VolumeDrives,
 	}
 }
 
 func (t Template) NewVersionTemplate(files storage.TemplateFiles) Template {
 	return Template{
-		Version:      CurrentVersion,
-		Template:     files,
-		Context:      t.Context,
-		Start:        t.Start,
-		FromTemplate: t.FromTemplate,
-		FromImage:    t.FromImage,
+		Version:         CurrentVersion,
+		Template:        files,
+		Context:         t.Context,
+		Start:           t.Start,
+		FromTemplate:    t.FromTemplate,
+		FromImage:       t.FromImage,
+		FromImageDigest: t.FromImageDigest,
+		VolumeDrives:    t.VolumeDrives,
 	}
 }
 
 func (t Template) SameVersionTemplate(files storage.TemplateFiles) Template {
 	return Template{
-		Version:      t.Version,
-		Template:     files,
-		Context:      t.Context,
-		Start:        t.Start,
-		FromTemplate: t.FromTemplate,
-		FromImage:    t.FromImage,
+		Version:         t.Version,
+		Template:        files,
+		Context:         t.Context,
+		Start:           t.Start,
+		FromTemplate:    t.FromTemplate,
+		FromImage:       t.FromImage,
+		FromImageDigest: t.FromImageDigest,
+		VolumeDrives:    t.VolumeDrives,
 	}
 }
 
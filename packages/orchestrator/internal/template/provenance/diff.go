@@ -0,0 +1,279 @@
+package provenance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/filesystem"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/export"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+var tracer = otel.Tracer("github.com/e2b-dev/infra/packages/orchestrator/internal/template/provenance")
+
+// IgnoredPaths are not compared, they are created by the filesystem or change with every run of the build.
+var IgnoredPaths = []string{
+	"lost+found",
+	"dev",
+	"proc",
+	"run",
+	"sys",
+	"tmp",
+	"var/cache",
+	"var/log",
+	"var/tmp",
+	"etc/hostname",
+	"etc/hosts",
+	"etc/machine-id",
+	"etc/resolv.conf",
+}
+
+type DifferenceKind string
+
+const (
+	DifferenceAdded    DifferenceKind = "added"
+	DifferenceRemoved  DifferenceKind = "removed"
+	DifferenceModified DifferenceKind = "modified"
+)
+
+// Difference of a path between the original and the rebuilt rootfs.
+type Difference struct {
+	Path string         `json:"path"`
+	Kind DifferenceKind `json:"kind"`
+	// Detail says what changed on the modified path
+	Detail string `json:"detail,omitempty"`
+}
+
+// CompareRootfs reassembles the rootfs of both builds in the directory, mounts them and compares their files.
+func CompareRootfs(ctx context.Context, s storage.StorageProvider, buildID string, rebuildID string, dir string) ([]Difference, error) {
+	ctx, span := tracer.Start(ctx, "compare-rootfs")
+	defer span.End()
+
+	original, cleanupOriginal, err := mountRootfs(ctx, s, buildID, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mount rootfs of build %s: %w", buildID, err)
+	}
+	defer cleanupOriginal()
+
+	rebuilt, cleanupRebuilt, err := mountRootfs(ctx, s, rebuildID, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mount rootfs of build %s: %w", rebuildID, err)
+	}
+	defer cleanupRebuilt()
+
+	return diffTrees(original, rebuilt, IgnoredPaths)
+}
+
+func mountRootfs(ctx context.Context, s storage.StorageProvider, buildID string, dir string) (string, func(), error) {
+	rootfsPath := filepath.Join(dir, buildID+".ext4")
+	err := export.ReassembleRootfs(ctx, s, buildID, rootfsPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	mountPath := filepath.Join(dir, buildID)
+	err = os.Mkdir(mountPath, 0o755)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create mount point: %w", err)
+	}
+
+	// The rootfs is built from the user code, it's only read
+	err = filesystem.MountReadOnly(ctx, rootfsPath, mountPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mountPath, func() {
+		if unmountErr := filesystem.Unmount(context.WithoutCancel(ctx), mountPath); unmountErr != nil {
+			zap.L().Error("error unmounting rootfs", zap.Error(unmountErr), zap.String("build_id", buildID))
+		}
+	}, nil
+}
+
+type treeEntry struct {
+	mode fs.FileMode
+	uid  uint32
+	gid  uint32
+	size int64
+	link string
+}
+
+// diffTrees compares the files of the directories, the ignored paths are relative to the roots.
+// The modification times are not compared, they change with every build.
+func diffTrees(original string, rebuilt string, ignored []string) ([]Difference, error) {
+	originalEntries, err := walkTree(original, ignored)
+	if err != nil {
+		return nil, err
+	}
+
+	rebuiltEntries, err := walkTree(rebuilt, ignored)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []Difference
+	for path, originalEntry := range originalEntries {
+		rebuiltEntry, ok := rebuiltEntries[path]
+		if !ok {
+			differences = append(differences, Difference{Path: path, Kind: DifferenceRemoved})
+
+			continue
+		}
+
+		detail, err := diffEntry(filepath.Join(original, path), filepath.Join(rebuilt, path), originalEntry, rebuiltEntry)
+		if err != nil {
+			return nil, err
+		}
+
+		if detail != "" {
+			differences = append(differences, Difference{Path: path, Kind: DifferenceModified, Detail: detail})
+		}
+	}
+
+	for path := range rebuiltEntries {
+		if _, ok := originalEntries[path]; !ok {
+			differences = append(differences, Difference{Path: path, Kind: DifferenceAdded})
+		}
+	}
+
+	slices.SortFunc(differences, func(a, b Difference) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return differences, nil
+}
+
+func walkTree(root string, ignored []string) (map[string]treeEntry, error) {
+	entries := make(map[string]treeEntry)
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		if slices.Contains(ignored, filepath.ToSlash(rel)) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entry := treeEntry{
+			mode: info.Mode(),
+			size: info.Size(),
+		}
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+			entry.uid = stat.Uid
+			entry.gid = stat.Gid
+		}
+
+		if info.Mode()&fs.ModeSymlink != 0 {
+			entry.link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		entries["/"+filepath.ToSlash(rel)] = entry
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+
+	return entries, nil
+}
+
+func diffEntry(originalPath string, rebuiltPath string, original treeEntry, rebuilt treeEntry) (string, error) {
+	switch {
+	case original.mode.Type() != rebuilt.mode.Type():
+		return "type", nil
+	case original.mode.Perm() != rebuilt.mode.Perm():
+		return "mode", nil
+	case original.uid != rebuilt.uid || original.gid != rebuilt.gid:
+		return "owner", nil
+	case original.link != rebuilt.link:
+		return "link", nil
+	case !original.mode.IsRegular():
+		return "", nil
+	case original.size != rebuilt.size:
+		return "content", nil
+	}
+
+	same, err := sameContent(originalPath, rebuiltPath)
+	if err != nil {
+		return "", err
+	}
+
+	if !same {
+		return "content", nil
+	}
+
+	return "", nil
+}
+
+func sameContent(originalPath string, rebuiltPath string) (bool, error) {
+	originalFile, err := os.Open(originalPath)
+	if err != nil {
+		return false, err
+	}
+	defer originalFile.Close()
+
+	rebuiltFile, err := os.Open(rebuiltPath)
+	if err != nil {
+		return false, err
+	}
+	defer rebuiltFile.Close()
+
+	originalBuf := make([]byte, 64<<10)
+	rebuiltBuf := make([]byte, 64<<10)
+	for {
+		n, originalErr := io.ReadFull(originalFile, originalBuf)
+		m, rebuiltErr := io.ReadFull(rebuiltFile, rebuiltBuf)
+
+		if !bytes.Equal(originalBuf[:n], rebuiltBuf[:m]) {
+			return false, nil
+		}
+
+		originalDone := errors.Is(originalErr, io.EOF) || errors.Is(originalErr, io.ErrUnexpectedEOF)
+		rebuiltDone := errors.Is(rebuiltErr, io.EOF) || errors.Is(rebuiltErr, io.ErrUnexpectedEOF)
+		if originalErr != nil && !originalDone {
+			return false, originalErr
+		}
+		if rebuiltErr != nil && !rebuiltDone {
+			return false, rebuiltErr
+		}
+
+		if originalDone || rebuiltDone {
+			return originalDone == rebuiltDone, nil
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package provenance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, root string, path string, content string, perm os.FileMode) {
+	t.Helper()
+
+	path = filepath.Join(root, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), perm))
+}
+
+func TestDiffTrees(t *testing.T) {
+	original := t.TempDir()
+	rebuilt := t.TempDir()
+
+	writeFile(t, original, "etc/same", "same", 0o644)
+	writeFile(t, rebuilt, "etc/same", "same", 0o644)
+
+	writeFile(t, original, "etc/content", "original", 0o644)
+	writeFile(t, rebuilt, "etc/content", "rebuilt!", 0o644)
+
+	writeFile(t, original, "usr/bin/tool", "tool", 0o644)
+	writeFile(t, rebuilt, "usr/bin/tool", "tool", 0o755)
+
+	writeFile(t, original, "removed", "removed", 0o644)
+	writeFile(t, rebuilt, "added/file", "added", 0o644)
+
+	require.NoError(t, os.Symlink("/etc/same", filepath.Join(original, "link")))
+	require.NoError(t, os.Symlink("/etc/content", filepath.Join(rebuilt, "link")))
+
+	// The ignored paths differ with every build
+	writeFile(t, original, "var/log/build.log", "original", 0o644)
+	writeFile(t, rebuilt, "var/log/build.log", "rebuilt", 0o644)
+	writeFile(t, rebuilt, "var/log/other.log", "rebuilt", 0o644)
+
+	differences, err := diffTrees(original, rebuilt, IgnoredPaths)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Difference{
+		{Path: "/added", Kind: DifferenceAdded},
+		{Path: "/added/file", Kind: DifferenceAdded},
+		{Path: "/etc/content", Kind: DifferenceModified, Detail: "content"},
+		{Path: "/link", Kind: DifferenceModified, Detail: "link"},
+		{Path: "/removed", Kind: DifferenceRemoved},
+		{Path: "/usr/bin/tool", Kind: DifferenceModified, Detail: "mode"},
+	}, differences)
+}
+
+func TestDiffTrees_Same(t *testing.T) {
+	original := t.TempDir()
+	rebuilt := t.TempDir()
+
+	for _, root := range []string{original, rebuilt} {
+		writeFile(t, root, "etc/config", "config", 0o644)
+		writeFile(t, root, "usr/bin/tool", string(make([]byte, 200<<10)), 0o755)
+	}
+
+	differences, err := diffTrees(original, rebuilt, IgnoredPaths)
+	require.NoError(t, err)
+	assert.Empty(t, differences)
+}
+
+func TestVerificationSetDifferences(t *testing.T) {
+	v := Verification{Status: VerificationRunning}
+	v.SetDifferences(nil)
+	assert.Equal(t, VerificationVerified, v.Status)
+	assert.NotNil(t, v.FinishedAt)
+
+	differences := make([]Difference, maxDifferences+1)
+	v = Verification{Status: VerificationRunning}
+	v.SetDifferences(differences)
+	assert.Equal(t, VerificationMismatch, v.Status)
+	assert.Len(t, v.Differences, maxDifferences)
+	assert.Equal(t, maxDifferences+1, v.DifferencesTotal)
+}
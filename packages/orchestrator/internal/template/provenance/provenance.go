@@ -0,0 +1,137 @@
+package provenance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+// Version of the provenance document format
+const Version = 1
+
+// Provenance describes what the template build contains, it's saved next to the build files when the build finishes.
+// The layer hashes are content addressed, the same hashes mean the same inputs of the layers.
+type Provenance struct {
+	Version         int       `json:"version"`
+	TemplateID      string    `json:"templateID"`
+	BuildID         string    `json:"buildID"`
+	CreatedAt       time.Time `json:"createdAt"`
+	CPUArchitecture string    `json:"cpuArchitecture"`
+
+	EnvdVersion        string `json:"envdVersion"`
+	KernelVersion      string `json:"kernelVersion"`
+	FirecrackerVersion string `json:"firecrackerVersion"`
+
+	Base   Base    `json:"base"`
+	Stages []Stage `json:"stages,omitempty"`
+	Steps  []Step  `json:"steps"`
+
+	StartCmd string `json:"startCmd,omitempty"`
+	ReadyCmd string `json:"readyCmd,omitempty"`
+	// FinalizeHash is the hash of the last layer, it covers the whole chain of the layers
+	FinalizeHash string `json:"finalizeHash"`
+}
+
+// Base is the source of the first layer, either an image or another template build.
+type Base struct {
+	FromImage string `json:"fromImage,omitempty"`
+	// FromImageDigest is the digest of the pulled image, the image tag can later point to another image
+	FromImageDigest string `json:"fromImageDigest,omitempty"`
+	FromTemplate    string `json:"fromTemplate,omitempty"`
+	FromBuildID     string `json:"fromBuildID,omitempty"`
+	Hash            string `json:"hash"`
+	Cached          bool   `json:"cached"`
+}
+
+// PinnedImage returns the image reference pinned to the digest of the pulled image.
+// The reference is returned unchanged when the digest is unknown.
+func (b Base) PinnedImage() (string, error) {
+	if b.FromImage == "" || b.FromImageDigest == "" {
+		return b.FromImage, nil
+	}
+
+	ref, err := name.ParseReference(b.FromImage)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %s: %w", b.FromImage, err)
+	}
+
+	return ref.Context().Digest(b.FromImageDigest).String(), nil
+}
+
+type Stage struct {
+	Name  string `json:"name"`
+	Base  Base   `json:"base"`
+	Steps []Step `json:"steps"`
+}
+
+type Step struct {
+	Number    int      `json:"number"`
+	Type      string   `json:"type"`
+	Args      []string `json:"args"`
+	FilesHash string   `json:"filesHash,omitempty"`
+	FromStage string   `json:"fromStage,omitempty"`
+	Hash      string   `json:"hash"`
+	Cached    bool     `json:"cached"`
+}
+
+// Upload saves the provenance document of the build.
+func Upload(ctx context.Context, s storage.StorageProvider, p Provenance) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to marshal provenance: %w", err)
+	}
+
+	return upload(ctx, s, storage.TemplateFiles{BuildID: p.BuildID}.StorageProvenancePath(), data)
+}
+
+// Get returns the provenance document of the build, storage.ErrObjectNotExist is returned for the builds finished without it.
+func Get(ctx context.Context, s storage.StorageProvider, buildID string) (Provenance, error) {
+	data, err := Read(ctx, s, storage.TemplateFiles{BuildID: buildID}.StorageProvenancePath())
+	if err != nil {
+		return Provenance{}, err
+	}
+
+	var p Provenance
+	err = json.Unmarshal(data, &p)
+	if err != nil {
+		return Provenance{}, fmt.Errorf("failed to unmarshal provenance: %w", err)
+	}
+
+	return p, nil
+}
+
+// Read returns the raw JSON document from the storage path.
+func Read(ctx context.Context, s storage.StorageProvider, path string) ([]byte, error) {
+	obj, err := s.OpenObject(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = obj.WriteTo(ctx, &buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func upload(ctx context.Context, s storage.StorageProvider, path string, data []byte) error {
+	obj, err := s.OpenObject(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	_, err = obj.Write(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
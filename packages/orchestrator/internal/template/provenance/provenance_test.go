@@ -0,0 +1,87 @@
+package provenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const testDigest = "sha256:9b8dec3bf938bc80fbe758d856e96fdfab5f56c39d44b0cff351e847bb1b01ea"
+
+func TestPinnedImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     Base
+		expected string
+	}{
+		{
+			name:     "tag",
+			base:     Base{FromImage: "ubuntu:22.04", FromImageDigest: testDigest},
+			expected: "index.docker.io/library/ubuntu@" + testDigest,
+		},
+		{
+			name:     "registry",
+			base:     Base{FromImage: "ghcr.io/e2b-dev/base:latest", FromImageDigest: testDigest},
+			expected: "ghcr.io/e2b-dev/base@" + testDigest,
+		},
+		{
+			name:     "without digest",
+			base:     Base{FromImage: "ubuntu:22.04"},
+			expected: "ubuntu:22.04",
+		},
+		{
+			name:     "template",
+			base:     Base{FromTemplate: "base", FromBuildID: "build"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image, err := tt.base.PinnedImage()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, image)
+		})
+	}
+}
+
+func TestUploadGet(t *testing.T) {
+	s, err := storage.NewFileSystemStorageProvider(t.TempDir())
+	require.NoError(t, err)
+
+	p := Provenance{
+		Version:    Version,
+		TemplateID: "template",
+		BuildID:    "build",
+		Base:       Base{FromImage: "ubuntu:22.04", FromImageDigest: testDigest, Hash: "base"},
+		Steps: []Step{
+			{Number: 1, Type: "RUN", Args: []string{"echo hello"}, Hash: "step"},
+		},
+	}
+
+	err = Upload(t.Context(), s, p)
+	require.NoError(t, err)
+
+	stored, err := Get(t.Context(), s, "build")
+	require.NoError(t, err)
+	assert.Equal(t, p, stored)
+
+	_, err = Get(t.Context(), s, "missing")
+	require.ErrorIs(t, err, storage.ErrObjectNotExist)
+}
+
+func TestVerificationRunning(t *testing.T) {
+	running := Verification{Status: VerificationRunning, StartedAt: time.Now()}
+	assert.True(t, running.Running())
+
+	// The verification interrupted by the builder restart doesn't block the next one
+	interrupted := Verification{Status: VerificationRunning, StartedAt: time.Now().Add(-VerificationTimeout)}
+	assert.False(t, interrupted.Running())
+
+	finished := Verification{Status: VerificationVerified, StartedAt: time.Now()}
+	assert.False(t, finished.Running())
+}
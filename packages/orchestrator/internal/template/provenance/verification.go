@@ -0,0 +1,98 @@
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+)
+
+const (
+	// maxDifferences limits the differences saved in the verification, the rebuild of a non-reproducible template can differ in every file
+	maxDifferences = 1000
+
+	// VerificationTimeout limits the rebuild and the comparison, the running verification older than it was interrupted
+	VerificationTimeout = time.Hour
+)
+
+type VerificationStatus string
+
+const (
+	VerificationRunning  VerificationStatus = "running"
+	VerificationVerified VerificationStatus = "verified"
+	VerificationMismatch VerificationStatus = "mismatch"
+	VerificationFailed   VerificationStatus = "failed"
+)
+
+// Verification is the result of rebuilding the template build and comparing its rootfs with the original one.
+// It's saved next to the files of the original build, only the last verification is kept.
+type Verification struct {
+	BuildID string `json:"buildID"`
+	// RebuildID is the build ID of the rebuild, its files are removed after the comparison
+	RebuildID  string             `json:"rebuildID"`
+	Status     VerificationStatus `json:"status"`
+	Reason     string             `json:"reason,omitempty"`
+	StartedAt  time.Time          `json:"startedAt"`
+	FinishedAt *time.Time         `json:"finishedAt,omitempty"`
+
+	// Differences are limited to maxDifferences, DifferencesTotal is the count of all of them
+	Differences      []Difference `json:"differences,omitempty"`
+	DifferencesTotal int          `json:"differencesTotal"`
+	IgnoredPaths     []string     `json:"ignoredPaths"`
+}
+
+// Running reports whether the verification is still in progress.
+func (v *Verification) Running() bool {
+	return v.Status == VerificationRunning && time.Since(v.StartedAt) < VerificationTimeout
+}
+
+// Finish sets the final status of the verification.
+func (v *Verification) Finish(status VerificationStatus, reason string) {
+	now := time.Now()
+
+	v.Status = status
+	v.Reason = reason
+	v.FinishedAt = &now
+}
+
+// SetDifferences saves the differences of the rootfs and sets the status by them.
+func (v *Verification) SetDifferences(differences []Difference) {
+	v.DifferencesTotal = len(differences)
+	v.Differences = differences[:min(len(differences), maxDifferences)]
+
+	if len(differences) == 0 {
+		v.Finish(VerificationVerified, "")
+
+		return
+	}
+
+	v.Finish(VerificationMismatch, fmt.Sprintf("%d paths differ from the original build", len(differences)))
+}
+
+// GetVerification returns the last verification of the build.
+func GetVerification(ctx context.Context, s storage.StorageProvider, buildID string) (Verification, error) {
+	data, err := Read(ctx, s, storage.TemplateFiles{BuildID: buildID}.StorageVerificationPath())
+	if err != nil {
+		return Verification{}, err
+	}
+
+	var v Verification
+	err = json.Unmarshal(data, &v)
+	if err != nil {
+		return Verification{}, fmt.Errorf("failed to unmarshal verification: %w", err)
+	}
+
+	return v, nil
+}
+
+// UploadVerification saves the verification of the build, it replaces the previous one.
+func UploadVerification(ctx context.Context, s storage.StorageProvider, v Verification) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal verification: %w", err)
+	}
+
+	return upload(ctx, s, storage.TemplateFiles{BuildID: v.BuildID}.StorageVerificationPath(), data)
+}
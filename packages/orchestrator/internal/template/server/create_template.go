@@ -7,12 +7,16 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/builderrors"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/buildlogger"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/core/oci/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -34,6 +38,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		attribute.Int64("env.memory_mb", int64(cfg.MemoryMB)),
 		attribute.Int64("env.vcpu_count", int64(cfg.VCpuCount)),
 		attribute.Bool("env.huge_pages", cfg.HugePages),
+		attribute.String("env.cpu_architecture", cfg.CpuArchitecture),
 	)
 
 	if s.info.GetStatus() != orchestrator.ServiceInfoStatus_Healthy {
@@ -41,6 +46,11 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		return nil, fmt.Errorf("server is draining")
 	}
 
+	// The build runs the template in sandboxes, so it can be built only on a node with the same architecture
+	if cpuArchitecture := consts.CPUArchitectureOrDefault(cfg.CpuArchitecture); cpuArchitecture != fc.HostArchitecture {
+		return nil, status.Errorf(codes.FailedPrecondition, "template architecture %s doesn't match the builder architecture %s", cpuArchitecture, fc.HostArchitecture)
+	}
+
 	metadata := storage.TemplateFiles{
 		BuildID:            cfg.BuildID,
 		KernelVersion:      cfg.KernelVersion,
@@ -53,25 +63,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		cacheScope = *templateRequest.CacheScope
 	}
 
-	// Create the auth provider using the factory
-	authProvider := auth.NewAuthProvider(cfg.FromImageRegistry)
-
-	template := config.TemplateConfig{
-		TeamID:               cfg.TeamID,
-		TemplateID:           cfg.TemplateID,
-		CacheScope:           cacheScope,
-		VCpuCount:            int64(cfg.VCpuCount),
-		MemoryMB:             int64(cfg.MemoryMB),
-		StartCmd:             cfg.StartCommand,
-		ReadyCmd:             cfg.ReadyCommand,
-		DiskSizeMB:           int64(cfg.DiskSizeMB),
-		HugePages:            cfg.HugePages,
-		FromImage:            cfg.GetFromImage(),
-		FromTemplate:         cfg.GetFromTemplate(),
-		RegistryAuthProvider: authProvider,
-		Force:                cfg.Force,
-		Steps:                cfg.Steps,
-	}
+	template := templateConfig(cfg, cacheScope)
 
 	logs := buildlogger.NewLogEntryLogger()
 	buildInfo, err := s.buildCache.Create(template.TeamID, metadata.BuildID, logs)
@@ -119,7 +111,7 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 			}
 		}()
 
-		res, err := s.builder.Build(ctx, metadata, template, core)
+		res, err := s.builder.Build(ctx, metadata, template, core, buildInfo.CacheResults)
 		_ = core.Sync()
 		if err != nil {
 			telemetry.ReportCriticalError(ctx, "error while building template", err, telemetry.WithTemplateID(cfg.TemplateID), telemetry.WithBuildID(cfg.BuildID))
@@ -136,3 +128,31 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 
 	return nil, nil
 }
+
+// templateConfig maps the requested template configuration to the build configuration.
+func templateConfig(cfg *templatemanager.TemplateConfig, cacheScope string) config.TemplateConfig {
+	// Create the auth provider using the factory
+	authProvider := auth.NewAuthProvider(cfg.FromImageRegistry)
+
+	return config.TemplateConfig{
+		TeamID:               cfg.TeamID,
+		TemplateID:           cfg.TemplateID,
+		CacheScope:           cacheScope,
+		VCpuCount:            int64(cfg.VCpuCount),
+		MemoryMB:             int64(cfg.MemoryMB),
+		BuildVCpuCount:       int64(cfg.GetBuildVCpuCount()),
+		BuildMemoryMB:        int64(cfg.GetBuildMemoryMB()),
+		StartCmd:             cfg.StartCommand,
+		ReadyCmd:             cfg.ReadyCommand,
+		DiskSizeMB:           int64(cfg.DiskSizeMB),
+		HugePages:            cfg.HugePages,
+		FromImage:            cfg.GetFromImage(),
+		FromTemplate:         cfg.GetFromTemplate(),
+		RegistryAuthProvider: authProvider,
+		Force:                cfg.Force,
+		Steps:                cfg.Steps,
+		Stages:               cfg.Stages,
+		Secrets:              cfg.Secrets,
+		CPUArchitecture:      consts.CPUArchitectureOrDefault(cfg.CpuArchitecture),
+	}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"os"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/export"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// exportChunkSize is the size of the streamed image chunks, it must be below the maximum gRPC message size.
+const exportChunkSize = 2 << 20 // 2 MiB
+
+type exportStreamWriter struct {
+	stream templatemanager.TemplateService_TemplateBuildExportServer
+}
+
+func (w exportStreamWriter) Write(p []byte) (int, error) {
+	for n := 0; n < len(p); n += exportChunkSize {
+		err := w.stream.Send(&templatemanager.TemplateBuildExportResponse{Data: p[n:min(n+exportChunkSize, len(p))]})
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (s *ServerStore) TemplateBuildExport(in *templatemanager.TemplateBuildExportRequest, stream templatemanager.TemplateService_TemplateBuildExportServer) error {
+	ctx, childSpan := tracer.Start(stream.Context(), "template-export-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	))
+	defer childSpan.End()
+
+	s.wg.Add(1)
+	defer s.wg.Done()
+
+	if in.GetTemplateID() == "" || in.GetBuildID() == "" {
+		return status.Error(codes.InvalidArgument, "template id and build id are required fields")
+	}
+
+	tag := in.GetTag()
+	if tag == "" {
+		tag = export.DefaultTag(in.GetTemplateID(), in.GetBuildID())
+	}
+
+	dir, err := os.MkdirTemp("", "template-export")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create export directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	img, err := export.Image(ctx, s.templateStorage, in.GetBuildID(), in.GetCpuArchitecture(), dir)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to export template build", err)
+
+		return status.Errorf(codes.Internal, "failed to export template build: %s", err)
+	}
+
+	w := bufio.NewWriterSize(exportStreamWriter{stream: stream}, exportChunkSize)
+	err = errors.Join(export.WriteTarball(img, tag, w), w.Flush())
+	if err != nil {
+		telemetry.ReportError(ctx, "failed to stream exported image", err)
+
+		return status.Errorf(codes.Internal, "failed to stream exported image: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/storage/cache"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// layerCacheIndex returns the cache index the template builds use, the cache scope defaults to the template.
+func (s *ServerStore) layerCacheIndex(templateID string, cacheScope *string) *cache.HashIndex {
+	scope := templateID
+	if cacheScope != nil {
+		scope = *cacheScope
+	}
+
+	return cache.NewHashIndex(scope, s.buildStorage, s.templateStorage)
+}
+
+func (s *ServerStore) TemplateLayerCacheList(ctx context.Context, in *templatemanager.TemplateLayerCacheListRequest) (*templatemanager.TemplateLayerCacheListResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "template-layer-cache-list-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+	))
+	defer childSpan.End()
+
+	if in.GetTemplateID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id is a required field")
+	}
+
+	layers, err := s.layerCacheIndex(in.GetTemplateID(), in.CacheScope).ListLayers(ctx, in.GetTemplateID())
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to list cached layers", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to list cached layers: %s", err)
+	}
+
+	entries := make([]*templatemanager.TemplateLayerCacheEntry, 0, len(layers))
+	for _, layer := range layers {
+		entries = append(entries, &templatemanager.TemplateLayerCacheEntry{
+			Hash:      layer.Hash,
+			BuildID:   layer.Template.BuildID,
+			Step:      layer.Step,
+			CreatedAt: timestamppb.New(layer.CreatedAt),
+			SizeBytes: layer.SizeBytes,
+		})
+	}
+
+	return &templatemanager.TemplateLayerCacheListResponse{Layers: entries}, nil
+}
+
+func (s *ServerStore) TemplateLayerCacheInvalidate(ctx context.Context, in *templatemanager.TemplateLayerCacheInvalidateRequest) (*templatemanager.TemplateLayerCacheInvalidateResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "template-layer-cache-invalidate-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+	))
+	defer childSpan.End()
+
+	if in.GetTemplateID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id is a required field")
+	}
+
+	hashes, err := s.layerCacheIndex(in.GetTemplateID(), in.CacheScope).InvalidateLayers(ctx, in.GetTemplateID(), in.GetHashes())
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to invalidate cached layers", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to invalidate cached layers: %s", err)
+	}
+
+	return &templatemanager.TemplateLayerCacheInvalidateResponse{Hashes: hashes}, nil
+}
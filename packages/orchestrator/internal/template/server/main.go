@@ -41,6 +41,9 @@ type ServerStore struct {
 
 	wg   *sync.WaitGroup // wait group for running builds
 	info *service.ServiceInfo
+
+	// verifyMu serializes the checks of the running verifications
+	verifyMu sync.Mutex
 }
 
 func New(
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/builderrors"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/config"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/provenance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+)
+
+func (s *ServerStore) TemplateBuildProvenance(ctx context.Context, in *templatemanager.TemplateBuildProvenanceRequest) (*templatemanager.TemplateBuildProvenanceResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "template-build-provenance-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	))
+	defer childSpan.End()
+
+	if in.GetTemplateID() == "" || in.GetBuildID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id and build id are required fields")
+	}
+
+	data, err := provenance.Read(ctx, s.templateStorage, storage.TemplateFiles{BuildID: in.GetBuildID()}.StorageProvenancePath())
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, status.Errorf(codes.NotFound, "build %s has no provenance", in.GetBuildID())
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to read build provenance", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to read build provenance: %s", err)
+	}
+
+	return &templatemanager.TemplateBuildProvenanceResponse{Provenance: data}, nil
+}
+
+func (s *ServerStore) TemplateBuildVerification(ctx context.Context, in *templatemanager.TemplateBuildVerificationRequest) (*templatemanager.TemplateBuildVerificationResponse, error) {
+	ctx, childSpan := tracer.Start(ctx, "template-build-verification-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.GetTemplateID()),
+		telemetry.WithBuildID(in.GetBuildID()),
+	))
+	defer childSpan.End()
+
+	if in.GetTemplateID() == "" || in.GetBuildID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id and build id are required fields")
+	}
+
+	data, err := provenance.Read(ctx, s.templateStorage, storage.TemplateFiles{BuildID: in.GetBuildID()}.StorageVerificationPath())
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, status.Errorf(codes.NotFound, "build %s was not verified", in.GetBuildID())
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to read build verification", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to read build verification: %s", err)
+	}
+
+	return &templatemanager.TemplateBuildVerificationResponse{Verification: data}, nil
+}
+
+// TemplateBuildVerify rebuilds the build in the background, the result is saved as the verification of the original build.
+func (s *ServerStore) TemplateBuildVerify(ctx context.Context, in *templatemanager.TemplateBuildVerifyRequest) (*emptypb.Empty, error) {
+	cfg := in.GetTemplate()
+
+	ctx, childSpan := tracer.Start(ctx, "template-build-verify-request", trace.WithAttributes(
+		telemetry.WithTemplateID(cfg.GetTemplateID()),
+		telemetry.WithBuildID(cfg.GetBuildID()),
+	))
+	defer childSpan.End()
+
+	if cfg.GetTemplateID() == "" || cfg.GetBuildID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id and build id are required fields")
+	}
+
+	if s.info.GetStatus() != orchestrator.ServiceInfoStatus_Healthy {
+		s.logger.Error("Requesting template verification while server not healthy is not possible", logger.WithTemplateID(cfg.GetTemplateID()))
+		return nil, fmt.Errorf("server is draining")
+	}
+
+	if cpuArchitecture := consts.CPUArchitectureOrDefault(cfg.GetCpuArchitecture()); cpuArchitecture != fc.HostArchitecture {
+		return nil, status.Errorf(codes.FailedPrecondition, "template architecture %s doesn't match the builder architecture %s", cpuArchitecture, fc.HostArchitecture)
+	}
+
+	original, err := provenance.Get(ctx, s.templateStorage, cfg.GetBuildID())
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, status.Errorf(codes.NotFound, "build %s has no provenance, only the builds with provenance can be verified", cfg.GetBuildID())
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to read build provenance", err)
+
+		return nil, status.Errorf(codes.Internal, "failed to read build provenance: %s", err)
+	}
+
+	cacheScope := cfg.GetTemplateID()
+	if in.CacheScope != nil {
+		cacheScope = in.GetCacheScope()
+	}
+
+	template := templateConfig(cfg, cacheScope)
+	template.Force = utils.ToPtr(true)
+
+	err = pinBase(&template, original)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pin the base of the build: %s", err)
+	}
+
+	// The rebuild runs with the same versions as the original build
+	rebuild := storage.TemplateFiles{
+		BuildID:            uuid.NewString(),
+		KernelVersion:      original.KernelVersion,
+		FirecrackerVersion: original.FirecrackerVersion,
+	}
+
+	verification := provenance.Verification{
+		BuildID:      cfg.GetBuildID(),
+		RebuildID:    rebuild.BuildID,
+		Status:       provenance.VerificationRunning,
+		StartedAt:    time.Now(),
+		IgnoredPaths: provenance.IgnoredPaths,
+	}
+
+	err = s.startVerification(ctx, verification)
+	if err != nil {
+		return nil, err
+	}
+
+	s.wg.Add(1)
+	go func(ctx context.Context) {
+		defer s.wg.Done()
+
+		defer func() {
+			if r := recover(); r != nil {
+				zap.L().Error("recovered from panic in template verification", zap.Any("panic", r), logger.WithTemplateID(cfg.GetTemplateID()), logger.WithBuildID(cfg.GetBuildID()))
+			}
+		}()
+
+		ctx, verifySpan := tracer.Start(ctx, "template-background-verify")
+		defer verifySpan.End()
+
+		ctx, cancel := context.WithTimeout(ctx, provenance.VerificationTimeout)
+		defer cancel()
+
+		verification = s.verifyBuild(ctx, template, rebuild, verification)
+
+		// The result is saved even when the verification timed out
+		err := provenance.UploadVerification(context.WithoutCancel(ctx), s.templateStorage, verification)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to save build verification", err)
+		}
+	}(context.WithoutCancel(ctx))
+
+	return &emptypb.Empty{}, nil
+}
+
+// startVerification saves the running verification, only one verification of the build can run at a time.
+func (s *ServerStore) startVerification(ctx context.Context, verification provenance.Verification) error {
+	s.verifyMu.Lock()
+	defer s.verifyMu.Unlock()
+
+	last, err := provenance.GetVerification(ctx, s.templateStorage, verification.BuildID)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		telemetry.ReportCriticalError(ctx, "failed to read build verification", err)
+
+		return status.Errorf(codes.Internal, "failed to read build verification: %s", err)
+	}
+
+	if err == nil && last.Running() {
+		return status.Errorf(codes.AlreadyExists, "build %s is already being verified", verification.BuildID)
+	}
+
+	err = provenance.UploadVerification(ctx, s.templateStorage, verification)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to save build verification", err)
+
+		return status.Errorf(codes.Internal, "failed to save build verification: %s", err)
+	}
+
+	return nil
+}
+
+// verifyBuild rebuilds the template and compares the rootfs of the rebuild with the original build.
+func (s *ServerStore) verifyBuild(ctx context.Context, template config.TemplateConfig, rebuild storage.TemplateFiles, verification provenance.Verification) provenance.Verification {
+	core := s.buildLogger.Core().With([]zap.Field{
+		{Type: zapcore.StringType, Key: "envID", String: template.TemplateID},
+		{Type: zapcore.StringType, Key: "buildID", String: rebuild.BuildID},
+	})
+
+	_, err := s.builder.Build(ctx, rebuild, template, core, nil)
+	if err != nil {
+		telemetry.ReportError(ctx, "template rebuild failed", err)
+		verification.Finish(provenance.VerificationFailed, fmt.Sprintf("rebuild failed: %s", builderrors.UnwrapUserError(err).GetMessage()))
+
+		return verification
+	}
+
+	// Only the rebuild files are removed, its layers are used by the layer cache like the layers of any other build
+	defer func() {
+		err := s.templateStorage.DeleteObjectsWithPrefix(ctx, rebuild.BuildID)
+		if err != nil {
+			zap.L().Error("failed to remove rebuild files", zap.Error(err), logger.WithBuildID(rebuild.BuildID))
+		}
+	}()
+
+	dir, err := os.MkdirTemp("", "template-verify")
+	if err != nil {
+		verification.Finish(provenance.VerificationFailed, fmt.Sprintf("failed to create verification directory: %s", err))
+
+		return verification
+	}
+	defer os.RemoveAll(dir)
+
+	differences, err := provenance.CompareRootfs(ctx, s.templateStorage, verification.BuildID, rebuild.BuildID, dir)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "failed to compare template rootfs", err)
+		verification.Finish(provenance.VerificationFailed, fmt.Sprintf("failed to compare rootfs: %s", err))
+
+		return verification
+	}
+
+	verification.SetDifferences(differences)
+
+	return verification
+}
+
+// pinBase sets the base images to the digests and the base template to the build the original build used.
+func pinBase(template *config.TemplateConfig, original provenance.Provenance) error {
+	if original.Base.FromBuildID != "" && template.FromTemplate != nil {
+		template.FromTemplate = &templatemanager.FromTemplateConfig{
+			Alias:   template.FromTemplate.GetAlias(),
+			BuildID: original.Base.FromBuildID,
+		}
+	}
+
+	if template.FromImage != "" {
+		image, err := original.Base.PinnedImage()
+		if err != nil {
+			return err
+		}
+
+		template.FromImage = image
+	}
+
+	stages := make(map[string]provenance.Stage, len(original.Stages))
+	for _, stage := range original.Stages {
+		stages[stage.Name] = stage
+	}
+
+	for _, stage := range template.Stages {
+		originalStage, ok := stages[stage.GetName()]
+		if !ok {
+			continue
+		}
+
+		image, err := originalStage.Base.PinnedImage()
+		if err != nil {
+			return err
+		}
+
+		stage.FromImage = image
+	}
+
+	return nil
+}
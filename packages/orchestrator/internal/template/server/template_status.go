@@ -40,11 +40,12 @@ func (s *ServerStore) TemplateBuildStatus(ctx context.Context, in *template_mana
 	result := buildInfo.GetResult()
 	if result == nil {
 		return &template_manager.TemplateBuildStatusResponse{
-			Status:     template_manager.TemplateBuildState_Building,
-			Reason:     nil,
-			Metadata:   nil,
-			Logs:       logs,
-			LogEntries: logEntries,
+			Status:       template_manager.TemplateBuildState_Building,
+			Reason:       nil,
+			Metadata:     nil,
+			Logs:         logs,
+			LogEntries:   logEntries,
+			CacheResults: buildInfo.CacheResults.List(),
 		}, nil
 	}
 
@@ -0,0 +1,13 @@
+package consts
+
+// DefaultCPUArchitecture is used for the templates and nodes without the architecture set, all of them were amd64.
+const DefaultCPUArchitecture = "amd64"
+
+// CPUArchitectureOrDefault returns the architecture, or the default one if it's not set.
+func CPUArchitectureOrDefault(arch string) string {
+	if arch == "" {
+		return DefaultCPUArchitecture
+	}
+
+	return arch
+}
@@ -7,11 +7,13 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
 type SnapshotInfo struct {
@@ -27,7 +29,14 @@ type SnapshotInfo struct {
 	EnvdVersion         string
 	EnvdSecured         bool
 	AllowInternetAccess *bool
+	Network             *schema.SandboxNetworkConfig
 	AutoPause           bool
+	// IdleTimeout in seconds, zero means the sandbox isn't paused when idle.
+	IdleTimeout int64
+	// MemoryLimitMB is the memory available to the sandbox, zero means all the memory of the sandbox.
+	MemoryLimitMB int64
+	// CPUArchitecture of the node where the sandbox runs, the snapshot can be resumed only on the same architecture.
+	CPUArchitecture string
 }
 
 // Check if there exists snapshot with the ID, if yes then return a new
@@ -77,7 +86,7 @@ func (db *DB) NewSnapshotBuild(
 			return nil, fmt.Errorf("failed to create env '%s': %w", snapshotConfig.SandboxID, err)
 		}
 
-		err = tx.
+		snapshotCreate := tx.
 			Snapshot.
 			Create().
 			SetSandboxID(snapshotConfig.SandboxID).
@@ -90,21 +99,35 @@ func (db *DB) NewSnapshotBuild(
 			SetNillableAllowInternetAccess(snapshotConfig.AllowInternetAccess).
 			SetOriginNodeID(originNodeID).
 			SetAutoPause(snapshotConfig.AutoPause).
-			Exec(ctx)
+			SetIdleTimeout(snapshotConfig.IdleTimeout).
+			SetMemoryLimitMB(snapshotConfig.MemoryLimitMB)
+		if snapshotConfig.Network != nil {
+			snapshotCreate.SetNetwork(snapshotConfig.Network)
+		}
+
+		err = snapshotCreate.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
 	} else {
 		e = s.Edges.Env
 		// Update existing snapshot with new metadata and pause time
-		err = tx.
+		snapshotUpdate := tx.
 			Snapshot.
 			UpdateOne(s).
 			SetMetadata(snapshotConfig.Metadata).
 			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
 			SetOriginNodeID(originNodeID).
 			SetAutoPause(snapshotConfig.AutoPause).
-			Exec(ctx)
+			SetIdleTimeout(snapshotConfig.IdleTimeout).
+			SetMemoryLimitMB(snapshotConfig.MemoryLimitMB)
+		if snapshotConfig.Network != nil {
+			snapshotUpdate.SetNetwork(snapshotConfig.Network)
+		} else {
+			snapshotUpdate.ClearNetwork()
+		}
+
+		err = snapshotUpdate.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to update snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
@@ -123,6 +146,7 @@ func (db *DB) NewSnapshotBuild(
 		SetStatus(envbuild.StatusSnapshotting).
 		SetClusterNodeID(originNodeID).
 		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetCPUArchitecture(consts.CPUArchitectureOrDefault(snapshotConfig.CPUArchitecture)).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create env build '%s': %w", snapshotConfig.SandboxID, err)
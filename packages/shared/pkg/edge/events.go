@@ -1,12 +1,15 @@
 package edge
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"google.golang.org/grpc/metadata"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 )
 
 const (
@@ -20,11 +23,13 @@ const (
 	sbxOrchestratorIdHeader   = "orchestrator-id"
 	sbxMaxLengthInHoursHeader = "sandbox-max-length-in-hours"
 	sbxStartTimeHeader        = "sandbox-start-time"
+	sbxIngressHeader          = "sandbox-ingress"
 )
 
 var (
 	ErrSandboxCreationParse = errors.New("failed to parse sandbox creation event metadata")
 	ErrSandboxLifetimeParse = errors.New("failed to parse sandbox max lifetime event metadata")
+	ErrSandboxIngressParse  = errors.New("failed to parse sandbox ingress event metadata")
 )
 
 type SandboxEventFieldMissingError struct {
@@ -42,6 +47,8 @@ type SandboxCatalogCreateEvent struct {
 	OrchestratorID          string
 	SandboxMaxLengthInHours int64
 	SandboxStartTime        time.Time // Formatted as RFC3339 (ISO 8601)
+	// Ingress is optional, nil means all sandbox ports are public
+	Ingress *proxy.Ingress
 }
 
 type SandboxCatalogDeleteEvent struct {
@@ -50,17 +57,23 @@ type SandboxCatalogDeleteEvent struct {
 }
 
 func SerializeSandboxCatalogCreateEvent(e SandboxCatalogCreateEvent) metadata.MD {
-	return metadata.New(
-		map[string]string{
-			EventTypeHeader: CatalogCreateEventType,
+	md := map[string]string{
+		EventTypeHeader: CatalogCreateEventType,
+
+		sbxIdHeader:               e.SandboxID,
+		sbxExecutionIdHeader:      e.ExecutionID,
+		sbxOrchestratorIdHeader:   e.OrchestratorID,
+		sbxStartTimeHeader:        e.SandboxStartTime.Format(time.RFC3339),
+		sbxMaxLengthInHoursHeader: strconv.Itoa(int(e.SandboxMaxLengthInHours)),
+	}
 
-			sbxIdHeader:               e.SandboxID,
-			sbxExecutionIdHeader:      e.ExecutionID,
-			sbxOrchestratorIdHeader:   e.OrchestratorID,
-			sbxStartTimeHeader:        e.SandboxStartTime.Format(time.RFC3339),
-			sbxMaxLengthInHoursHeader: strconv.Itoa(int(e.SandboxMaxLengthInHours)),
-		},
-	)
+	if e.Ingress != nil {
+		// The ingress contains only strings and maps with integer keys, so the marshalling can't fail
+		ingress, _ := json.Marshal(e.Ingress)
+		md[sbxIngressHeader] = string(ingress)
+	}
+
+	return metadata.New(md)
 }
 
 func SerializeSandboxCatalogDeleteEvent(e SandboxCatalogDeleteEvent) metadata.MD {
@@ -110,12 +123,22 @@ func ParseSandboxCatalogCreateEvent(md metadata.MD) (e *SandboxCatalogCreateEven
 		return nil, ErrSandboxCreationParse
 	}
 
+	var ingress *proxy.Ingress
+	if ingressStr, found := getMetadataValue(md, sbxIngressHeader); found {
+		ingress = &proxy.Ingress{}
+		err = json.Unmarshal([]byte(ingressStr), ingress)
+		if err != nil {
+			return nil, ErrSandboxIngressParse
+		}
+	}
+
 	return &SandboxCatalogCreateEvent{
 		SandboxID:               sandboxID,
 		ExecutionID:             executionID,
 		OrchestratorID:          orchestratorID,
 		SandboxMaxLengthInHours: int64(maxLengthInHours),
 		SandboxStartTime:        sandboxStartTime,
+		Ingress:                 ingress,
 	}, nil
 }
 
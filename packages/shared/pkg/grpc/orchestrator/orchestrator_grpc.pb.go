@@ -28,6 +28,9 @@ type SandboxServiceClient interface {
 	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListResponse, error)
 	Delete(ctx context.Context, in *SandboxDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Pause(ctx context.Context, in *SandboxPauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error)
+	VolumeDelete(ctx context.Context, in *VolumeDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Exec(ctx context.Context, in *SandboxExecRequest, opts ...grpc.CallOption) (*SandboxExecResponse, error)
 	ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error)
 }
 
@@ -84,6 +87,33 @@ func (c *sandboxServiceClient) Pause(ctx context.Context, in *SandboxPauseReques
 	return out, nil
 }
 
+func (c *sandboxServiceClient) Fork(ctx context.Context, in *SandboxForkRequest, opts ...grpc.CallOption) (*SandboxForkResponse, error) {
+	out := new(SandboxForkResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Fork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) VolumeDelete(ctx context.Context, in *VolumeDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/SandboxService/VolumeDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) Exec(ctx context.Context, in *SandboxExecRequest, opts ...grpc.CallOption) (*SandboxExecResponse, error) {
+	out := new(SandboxExecResponse)
+	err := c.cc.Invoke(ctx, "/SandboxService/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) ListCachedBuilds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SandboxListCachedBuildsResponse, error) {
 	out := new(SandboxListCachedBuildsResponse)
 	err := c.cc.Invoke(ctx, "/SandboxService/ListCachedBuilds", in, out, opts...)
@@ -102,6 +132,9 @@ type SandboxServiceServer interface {
 	List(context.Context, *emptypb.Empty) (*SandboxListResponse, error)
 	Delete(context.Context, *SandboxDeleteRequest) (*emptypb.Empty, error)
 	Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error)
+	Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error)
+	VolumeDelete(context.Context, *VolumeDeleteRequest) (*emptypb.Empty, error)
+	Exec(context.Context, *SandboxExecRequest) (*SandboxExecResponse, error)
 	ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
@@ -125,6 +158,15 @@ func (UnimplementedSandboxServiceServer) Delete(context.Context, *SandboxDeleteR
 func (UnimplementedSandboxServiceServer) Pause(context.Context, *SandboxPauseRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
+func (UnimplementedSandboxServiceServer) Fork(context.Context, *SandboxForkRequest) (*SandboxForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fork not implemented")
+}
+func (UnimplementedSandboxServiceServer) VolumeDelete(context.Context, *VolumeDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeDelete not implemented")
+}
+func (UnimplementedSandboxServiceServer) Exec(context.Context, *SandboxExecRequest) (*SandboxExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
 func (UnimplementedSandboxServiceServer) ListCachedBuilds(context.Context, *emptypb.Empty) (*SandboxListCachedBuildsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCachedBuilds not implemented")
 }
@@ -231,6 +273,60 @@ func _SandboxService_Pause_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_Fork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Fork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Fork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Fork(ctx, req.(*SandboxForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_VolumeDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).VolumeDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/VolumeDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).VolumeDelete(ctx, req.(*VolumeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SandboxService/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).Exec(ctx, req.(*SandboxExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_ListCachedBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -276,6 +372,18 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _SandboxService_Pause_Handler,
 		},
+		{
+			MethodName: "Fork",
+			Handler:    _SandboxService_Fork_Handler,
+		},
+		{
+			MethodName: "VolumeDelete",
+			Handler:    _SandboxService_VolumeDelete_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _SandboxService_Exec_Handler,
+		},
 		{
 			MethodName: "ListCachedBuilds",
 			Handler:    _SandboxService_ListCachedBuilds_Handler,
@@ -250,6 +250,15 @@ type TemplateStep struct {
 	Args      []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	Force     *bool    `protobuf:"varint,3,opt,name=force,proto3,oneof" json:"force,omitempty"`
 	FilesHash *string  `protobuf:"bytes,4,opt,name=filesHash,proto3,oneof" json:"filesHash,omitempty"`
+	// Stage the files are copied from, only used by the COPY step
+	FromStage *string `protobuf:"bytes,5,opt,name=fromStage,proto3,oneof" json:"fromStage,omitempty"`
+	// Mounts available only while the command runs, only used by the RUN step
+	Mounts []*TemplateStepMount `protobuf:"bytes,6,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	// Maximum duration of the step in seconds, only used by the RUN step
+	TimeoutSeconds *int32 `protobuf:"varint,7,opt,name=timeoutSeconds,proto3,oneof" json:"timeoutSeconds,omitempty"`
+	// Resources of the step sandbox, they override the build resources of the template
+	VCpuCount *int32 `protobuf:"varint,8,opt,name=vCpuCount,proto3,oneof" json:"vCpuCount,omitempty"`
+	MemoryMB  *int32 `protobuf:"varint,9,opt,name=memoryMB,proto3,oneof" json:"memoryMB,omitempty"`
 }
 
 func (x *TemplateStep) Reset() {
@@ -312,6 +321,190 @@ func (x *TemplateStep) GetFilesHash() string {
 	return ""
 }
 
+func (x *TemplateStep) GetFromStage() string {
+	if x != nil && x.FromStage != nil {
+		return *x.FromStage
+	}
+	return ""
+}
+
+func (x *TemplateStep) GetMounts() []*TemplateStepMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *TemplateStep) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TemplateStep) GetVCpuCount() int32 {
+	if x != nil && x.VCpuCount != nil {
+		return *x.VCpuCount
+	}
+	return 0
+}
+
+func (x *TemplateStep) GetMemoryMB() int32 {
+	if x != nil && x.MemoryMB != nil {
+		return *x.MemoryMB
+	}
+	return 0
+}
+
+// Mount of the RUN step, its content is never saved in the layer
+type TemplateStepMount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Mount type, either "secret" or "cache"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Secret name, or the key of the cache shared between the builds
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Path of the secret file or of the cache directory
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Environment variable the secret is exposed in instead of the file
+	Env *string `protobuf:"bytes,4,opt,name=env,proto3,oneof" json:"env,omitempty"`
+	// The step fails if the secret is not provided
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *TemplateStepMount) Reset() {
+	*x = TemplateStepMount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateStepMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateStepMount) ProtoMessage() {}
+
+func (x *TemplateStepMount) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateStepMount.ProtoReflect.Descriptor instead.
+func (*TemplateStepMount) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TemplateStepMount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TemplateStepMount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TemplateStepMount) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TemplateStepMount) GetEnv() string {
+	if x != nil && x.Env != nil {
+		return *x.Env
+	}
+	return ""
+}
+
+func (x *TemplateStepMount) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// Named build stage, its layers are built before the template and only the files copied from it end up in the template
+type TemplateStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromImage string          `protobuf:"bytes,2,opt,name=fromImage,proto3" json:"fromImage,omitempty"`
+	Steps     []*TemplateStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *TemplateStage) Reset() {
+	*x = TemplateStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateStage) ProtoMessage() {}
+
+func (x *TemplateStage) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateStage.ProtoReflect.Descriptor instead.
+func (*TemplateStage) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TemplateStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateStage) GetFromImage() string {
+	if x != nil {
+		return x.FromImage
+	}
+	return ""
+}
+
+func (x *TemplateStage) GetSteps() []*TemplateStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
 type FromTemplateConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -324,7 +517,7 @@ type FromTemplateConfig struct {
 func (x *FromTemplateConfig) Reset() {
 	*x = FromTemplateConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[3]
+		mi := &file_template_manager_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +530,7 @@ func (x *FromTemplateConfig) String() string {
 func (*FromTemplateConfig) ProtoMessage() {}
 
 func (x *FromTemplateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[3]
+	mi := &file_template_manager_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +543,7 @@ func (x *FromTemplateConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromTemplateConfig.ProtoReflect.Descriptor instead.
 func (*FromTemplateConfig) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{3}
+	return file_template_manager_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FromTemplateConfig) GetAlias() string {
@@ -381,7 +574,7 @@ type AWSRegistry struct {
 func (x *AWSRegistry) Reset() {
 	*x = AWSRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[4]
+		mi := &file_template_manager_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +587,7 @@ func (x *AWSRegistry) String() string {
 func (*AWSRegistry) ProtoMessage() {}
 
 func (x *AWSRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[4]
+	mi := &file_template_manager_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +600,7 @@ func (x *AWSRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AWSRegistry.ProtoReflect.Descriptor instead.
 func (*AWSRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{4}
+	return file_template_manager_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AWSRegistry) GetAwsAccessKeyId() string {
@@ -443,7 +636,7 @@ type GCPRegistry struct {
 func (x *GCPRegistry) Reset() {
 	*x = GCPRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[5]
+		mi := &file_template_manager_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -456,7 +649,7 @@ func (x *GCPRegistry) String() string {
 func (*GCPRegistry) ProtoMessage() {}
 
 func (x *GCPRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[5]
+	mi := &file_template_manager_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +662,7 @@ func (x *GCPRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCPRegistry.ProtoReflect.Descriptor instead.
 func (*GCPRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{5}
+	return file_template_manager_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GCPRegistry) GetServiceAccountJson() string {
@@ -492,7 +685,7 @@ type GeneralRegistry struct {
 func (x *GeneralRegistry) Reset() {
 	*x = GeneralRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[6]
+		mi := &file_template_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +698,7 @@ func (x *GeneralRegistry) String() string {
 func (*GeneralRegistry) ProtoMessage() {}
 
 func (x *GeneralRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[6]
+	mi := &file_template_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +711,7 @@ func (x *GeneralRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneralRegistry.ProtoReflect.Descriptor instead.
 func (*GeneralRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{6}
+	return file_template_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GeneralRegistry) GetUsername() string {
@@ -552,7 +745,7 @@ type FromImageRegistry struct {
 func (x *FromImageRegistry) Reset() {
 	*x = FromImageRegistry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[7]
+		mi := &file_template_manager_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -565,7 +758,7 @@ func (x *FromImageRegistry) String() string {
 func (*FromImageRegistry) ProtoMessage() {}
 
 func (x *FromImageRegistry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[7]
+	mi := &file_template_manager_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +771,7 @@ func (x *FromImageRegistry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromImageRegistry.ProtoReflect.Descriptor instead.
 func (*FromImageRegistry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{7}
+	return file_template_manager_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *FromImageRegistry) GetType() isFromImageRegistry_Type {
@@ -655,12 +848,20 @@ type TemplateConfig struct {
 	Source            isTemplateConfig_Source `protobuf_oneof:"source"`
 	FromImageRegistry *FromImageRegistry      `protobuf:"bytes,15,opt,name=fromImageRegistry,proto3,oneof" json:"fromImageRegistry,omitempty"`
 	TeamID            string                  `protobuf:"bytes,16,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	Stages            []*TemplateStage        `protobuf:"bytes,17,rep,name=stages,proto3" json:"stages,omitempty"`
+	// Secrets for the RUN step mounts, they are not persisted anywhere
+	Secrets map[string]string `protobuf:"bytes,18,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// CPU architecture of the template, it's built only on a node with the same architecture. Empty means amd64.
+	CpuArchitecture string `protobuf:"bytes,19,opt,name=cpuArchitecture,proto3" json:"cpuArchitecture,omitempty"`
+	// Resources of the build sandboxes, the runtime resources are used when not set
+	BuildVCpuCount *int32 `protobuf:"varint,20,opt,name=buildVCpuCount,proto3,oneof" json:"buildVCpuCount,omitempty"`
+	BuildMemoryMB  *int32 `protobuf:"varint,21,opt,name=buildMemoryMB,proto3,oneof" json:"buildMemoryMB,omitempty"`
 }
 
 func (x *TemplateConfig) Reset() {
 	*x = TemplateConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[8]
+		mi := &file_template_manager_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +874,7 @@ func (x *TemplateConfig) String() string {
 func (*TemplateConfig) ProtoMessage() {}
 
 func (x *TemplateConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[8]
+	mi := &file_template_manager_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +887,7 @@ func (x *TemplateConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateConfig.ProtoReflect.Descriptor instead.
 func (*TemplateConfig) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{8}
+	return file_template_manager_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TemplateConfig) GetTemplateID() string {
@@ -808,6 +1009,41 @@ func (x *TemplateConfig) GetTeamID() string {
 	return ""
 }
 
+func (x *TemplateConfig) GetStages() []*TemplateStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *TemplateConfig) GetCpuArchitecture() string {
+	if x != nil {
+		return x.CpuArchitecture
+	}
+	return ""
+}
+
+func (x *TemplateConfig) GetBuildVCpuCount() int32 {
+	if x != nil && x.BuildVCpuCount != nil {
+		return *x.BuildVCpuCount
+	}
+	return 0
+}
+
+func (x *TemplateConfig) GetBuildMemoryMB() int32 {
+	if x != nil && x.BuildMemoryMB != nil {
+		return *x.BuildMemoryMB
+	}
+	return 0
+}
+
 type isTemplateConfig_Source interface {
 	isTemplateConfig_Source()
 }
@@ -836,7 +1072,7 @@ type TemplateCreateRequest struct {
 func (x *TemplateCreateRequest) Reset() {
 	*x = TemplateCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[9]
+		mi := &file_template_manager_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +1085,7 @@ func (x *TemplateCreateRequest) String() string {
 func (*TemplateCreateRequest) ProtoMessage() {}
 
 func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[9]
+	mi := &file_template_manager_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +1098,7 @@ func (x *TemplateCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateCreateRequest.ProtoReflect.Descriptor instead.
 func (*TemplateCreateRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{9}
+	return file_template_manager_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TemplateCreateRequest) GetTemplate() *TemplateConfig {
@@ -893,7 +1129,7 @@ type TemplateStatusRequest struct {
 func (x *TemplateStatusRequest) Reset() {
 	*x = TemplateStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[10]
+		mi := &file_template_manager_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -906,7 +1142,7 @@ func (x *TemplateStatusRequest) String() string {
 func (*TemplateStatusRequest) ProtoMessage() {}
 
 func (x *TemplateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[10]
+	mi := &file_template_manager_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1155,7 @@ func (x *TemplateStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateStatusRequest.ProtoReflect.Descriptor instead.
 func (*TemplateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{10}
+	return file_template_manager_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TemplateStatusRequest) GetTemplateID() string {
@@ -963,7 +1199,7 @@ type TemplateBuildDeleteRequest struct {
 func (x *TemplateBuildDeleteRequest) Reset() {
 	*x = TemplateBuildDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[11]
+		mi := &file_template_manager_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -976,7 +1212,7 @@ func (x *TemplateBuildDeleteRequest) String() string {
 func (*TemplateBuildDeleteRequest) ProtoMessage() {}
 
 func (x *TemplateBuildDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[11]
+	mi := &file_template_manager_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,7 +1225,7 @@ func (x *TemplateBuildDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildDeleteRequest.ProtoReflect.Descriptor instead.
 func (*TemplateBuildDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{11}
+	return file_template_manager_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TemplateBuildDeleteRequest) GetBuildID() string {
@@ -1018,7 +1254,7 @@ type TemplateBuildMetadata struct {
 func (x *TemplateBuildMetadata) Reset() {
 	*x = TemplateBuildMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[12]
+		mi := &file_template_manager_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1031,7 +1267,7 @@ func (x *TemplateBuildMetadata) String() string {
 func (*TemplateBuildMetadata) ProtoMessage() {}
 
 func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[12]
+	mi := &file_template_manager_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1280,7 @@ func (x *TemplateBuildMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildMetadata.ProtoReflect.Descriptor instead.
 func (*TemplateBuildMetadata) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{12}
+	return file_template_manager_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TemplateBuildMetadata) GetRootfsSizeKey() int32 {
@@ -1075,7 +1311,7 @@ type TemplateBuildLogEntry struct {
 func (x *TemplateBuildLogEntry) Reset() {
 	*x = TemplateBuildLogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[13]
+		mi := &file_template_manager_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1088,7 +1324,7 @@ func (x *TemplateBuildLogEntry) String() string {
 func (*TemplateBuildLogEntry) ProtoMessage() {}
 
 func (x *TemplateBuildLogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[13]
+	mi := &file_template_manager_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1337,7 @@ func (x *TemplateBuildLogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildLogEntry.ProtoReflect.Descriptor instead.
 func (*TemplateBuildLogEntry) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{13}
+	return file_template_manager_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TemplateBuildLogEntry) GetTimestamp() *timestamppb.Timestamp {
@@ -1144,7 +1380,7 @@ type TemplateBuildStatusReason struct {
 func (x *TemplateBuildStatusReason) Reset() {
 	*x = TemplateBuildStatusReason{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[14]
+		mi := &file_template_manager_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1157,7 +1393,7 @@ func (x *TemplateBuildStatusReason) String() string {
 func (*TemplateBuildStatusReason) ProtoMessage() {}
 
 func (x *TemplateBuildStatusReason) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[14]
+	mi := &file_template_manager_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +1406,7 @@ func (x *TemplateBuildStatusReason) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateBuildStatusReason.ProtoReflect.Descriptor instead.
 func (*TemplateBuildStatusReason) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{14}
+	return file_template_manager_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TemplateBuildStatusReason) GetMessage() string {
@@ -1187,36 +1423,38 @@ func (x *TemplateBuildStatusReason) GetStep() string {
 	return ""
 }
 
-// Logs from template build
-type TemplateBuildStatusResponse struct {
+// Layer cache result of a build step
+type TemplateBuildStepCacheResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     TemplateBuildState         `protobuf:"varint,1,opt,name=status,proto3,enum=TemplateBuildState" json:"status,omitempty"`
-	Metadata   *TemplateBuildMetadata     `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Logs       []string                   `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
-	LogEntries []*TemplateBuildLogEntry   `protobuf:"bytes,5,rep,name=logEntries,proto3" json:"logEntries,omitempty"`
-	Reason     *TemplateBuildStatusReason `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Phase      string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	StepNumber *int32 `protobuf:"varint,2,opt,name=stepNumber,proto3,oneof" json:"stepNumber,omitempty"`
+	StepType   string `protobuf:"bytes,3,opt,name=stepType,proto3" json:"stepType,omitempty"`
+	// Name of the build stage, empty for the template itself
+	Stage  *string `protobuf:"bytes,4,opt,name=stage,proto3,oneof" json:"stage,omitempty"`
+	Hash   string  `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	Cached bool    `protobuf:"varint,6,opt,name=cached,proto3" json:"cached,omitempty"`
 }
 
-func (x *TemplateBuildStatusResponse) Reset() {
-	*x = TemplateBuildStatusResponse{}
+func (x *TemplateBuildStepCacheResult) Reset() {
+	*x = TemplateBuildStepCacheResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_template_manager_proto_msgTypes[15]
+		mi := &file_template_manager_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TemplateBuildStatusResponse) String() string {
+func (x *TemplateBuildStepCacheResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TemplateBuildStatusResponse) ProtoMessage() {}
+func (*TemplateBuildStepCacheResult) ProtoMessage() {}
 
-func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_template_manager_proto_msgTypes[15]
+func (x *TemplateBuildStepCacheResult) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,47 +1465,817 @@ func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TemplateBuildStatusResponse.ProtoReflect.Descriptor instead.
-func (*TemplateBuildStatusResponse) Descriptor() ([]byte, []int) {
-	return file_template_manager_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TemplateBuildStepCacheResult.ProtoReflect.Descriptor instead.
+func (*TemplateBuildStepCacheResult) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TemplateBuildStatusResponse) GetStatus() TemplateBuildState {
+func (x *TemplateBuildStepCacheResult) GetPhase() string {
 	if x != nil {
-		return x.Status
+		return x.Phase
 	}
-	return TemplateBuildState_Building
+	return ""
 }
 
-func (x *TemplateBuildStatusResponse) GetMetadata() *TemplateBuildMetadata {
-	if x != nil {
-		return x.Metadata
+func (x *TemplateBuildStepCacheResult) GetStepNumber() int32 {
+	if x != nil && x.StepNumber != nil {
+		return *x.StepNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *TemplateBuildStatusResponse) GetLogs() []string {
+func (x *TemplateBuildStepCacheResult) GetStepType() string {
 	if x != nil {
-		return x.Logs
+		return x.StepType
 	}
-	return nil
+	return ""
 }
 
-func (x *TemplateBuildStatusResponse) GetLogEntries() []*TemplateBuildLogEntry {
+func (x *TemplateBuildStepCacheResult) GetStage() string {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return ""
+}
+
+func (x *TemplateBuildStepCacheResult) GetHash() string {
 	if x != nil {
-		return x.LogEntries
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *TemplateBuildStatusResponse) GetReason() *TemplateBuildStatusReason {
+func (x *TemplateBuildStepCacheResult) GetCached() bool {
 	if x != nil {
-		return x.Reason
+		return x.Cached
 	}
-	return nil
+	return false
 }
 
-var File_template_manager_proto protoreflect.FileDescriptor
+// Logs from template build
+type TemplateBuildStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       TemplateBuildState              `protobuf:"varint,1,opt,name=status,proto3,enum=TemplateBuildState" json:"status,omitempty"`
+	Metadata     *TemplateBuildMetadata          `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Logs         []string                        `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	LogEntries   []*TemplateBuildLogEntry        `protobuf:"bytes,5,rep,name=logEntries,proto3" json:"logEntries,omitempty"`
+	Reason       *TemplateBuildStatusReason      `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CacheResults []*TemplateBuildStepCacheResult `protobuf:"bytes,7,rep,name=cacheResults,proto3" json:"cacheResults,omitempty"`
+}
+
+func (x *TemplateBuildStatusResponse) Reset() {
+	*x = TemplateBuildStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildStatusResponse) ProtoMessage() {}
+
+func (x *TemplateBuildStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildStatusResponse.ProtoReflect.Descriptor instead.
+func (*TemplateBuildStatusResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TemplateBuildStatusResponse) GetStatus() TemplateBuildState {
+	if x != nil {
+		return x.Status
+	}
+	return TemplateBuildState_Building
+}
+
+func (x *TemplateBuildStatusResponse) GetMetadata() *TemplateBuildMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TemplateBuildStatusResponse) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *TemplateBuildStatusResponse) GetLogEntries() []*TemplateBuildLogEntry {
+	if x != nil {
+		return x.LogEntries
+	}
+	return nil
+}
+
+func (x *TemplateBuildStatusResponse) GetReason() *TemplateBuildStatusReason {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+func (x *TemplateBuildStatusResponse) GetCacheResults() []*TemplateBuildStepCacheResult {
+	if x != nil {
+		return x.CacheResults
+	}
+	return nil
+}
+
+type TemplateLayerCacheListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID string  `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	CacheScope *string `protobuf:"bytes,2,opt,name=cacheScope,proto3,oneof" json:"cacheScope,omitempty"`
+}
+
+func (x *TemplateLayerCacheListRequest) Reset() {
+	*x = TemplateLayerCacheListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateLayerCacheListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLayerCacheListRequest) ProtoMessage() {}
+
+func (x *TemplateLayerCacheListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLayerCacheListRequest.ProtoReflect.Descriptor instead.
+func (*TemplateLayerCacheListRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TemplateLayerCacheListRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheListRequest) GetCacheScope() string {
+	if x != nil && x.CacheScope != nil {
+		return *x.CacheScope
+	}
+	return ""
+}
+
+type TemplateLayerCacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	BuildID   string                 `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	Step      string                 `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SizeBytes int64                  `protobuf:"varint,5,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+}
+
+func (x *TemplateLayerCacheEntry) Reset() {
+	*x = TemplateLayerCacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateLayerCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLayerCacheEntry) ProtoMessage() {}
+
+func (x *TemplateLayerCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLayerCacheEntry.ProtoReflect.Descriptor instead.
+func (*TemplateLayerCacheEntry) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TemplateLayerCacheEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheEntry) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheEntry) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TemplateLayerCacheEntry) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type TemplateLayerCacheListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Layers []*TemplateLayerCacheEntry `protobuf:"bytes,1,rep,name=layers,proto3" json:"layers,omitempty"`
+}
+
+func (x *TemplateLayerCacheListResponse) Reset() {
+	*x = TemplateLayerCacheListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateLayerCacheListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLayerCacheListResponse) ProtoMessage() {}
+
+func (x *TemplateLayerCacheListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLayerCacheListResponse.ProtoReflect.Descriptor instead.
+func (*TemplateLayerCacheListResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TemplateLayerCacheListResponse) GetLayers() []*TemplateLayerCacheEntry {
+	if x != nil {
+		return x.Layers
+	}
+	return nil
+}
+
+type TemplateLayerCacheInvalidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID string  `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	CacheScope *string `protobuf:"bytes,2,opt,name=cacheScope,proto3,oneof" json:"cacheScope,omitempty"`
+	// Hashes of the layers to invalidate, all the layers of the template are invalidated when empty
+	Hashes []string `protobuf:"bytes,3,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *TemplateLayerCacheInvalidateRequest) Reset() {
+	*x = TemplateLayerCacheInvalidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateLayerCacheInvalidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLayerCacheInvalidateRequest) ProtoMessage() {}
+
+func (x *TemplateLayerCacheInvalidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLayerCacheInvalidateRequest.ProtoReflect.Descriptor instead.
+func (*TemplateLayerCacheInvalidateRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TemplateLayerCacheInvalidateRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheInvalidateRequest) GetCacheScope() string {
+	if x != nil && x.CacheScope != nil {
+		return *x.CacheScope
+	}
+	return ""
+}
+
+func (x *TemplateLayerCacheInvalidateRequest) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type TemplateLayerCacheInvalidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *TemplateLayerCacheInvalidateResponse) Reset() {
+	*x = TemplateLayerCacheInvalidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateLayerCacheInvalidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLayerCacheInvalidateResponse) ProtoMessage() {}
+
+func (x *TemplateLayerCacheInvalidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLayerCacheInvalidateResponse.ProtoReflect.Descriptor instead.
+func (*TemplateLayerCacheInvalidateResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TemplateLayerCacheInvalidateResponse) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type TemplateBuildExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID      string `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID         string `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	CpuArchitecture string `protobuf:"bytes,3,opt,name=cpuArchitecture,proto3" json:"cpuArchitecture,omitempty"`
+	// Tag of the exported image, defaults to <templateID>:<buildID>
+	Tag *string `protobuf:"bytes,4,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+}
+
+func (x *TemplateBuildExportRequest) Reset() {
+	*x = TemplateBuildExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildExportRequest) ProtoMessage() {}
+
+func (x *TemplateBuildExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildExportRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildExportRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TemplateBuildExportRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateBuildExportRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+func (x *TemplateBuildExportRequest) GetCpuArchitecture() string {
+	if x != nil {
+		return x.CpuArchitecture
+	}
+	return ""
+}
+
+func (x *TemplateBuildExportRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+// Chunk of the exported OCI image tarball
+type TemplateBuildExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TemplateBuildExportResponse) Reset() {
+	*x = TemplateBuildExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildExportResponse) ProtoMessage() {}
+
+func (x *TemplateBuildExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildExportResponse.ProtoReflect.Descriptor instead.
+func (*TemplateBuildExportResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TemplateBuildExportResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Interface exported by the server.
+type TemplateBuildProvenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID string `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID    string `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+}
+
+func (x *TemplateBuildProvenanceRequest) Reset() {
+	*x = TemplateBuildProvenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildProvenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildProvenanceRequest) ProtoMessage() {}
+
+func (x *TemplateBuildProvenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildProvenanceRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildProvenanceRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TemplateBuildProvenanceRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateBuildProvenanceRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type TemplateBuildProvenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON provenance document
+	Provenance []byte `protobuf:"bytes,1,opt,name=provenance,proto3" json:"provenance,omitempty"`
+}
+
+func (x *TemplateBuildProvenanceResponse) Reset() {
+	*x = TemplateBuildProvenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildProvenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildProvenanceResponse) ProtoMessage() {}
+
+func (x *TemplateBuildProvenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildProvenanceResponse.ProtoReflect.Descriptor instead.
+func (*TemplateBuildProvenanceResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TemplateBuildProvenanceResponse) GetProvenance() []byte {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type TemplateBuildVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Configuration of the verified build, the build ID is the ID of the original build
+	Template   *TemplateConfig `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	CacheScope *string         `protobuf:"bytes,2,opt,name=cacheScope,proto3,oneof" json:"cacheScope,omitempty"`
+}
+
+func (x *TemplateBuildVerifyRequest) Reset() {
+	*x = TemplateBuildVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildVerifyRequest) ProtoMessage() {}
+
+func (x *TemplateBuildVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildVerifyRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TemplateBuildVerifyRequest) GetTemplate() *TemplateConfig {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *TemplateBuildVerifyRequest) GetCacheScope() string {
+	if x != nil && x.CacheScope != nil {
+		return *x.CacheScope
+	}
+	return ""
+}
+
+type TemplateBuildVerificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID string `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	BuildID    string `protobuf:"bytes,2,opt,name=buildID,proto3" json:"buildID,omitempty"`
+}
+
+func (x *TemplateBuildVerificationRequest) Reset() {
+	*x = TemplateBuildVerificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildVerificationRequest) ProtoMessage() {}
+
+func (x *TemplateBuildVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildVerificationRequest.ProtoReflect.Descriptor instead.
+func (*TemplateBuildVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TemplateBuildVerificationRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *TemplateBuildVerificationRequest) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type TemplateBuildVerificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON verification document
+	Verification []byte `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+}
+
+func (x *TemplateBuildVerificationResponse) Reset() {
+	*x = TemplateBuildVerificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_template_manager_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateBuildVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateBuildVerificationResponse) ProtoMessage() {}
+
+func (x *TemplateBuildVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_template_manager_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateBuildVerificationResponse.ProtoReflect.Descriptor instead.
+func (*TemplateBuildVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_template_manager_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TemplateBuildVerificationResponse) GetVerification() []byte {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+var File_template_manager_proto protoreflect.FileDescriptor
 
 var file_template_manager_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67,
@@ -1289,187 +2297,370 @@ var file_template_manager_proto_rawDesc = []byte{
 	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
 	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a,
-	0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x88, 0x03, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
 	0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
 	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x19,
 	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
 	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x69, 0x6c,
 	0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x06, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x4d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x76, 0x43, 0x70, 0x75,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x76,
+	0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52,
+	0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
 	0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x48, 0x61, 0x73, 0x68, 0x22, 0x44, 0x0a, 0x12, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x41,
-	0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x77,
-	0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x61, 0x77, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x22, 0x3d, 0x0a, 0x0b, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12,
-	0x2e, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
-	0x49, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x46,
+	0x48, 0x61, 0x73, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42,
+	0x22, 0x8a, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x76, 0x22, 0x66, 0x0a,
+	0x0d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x0b,
+	0x41, 0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x77, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x77, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x61, 0x77, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x77, 0x73, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x73, 0x6f, 0x6e,
+	0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11,
+	0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x12, 0x20, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x41, 0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03,
+	0x61, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x03, 0x67, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc7, 0x07, 0x0a, 0x0e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x4d, 0x42, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x65,
+	0x63, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19,
+	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1e,
+	0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39,
+	0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x66, 0x72, 0x6f,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x02, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x36, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x70, 0x75, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x43, 0x70, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x56, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66,
 	0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x12, 0x20, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x41, 0x57, 0x53, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x61,
-	0x77, 0x73, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x47, 0x43, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52,
-	0x03, 0x67, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x84, 0x05, 0x0a, 0x0e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x43, 0x70, 0x75, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0xa9, 0x01, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1a, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x44, 0x22, 0x65, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x57, 0x0a, 0x19, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x22, 0xd5, 0x01, 0x0a, 0x1c, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x65, 0x70, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x22, 0xd7, 0x02, 0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x65,
+	0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x73, 0x0a, 0x1d, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0a,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x22, 0xb3, 0x01, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x1e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x23, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x3e,
+	0x0a, 0x24, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x9f,
+	0x01, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
 	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
 	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x43, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4d,
-	0x42, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63,
-	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x69, 0x72, 0x65, 0x63, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x68,
-	0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1e, 0x0a,
-	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a,
-	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x02, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x22, 0x78, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x74, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63,
-	0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x15,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x70, 0x75, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x70, 0x75, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67,
+	0x22, 0x31, 0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x1e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22,
+	0x41, 0x0a, 0x1f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x7d, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x22, 0x5c, 0x0a, 0x20, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
 	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
 	0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12,
-	0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88,
-	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1a, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x12,
-	0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x22,
-	0x65, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74,
-	0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x26,
-	0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x83, 0x02, 0x0a, 0x15, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x19,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x22, 0x94, 0x02, 0x0a, 0x1b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4c, 0x6f,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x2a, 0x34, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x12, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10,
-	0x02, 0x32, 0xbe, 0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x50, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c,
-	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61,
-	0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72,
-	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x32, 0x62, 0x2d, 0x64, 0x65, 0x76,
-	0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22,
+	0x47, 0x0a, 0x21, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x34, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x61, 0x72,
+	0x6e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x2a, 0x3d,
+	0x0a, 0x12, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x32, 0xe8, 0x06,
+	0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x13,
+	0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x1b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x59, 0x0a, 0x16, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x1c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x1b, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x62, 0x0a, 0x19, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65,
+	0x32, 0x62, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1485,59 +2676,95 @@ func file_template_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_template_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_template_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_template_manager_proto_goTypes = []interface{}{
-	(LogLevel)(0),                       // 0: LogLevel
-	(TemplateBuildState)(0),             // 1: TemplateBuildState
-	(*InitLayerFileUploadRequest)(nil),  // 2: InitLayerFileUploadRequest
-	(*InitLayerFileUploadResponse)(nil), // 3: InitLayerFileUploadResponse
-	(*TemplateStep)(nil),                // 4: TemplateStep
-	(*FromTemplateConfig)(nil),          // 5: FromTemplateConfig
-	(*AWSRegistry)(nil),                 // 6: AWSRegistry
-	(*GCPRegistry)(nil),                 // 7: GCPRegistry
-	(*GeneralRegistry)(nil),             // 8: GeneralRegistry
-	(*FromImageRegistry)(nil),           // 9: FromImageRegistry
-	(*TemplateConfig)(nil),              // 10: TemplateConfig
-	(*TemplateCreateRequest)(nil),       // 11: TemplateCreateRequest
-	(*TemplateStatusRequest)(nil),       // 12: TemplateStatusRequest
-	(*TemplateBuildDeleteRequest)(nil),  // 13: TemplateBuildDeleteRequest
-	(*TemplateBuildMetadata)(nil),       // 14: TemplateBuildMetadata
-	(*TemplateBuildLogEntry)(nil),       // 15: TemplateBuildLogEntry
-	(*TemplateBuildStatusReason)(nil),   // 16: TemplateBuildStatusReason
-	(*TemplateBuildStatusResponse)(nil), // 17: TemplateBuildStatusResponse
-	nil,                                 // 18: TemplateBuildLogEntry.FieldsEntry
-	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 20: google.protobuf.Empty
+	(LogLevel)(0),                                // 0: LogLevel
+	(TemplateBuildState)(0),                      // 1: TemplateBuildState
+	(*InitLayerFileUploadRequest)(nil),           // 2: InitLayerFileUploadRequest
+	(*InitLayerFileUploadResponse)(nil),          // 3: InitLayerFileUploadResponse
+	(*TemplateStep)(nil),                         // 4: TemplateStep
+	(*TemplateStepMount)(nil),                    // 5: TemplateStepMount
+	(*TemplateStage)(nil),                        // 6: TemplateStage
+	(*FromTemplateConfig)(nil),                   // 7: FromTemplateConfig
+	(*AWSRegistry)(nil),                          // 8: AWSRegistry
+	(*GCPRegistry)(nil),                          // 9: GCPRegistry
+	(*GeneralRegistry)(nil),                      // 10: GeneralRegistry
+	(*FromImageRegistry)(nil),                    // 11: FromImageRegistry
+	(*TemplateConfig)(nil),                       // 12: TemplateConfig
+	(*TemplateCreateRequest)(nil),                // 13: TemplateCreateRequest
+	(*TemplateStatusRequest)(nil),                // 14: TemplateStatusRequest
+	(*TemplateBuildDeleteRequest)(nil),           // 15: TemplateBuildDeleteRequest
+	(*TemplateBuildMetadata)(nil),                // 16: TemplateBuildMetadata
+	(*TemplateBuildLogEntry)(nil),                // 17: TemplateBuildLogEntry
+	(*TemplateBuildStatusReason)(nil),            // 18: TemplateBuildStatusReason
+	(*TemplateBuildStepCacheResult)(nil),         // 19: TemplateBuildStepCacheResult
+	(*TemplateBuildStatusResponse)(nil),          // 20: TemplateBuildStatusResponse
+	(*TemplateLayerCacheListRequest)(nil),        // 21: TemplateLayerCacheListRequest
+	(*TemplateLayerCacheEntry)(nil),              // 22: TemplateLayerCacheEntry
+	(*TemplateLayerCacheListResponse)(nil),       // 23: TemplateLayerCacheListResponse
+	(*TemplateLayerCacheInvalidateRequest)(nil),  // 24: TemplateLayerCacheInvalidateRequest
+	(*TemplateLayerCacheInvalidateResponse)(nil), // 25: TemplateLayerCacheInvalidateResponse
+	(*TemplateBuildExportRequest)(nil),           // 26: TemplateBuildExportRequest
+	(*TemplateBuildExportResponse)(nil),          // 27: TemplateBuildExportResponse
+	(*TemplateBuildProvenanceRequest)(nil),       // 28: TemplateBuildProvenanceRequest
+	(*TemplateBuildProvenanceResponse)(nil),      // 29: TemplateBuildProvenanceResponse
+	(*TemplateBuildVerifyRequest)(nil),           // 30: TemplateBuildVerifyRequest
+	(*TemplateBuildVerificationRequest)(nil),     // 31: TemplateBuildVerificationRequest
+	(*TemplateBuildVerificationResponse)(nil),    // 32: TemplateBuildVerificationResponse
+	nil,                           // 33: TemplateConfig.SecretsEntry
+	nil,                           // 34: TemplateBuildLogEntry.FieldsEntry
+	(*timestamppb.Timestamp)(nil), // 35: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 36: google.protobuf.Empty
 }
 var file_template_manager_proto_depIdxs = []int32{
-	6,  // 0: FromImageRegistry.aws:type_name -> AWSRegistry
-	7,  // 1: FromImageRegistry.gcp:type_name -> GCPRegistry
-	8,  // 2: FromImageRegistry.general:type_name -> GeneralRegistry
-	4,  // 3: TemplateConfig.steps:type_name -> TemplateStep
-	5,  // 4: TemplateConfig.fromTemplate:type_name -> FromTemplateConfig
-	9,  // 5: TemplateConfig.fromImageRegistry:type_name -> FromImageRegistry
-	10, // 6: TemplateCreateRequest.template:type_name -> TemplateConfig
-	0,  // 7: TemplateStatusRequest.level:type_name -> LogLevel
-	19, // 8: TemplateBuildLogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 9: TemplateBuildLogEntry.level:type_name -> LogLevel
-	18, // 10: TemplateBuildLogEntry.fields:type_name -> TemplateBuildLogEntry.FieldsEntry
-	1,  // 11: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
-	14, // 12: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
-	15, // 13: TemplateBuildStatusResponse.logEntries:type_name -> TemplateBuildLogEntry
-	16, // 14: TemplateBuildStatusResponse.reason:type_name -> TemplateBuildStatusReason
-	11, // 15: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
-	12, // 16: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
-	13, // 17: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
-	2,  // 18: TemplateService.InitLayerFileUpload:input_type -> InitLayerFileUploadRequest
-	20, // 19: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
-	17, // 20: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
-	20, // 21: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
-	3,  // 22: TemplateService.InitLayerFileUpload:output_type -> InitLayerFileUploadResponse
-	19, // [19:23] is the sub-list for method output_type
-	15, // [15:19] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	5,  // 0: TemplateStep.mounts:type_name -> TemplateStepMount
+	4,  // 1: TemplateStage.steps:type_name -> TemplateStep
+	8,  // 2: FromImageRegistry.aws:type_name -> AWSRegistry
+	9,  // 3: FromImageRegistry.gcp:type_name -> GCPRegistry
+	10, // 4: FromImageRegistry.general:type_name -> GeneralRegistry
+	4,  // 5: TemplateConfig.steps:type_name -> TemplateStep
+	7,  // 6: TemplateConfig.fromTemplate:type_name -> FromTemplateConfig
+	11, // 7: TemplateConfig.fromImageRegistry:type_name -> FromImageRegistry
+	6,  // 8: TemplateConfig.stages:type_name -> TemplateStage
+	33, // 9: TemplateConfig.secrets:type_name -> TemplateConfig.SecretsEntry
+	12, // 10: TemplateCreateRequest.template:type_name -> TemplateConfig
+	0,  // 11: TemplateStatusRequest.level:type_name -> LogLevel
+	35, // 12: TemplateBuildLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 13: TemplateBuildLogEntry.level:type_name -> LogLevel
+	34, // 14: TemplateBuildLogEntry.fields:type_name -> TemplateBuildLogEntry.FieldsEntry
+	1,  // 15: TemplateBuildStatusResponse.status:type_name -> TemplateBuildState
+	16, // 16: TemplateBuildStatusResponse.metadata:type_name -> TemplateBuildMetadata
+	17, // 17: TemplateBuildStatusResponse.logEntries:type_name -> TemplateBuildLogEntry
+	18, // 18: TemplateBuildStatusResponse.reason:type_name -> TemplateBuildStatusReason
+	19, // 19: TemplateBuildStatusResponse.cacheResults:type_name -> TemplateBuildStepCacheResult
+	35, // 20: TemplateLayerCacheEntry.createdAt:type_name -> google.protobuf.Timestamp
+	22, // 21: TemplateLayerCacheListResponse.layers:type_name -> TemplateLayerCacheEntry
+	12, // 22: TemplateBuildVerifyRequest.template:type_name -> TemplateConfig
+	13, // 23: TemplateService.TemplateCreate:input_type -> TemplateCreateRequest
+	14, // 24: TemplateService.TemplateBuildStatus:input_type -> TemplateStatusRequest
+	15, // 25: TemplateService.TemplateBuildDelete:input_type -> TemplateBuildDeleteRequest
+	2,  // 26: TemplateService.InitLayerFileUpload:input_type -> InitLayerFileUploadRequest
+	26, // 27: TemplateService.TemplateBuildExport:input_type -> TemplateBuildExportRequest
+	21, // 28: TemplateService.TemplateLayerCacheList:input_type -> TemplateLayerCacheListRequest
+	24, // 29: TemplateService.TemplateLayerCacheInvalidate:input_type -> TemplateLayerCacheInvalidateRequest
+	28, // 30: TemplateService.TemplateBuildProvenance:input_type -> TemplateBuildProvenanceRequest
+	30, // 31: TemplateService.TemplateBuildVerify:input_type -> TemplateBuildVerifyRequest
+	31, // 32: TemplateService.TemplateBuildVerification:input_type -> TemplateBuildVerificationRequest
+	36, // 33: TemplateService.TemplateCreate:output_type -> google.protobuf.Empty
+	20, // 34: TemplateService.TemplateBuildStatus:output_type -> TemplateBuildStatusResponse
+	36, // 35: TemplateService.TemplateBuildDelete:output_type -> google.protobuf.Empty
+	3,  // 36: TemplateService.InitLayerFileUpload:output_type -> InitLayerFileUploadResponse
+	27, // 37: TemplateService.TemplateBuildExport:output_type -> TemplateBuildExportResponse
+	23, // 38: TemplateService.TemplateLayerCacheList:output_type -> TemplateLayerCacheListResponse
+	25, // 39: TemplateService.TemplateLayerCacheInvalidate:output_type -> TemplateLayerCacheInvalidateResponse
+	29, // 40: TemplateService.TemplateBuildProvenance:output_type -> TemplateBuildProvenanceResponse
+	36, // 41: TemplateService.TemplateBuildVerify:output_type -> google.protobuf.Empty
+	32, // 42: TemplateService.TemplateBuildVerification:output_type -> TemplateBuildVerificationResponse
+	33, // [33:43] is the sub-list for method output_type
+	23, // [23:33] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_template_manager_proto_init() }
@@ -1583,7 +2810,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FromTemplateConfig); i {
+			switch v := v.(*TemplateStepMount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +2822,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AWSRegistry); i {
+			switch v := v.(*TemplateStage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +2834,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCPRegistry); i {
+			switch v := v.(*FromTemplateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +2846,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneralRegistry); i {
+			switch v := v.(*AWSRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +2858,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FromImageRegistry); i {
+			switch v := v.(*GCPRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +2870,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateConfig); i {
+			switch v := v.(*GeneralRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +2882,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateCreateRequest); i {
+			switch v := v.(*FromImageRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +2894,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateStatusRequest); i {
+			switch v := v.(*TemplateConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +2906,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildDeleteRequest); i {
+			switch v := v.(*TemplateCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +2918,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildMetadata); i {
+			switch v := v.(*TemplateStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,7 +2930,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildLogEntry); i {
+			switch v := v.(*TemplateBuildDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,7 +2942,7 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TemplateBuildStatusReason); i {
+			switch v := v.(*TemplateBuildMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,6 +2954,42 @@ func file_template_manager_proto_init() {
 			}
 		}
 		file_template_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildStatusReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildStepCacheResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TemplateBuildStatusResponse); i {
 			case 0:
 				return &v.state
@@ -1738,30 +3001,180 @@ func file_template_manager_proto_init() {
 				return nil
 			}
 		}
+		file_template_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateLayerCacheListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateLayerCacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateLayerCacheListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateLayerCacheInvalidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateLayerCacheInvalidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildProvenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildProvenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_template_manager_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateBuildVerificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_template_manager_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_template_manager_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_template_manager_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_template_manager_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*FromImageRegistry_Aws)(nil),
 		(*FromImageRegistry_Gcp)(nil),
 		(*FromImageRegistry_General)(nil),
 	}
-	file_template_manager_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_template_manager_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*TemplateConfig_FromImage)(nil),
 		(*TemplateConfig_FromTemplate)(nil),
 	}
-	file_template_manager_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_template_manager_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_template_manager_proto_msgTypes[28].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_template_manager_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
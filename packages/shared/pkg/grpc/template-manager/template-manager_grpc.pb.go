@@ -31,6 +31,18 @@ type TemplateServiceClient interface {
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(ctx context.Context, in *InitLayerFileUploadRequest, opts ...grpc.CallOption) (*InitLayerFileUploadResponse, error)
+	// TemplateBuildExport streams the rootfs of a template build as an OCI image tarball.
+	TemplateBuildExport(ctx context.Context, in *TemplateBuildExportRequest, opts ...grpc.CallOption) (TemplateService_TemplateBuildExportClient, error)
+	// TemplateLayerCacheList lists the cached layers created by the template builds.
+	TemplateLayerCacheList(ctx context.Context, in *TemplateLayerCacheListRequest, opts ...grpc.CallOption) (*TemplateLayerCacheListResponse, error)
+	// TemplateLayerCacheInvalidate removes the cached layers of the template, so the steps are rebuilt in the next build.
+	TemplateLayerCacheInvalidate(ctx context.Context, in *TemplateLayerCacheInvalidateRequest, opts ...grpc.CallOption) (*TemplateLayerCacheInvalidateResponse, error)
+	// TemplateBuildProvenance returns the provenance document of the finished template build.
+	TemplateBuildProvenance(ctx context.Context, in *TemplateBuildProvenanceRequest, opts ...grpc.CallOption) (*TemplateBuildProvenanceResponse, error)
+	// TemplateBuildVerify rebuilds the template build without the cache and compares its rootfs with the original one.
+	TemplateBuildVerify(ctx context.Context, in *TemplateBuildVerifyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildVerification returns the result of the last verification of the template build.
+	TemplateBuildVerification(ctx context.Context, in *TemplateBuildVerificationRequest, opts ...grpc.CallOption) (*TemplateBuildVerificationResponse, error)
 }
 
 type templateServiceClient struct {
@@ -77,6 +89,83 @@ func (c *templateServiceClient) InitLayerFileUpload(ctx context.Context, in *Ini
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildExport(ctx context.Context, in *TemplateBuildExportRequest, opts ...grpc.CallOption) (TemplateService_TemplateBuildExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TemplateService_ServiceDesc.Streams[0], "/TemplateService/TemplateBuildExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &templateServiceTemplateBuildExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TemplateService_TemplateBuildExportClient interface {
+	Recv() (*TemplateBuildExportResponse, error)
+	grpc.ClientStream
+}
+
+type templateServiceTemplateBuildExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *templateServiceTemplateBuildExportClient) Recv() (*TemplateBuildExportResponse, error) {
+	m := new(TemplateBuildExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *templateServiceClient) TemplateLayerCacheList(ctx context.Context, in *TemplateLayerCacheListRequest, opts ...grpc.CallOption) (*TemplateLayerCacheListResponse, error) {
+	out := new(TemplateLayerCacheListResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateLayerCacheList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateLayerCacheInvalidate(ctx context.Context, in *TemplateLayerCacheInvalidateRequest, opts ...grpc.CallOption) (*TemplateLayerCacheInvalidateResponse, error) {
+	out := new(TemplateLayerCacheInvalidateResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateLayerCacheInvalidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateBuildProvenance(ctx context.Context, in *TemplateBuildProvenanceRequest, opts ...grpc.CallOption) (*TemplateBuildProvenanceResponse, error) {
+	out := new(TemplateBuildProvenanceResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildProvenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateBuildVerify(ctx context.Context, in *TemplateBuildVerifyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) TemplateBuildVerification(ctx context.Context, in *TemplateBuildVerificationRequest, opts ...grpc.CallOption) (*TemplateBuildVerificationResponse, error) {
+	out := new(TemplateBuildVerificationResponse)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TemplateServiceServer is the server API for TemplateService service.
 // All implementations must embed UnimplementedTemplateServiceServer
 // for forward compatibility
@@ -89,6 +178,18 @@ type TemplateServiceServer interface {
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
 	// InitLayerFileUpload requests an upload URL for a tar file containing layer files to be cached for the template build.
 	InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error)
+	// TemplateBuildExport streams the rootfs of a template build as an OCI image tarball.
+	TemplateBuildExport(*TemplateBuildExportRequest, TemplateService_TemplateBuildExportServer) error
+	// TemplateLayerCacheList lists the cached layers created by the template builds.
+	TemplateLayerCacheList(context.Context, *TemplateLayerCacheListRequest) (*TemplateLayerCacheListResponse, error)
+	// TemplateLayerCacheInvalidate removes the cached layers of the template, so the steps are rebuilt in the next build.
+	TemplateLayerCacheInvalidate(context.Context, *TemplateLayerCacheInvalidateRequest) (*TemplateLayerCacheInvalidateResponse, error)
+	// TemplateBuildProvenance returns the provenance document of the finished template build.
+	TemplateBuildProvenance(context.Context, *TemplateBuildProvenanceRequest) (*TemplateBuildProvenanceResponse, error)
+	// TemplateBuildVerify rebuilds the template build without the cache and compares its rootfs with the original one.
+	TemplateBuildVerify(context.Context, *TemplateBuildVerifyRequest) (*emptypb.Empty, error)
+	// TemplateBuildVerification returns the result of the last verification of the template build.
+	TemplateBuildVerification(context.Context, *TemplateBuildVerificationRequest) (*TemplateBuildVerificationResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
 }
 
@@ -108,6 +209,24 @@ func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *
 func (UnimplementedTemplateServiceServer) InitLayerFileUpload(context.Context, *InitLayerFileUploadRequest) (*InitLayerFileUploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitLayerFileUpload not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildExport(*TemplateBuildExportRequest, TemplateService_TemplateBuildExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method TemplateBuildExport not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateLayerCacheList(context.Context, *TemplateLayerCacheListRequest) (*TemplateLayerCacheListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateLayerCacheList not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateLayerCacheInvalidate(context.Context, *TemplateLayerCacheInvalidateRequest) (*TemplateLayerCacheInvalidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateLayerCacheInvalidate not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildProvenance(context.Context, *TemplateBuildProvenanceRequest) (*TemplateBuildProvenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildProvenance not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildVerify(context.Context, *TemplateBuildVerifyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildVerify not implemented")
+}
+func (UnimplementedTemplateServiceServer) TemplateBuildVerification(context.Context, *TemplateBuildVerificationRequest) (*TemplateBuildVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildVerification not implemented")
+}
 func (UnimplementedTemplateServiceServer) mustEmbedUnimplementedTemplateServiceServer() {}
 
 // UnsafeTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +312,117 @@ func _TemplateService_InitLayerFileUpload_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TemplateBuildExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TemplateServiceServer).TemplateBuildExport(m, &templateServiceTemplateBuildExportServer{stream})
+}
+
+type TemplateService_TemplateBuildExportServer interface {
+	Send(*TemplateBuildExportResponse) error
+	grpc.ServerStream
+}
+
+type templateServiceTemplateBuildExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *templateServiceTemplateBuildExportServer) Send(m *TemplateBuildExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TemplateService_TemplateLayerCacheList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateLayerCacheListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateLayerCacheList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateLayerCacheList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateLayerCacheList(ctx, req.(*TemplateLayerCacheListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateLayerCacheInvalidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateLayerCacheInvalidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateLayerCacheInvalidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateLayerCacheInvalidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateLayerCacheInvalidate(ctx, req.(*TemplateLayerCacheInvalidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateBuildProvenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildProvenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildProvenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildProvenance(ctx, req.(*TemplateBuildProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateBuildVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildVerify(ctx, req.(*TemplateBuildVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_TemplateBuildVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildVerification(ctx, req.(*TemplateBuildVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TemplateService_ServiceDesc is the grpc.ServiceDesc for TemplateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,7 +446,33 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitLayerFileUpload",
 			Handler:    _TemplateService_InitLayerFileUpload_Handler,
 		},
+		{
+			MethodName: "TemplateLayerCacheList",
+			Handler:    _TemplateService_TemplateLayerCacheList_Handler,
+		},
+		{
+			MethodName: "TemplateLayerCacheInvalidate",
+			Handler:    _TemplateService_TemplateLayerCacheInvalidate_Handler,
+		},
+		{
+			MethodName: "TemplateBuildProvenance",
+			Handler:    _TemplateService_TemplateBuildProvenance_Handler,
+		},
+		{
+			MethodName: "TemplateBuildVerify",
+			Handler:    _TemplateService_TemplateBuildVerify_Handler,
+		},
+		{
+			MethodName: "TemplateBuildVerification",
+			Handler:    _TemplateService_TemplateBuildVerification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TemplateBuildExport",
+			Handler:       _TemplateService_TemplateBuildExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "template-manager.proto",
 }
@@ -18,14 +18,22 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xTwY7TMBD9FWvgGDWhC5ccV7sSRRQkekR7cJ1J1ijxmBmnsKry72icQLW0hQsiF9uZ",
-	"mTfznp+P4GiIFDAkgfoIjBIpCObD66rSxVFIGJJubYy9dzZ5CuUXoaD/xD3iYHX3krGFGl6UJ8xyjkp5",
-	"z0wM0zQV0KA49lFBoIZb2xjGryNKgqmAN/+j5w75gGxwiRcLXiY9F9VHiEwROflZC0cN6vocKCebHCug",
-	"JR5sghp8SDdrKCA9RZyP2CEruwFFbHcN6FQiiX3o8miqjWdsoP4MS6OfKA9TAVs8n1VsaPb0fXN33mc3",
-	"h8zm7q/NTigPWUIfWlLA5FOvZffrW/P2KSL3RBEKOCDL3KRavVpVSpciBhs91HCzqlYVFBBteswzlj11",
-	"eRNJ0vmcn9ChP6CYd7uPH8zeCjZGK8zCXSCjc7bFpoEa3ite8dzA69lMv0Nns5lvVoyMzqFIO/Y67uL3",
-	"S5b6BVtq0smnf87VpCmLVw75njq8yDWNHMSowOogT8HYPY3JLFdgfBLs2zPKW7xC+J+8ni1eejrX1Mvf",
-	"jwAAAP//DgfnLk4EAAA=",
+	"H4sIAAAAAAAC/7xW247bNhD9lQFboC+CrTrbAvHjXoBuEbfBXpCHIg+0NJbZFTkqZ+RLF/73gpS8vkje",
+	"ddM0frEkDs/hnDkk51llZCty6ITV+Fl55IocY3y5SNPwl5ETdBIedVWVJtNiyA3/ZHLhG2dztDo8fe9x",
+	"psbqu+EOc9iM8vDGe/Jqs9kkKkfOvKkCiBqrS52Dx79qZFGbRF2M3v//nA9EYLVbb4k5MP/0LbK9R79A",
+	"D9iOJy1elLuZNH5WlacKvZimChnlGP4PgWIwxLFEzchbLWqsjJN3I5UoWVfYvGKBPmRnkVkXp4B2U1i8",
+	"cUVcWhDHeMzV+A/VEm1RPm8SNcHuWlm7fEqr2+suz30zBLfXb5LtUALPR08ZMt+4vEcbm3eZrsha7XKg",
+	"GcgcoWrmd1kThSsjV/3yroxEdbsoZ4gdcLFnZZ/mKHP0+4DQxMJ0DUYYy1kCM10ywnKODozAUjM8mbJs",
+	"YjSwKZwud7RTohK1C7SlsUZuVhli3sf+IQyDzHUDim1ggH1Dp8r0wLV1gdtrMI5NjhGlrd2RTj9f9OrE",
+	"oqXmHq/E70fSA7q8b3Giiy7Cgy7OqH+93RKv7eU2z8cYe2zVoEwSbbjnphcDvGS4pdrz8+OW+8jRVX2/",
+	"ZkE76dHl6uMjiLEIXKETMC4m+ITeYQk2uNU4sKYsDWNGLufzypBV9SOjP5+xZvT/gc/QHer8ci3Ywxg/",
+	"g0edw8yTjXzTkrInyHFhMjyb45M3gq+SLL0RQQdCX8pi9eqO+QTJRK+MrS14DLvDCTAKsPk7qjZdy7kk",
+	"S12WD8ZiX33C951OLNrLNh3sHIFfUq3je+DFKsmBVQ+lOCzxUTEOEvoc70jjZhRyEyNlYL8ZXcIv6wp9",
+	"SVSpRC3Qc5NvOvhxkAZRqEKnK6PG6t0gHaQqUZWWeVRoiIu4gbcbP+wxYumKd4cZmgXyKbmWRubhVA4V",
+	"pNpnwfi6wAE8zNtHMGGwIi+7c7Q9ARNgAiM/MLCQxxw0QxGajd2EcEnZmgUcCUwDJOYwIw/TUCRXAHmI",
+	"ZzoPVMzYx17kNlfj/VuxqRCyXFK+/motzB7B5tAF4mvcJIet4ihN+/SNy4q3DddZgJvVZezy0vQU/wvs",
+	"MATtOsI3Ykfv93q412ND0CbmNCypOMcfv97//htMdShPmAFtG8SdqnwIeN9Omn+bro0XToG9uUrtXTgi",
+	"miPBkAM9pVq2fm7bk07KEzyR8Fdx4QT7uuhT6sXfPwMA3FbTqtMMAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -18,8 +18,60 @@ type Me struct {
 	SandboxID string `json:"sandboxID"`
 }
 
+// ProcessEnd defines model for ProcessEnd.
+type ProcessEnd struct {
+	// Cmd Command of the process
+	Cmd string `json:"cmd"`
+
+	// ExitCode Exit code of the process
+	ExitCode int32 `json:"exitCode"`
+
+	// Exited Whether the process exited by itself, false when it was killed by a signal
+	Exited bool `json:"exited"`
+
+	// LimitExceeded Limit that was exceeded by the process
+	LimitExceeded *string `json:"limitExceeded,omitempty"`
+
+	// Pid Process ID inside the sandbox
+	Pid int64 `json:"pid"`
+
+	// Status Status of the process end
+	Status string `json:"status"`
+
+	// Tag Tag of the process
+	Tag   *string      `json:"tag,omitempty"`
+	Usage ProcessUsage `json:"usage"`
+}
+
+// ProcessUsage defines model for ProcessUsage.
+type ProcessUsage struct {
+	// CpuSystemMs CPU time spent in the kernel mode in milliseconds
+	CpuSystemMs int64 `json:"cpuSystemMs"`
+
+	// CpuUserMs CPU time spent in the user mode in milliseconds
+	CpuUserMs int64 `json:"cpuUserMs"`
+
+	// IoReadBytes Bytes read from the block devices
+	IoReadBytes int64 `json:"ioReadBytes"`
+
+	// IoWriteBytes Bytes written to the block devices
+	IoWriteBytes int64 `json:"ioWriteBytes"`
+
+	// MaxRssBytes Maximum resident set size in bytes
+	MaxRssBytes int64 `json:"maxRssBytes"`
+
+	// WallTimeMs Time from the start to the end of the process in milliseconds
+	WallTimeMs int64 `json:"wallTimeMs"`
+}
+
 // N400 defines model for 400.
 type N400 = Error
 
+// N429 defines model for 429.
+type N429 = Error
+
 // N500 defines model for 500.
 type N500 = Error
+
+// ProcessEndJSONRequestBody defines body for ProcessEnd for application/json ContentType.
+type ProcessEndJSONRequestBody = ProcessEnd
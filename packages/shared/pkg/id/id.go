@@ -27,3 +27,50 @@ func CleanEnvID(envID string) (string, error) {
 
 	return cleanedEnvID, nil
 }
+
+// DefaultTag refers to the latest successful build of the template, it can't be assigned to a build.
+const DefaultTag = "latest"
+
+// tagSeparator separates the template alias or ID and the build tag, e.g. "my-template:stable".
+const tagSeparator = ":"
+
+// CleanTag validates the build tag, the tags are case-insensitive.
+func CleanTag(tag string) (string, error) {
+	cleanedTag := strings.ToLower(strings.TrimSpace(tag))
+	ok, err := regexp.MatchString("^[a-z0-9][a-z0-9._-]*$", cleanedTag)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", fmt.Errorf("invalid tag: %s", tag)
+	}
+
+	return cleanedTag, nil
+}
+
+// ParseTemplateTag splits the template reference to the cleaned template alias or ID and the build tag.
+// The returned tag is empty if the reference has no tag or it's the default tag.
+func ParseTemplateTag(templateRef string) (aliasOrEnvID string, tag string, err error) {
+	aliasOrEnvID, tag, found := strings.Cut(templateRef, tagSeparator)
+
+	aliasOrEnvID, err = CleanEnvID(aliasOrEnvID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !found {
+		return aliasOrEnvID, "", nil
+	}
+
+	tag, err = CleanTag(tag)
+	if err != nil {
+		return "", "", err
+	}
+
+	if tag == DefaultTag {
+		return aliasOrEnvID, "", nil
+	}
+
+	return aliasOrEnvID, tag, nil
+}
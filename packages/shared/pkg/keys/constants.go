@@ -3,4 +3,6 @@ package keys
 const (
 	ApiKeyPrefix      = "e2b_"
 	AccessTokenPrefix = "sk_e2b_"
+
+	TrafficAccessTokenPrefix = "ta_e2b_"
 )
@@ -22,6 +22,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
@@ -48,6 +49,8 @@ type Client struct {
 	EnvBuild *EnvBuildClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
+	// SnapshotCheckpoint is the client for interacting with the SnapshotCheckpoint builders.
+	SnapshotCheckpoint *SnapshotCheckpointClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamAPIKey is the client for interacting with the TeamAPIKey builders.
@@ -75,6 +78,7 @@ func (c *Client) init() {
 	c.EnvAlias = NewEnvAliasClient(c.config)
 	c.EnvBuild = NewEnvBuildClient(c.config)
 	c.Snapshot = NewSnapshotClient(c.config)
+	c.SnapshotCheckpoint = NewSnapshotCheckpointClient(c.config)
 	c.Team = NewTeamClient(c.config)
 	c.TeamAPIKey = NewTeamAPIKeyClient(c.config)
 	c.Tier = NewTierClient(c.config)
@@ -173,19 +177,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Cluster:     NewClusterClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AccessToken:        NewAccessTokenClient(cfg),
+		Cluster:            NewClusterClient(cfg),
+		Env:                NewEnvClient(cfg),
+		EnvAlias:           NewEnvAliasClient(cfg),
+		EnvBuild:           NewEnvBuildClient(cfg),
+		Snapshot:           NewSnapshotClient(cfg),
+		SnapshotCheckpoint: NewSnapshotCheckpointClient(cfg),
+		Team:               NewTeamClient(cfg),
+		TeamAPIKey:         NewTeamAPIKeyClient(cfg),
+		Tier:               NewTierClient(cfg),
+		User:               NewUserClient(cfg),
+		UsersTeams:         NewUsersTeamsClient(cfg),
 	}, nil
 }
 
@@ -203,19 +208,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AccessToken: NewAccessTokenClient(cfg),
-		Cluster:     NewClusterClient(cfg),
-		Env:         NewEnvClient(cfg),
-		EnvAlias:    NewEnvAliasClient(cfg),
-		EnvBuild:    NewEnvBuildClient(cfg),
-		Snapshot:    NewSnapshotClient(cfg),
-		Team:        NewTeamClient(cfg),
-		TeamAPIKey:  NewTeamAPIKeyClient(cfg),
-		Tier:        NewTierClient(cfg),
-		User:        NewUserClient(cfg),
-		UsersTeams:  NewUsersTeamsClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AccessToken:        NewAccessTokenClient(cfg),
+		Cluster:            NewClusterClient(cfg),
+		Env:                NewEnvClient(cfg),
+		EnvAlias:           NewEnvAliasClient(cfg),
+		EnvBuild:           NewEnvBuildClient(cfg),
+		Snapshot:           NewSnapshotClient(cfg),
+		SnapshotCheckpoint: NewSnapshotCheckpointClient(cfg),
+		Team:               NewTeamClient(cfg),
+		TeamAPIKey:         NewTeamAPIKeyClient(cfg),
+		Tier:               NewTierClient(cfg),
+		User:               NewUserClient(cfg),
+		UsersTeams:         NewUsersTeamsClient(cfg),
 	}, nil
 }
 
@@ -245,8 +251,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
-		c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot,
+		c.SnapshotCheckpoint, c.Team, c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
 	} {
 		n.Use(hooks...)
 	}
@@ -256,8 +262,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot, c.Team,
-		c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
+		c.AccessToken, c.Cluster, c.Env, c.EnvAlias, c.EnvBuild, c.Snapshot,
+		c.SnapshotCheckpoint, c.Team, c.TeamAPIKey, c.Tier, c.User, c.UsersTeams,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -278,6 +284,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.EnvBuild.mutate(ctx, m)
 	case *SnapshotMutation:
 		return c.Snapshot.mutate(ctx, m)
+	case *SnapshotCheckpointMutation:
+		return c.SnapshotCheckpoint.mutate(ctx, m)
 	case *TeamMutation:
 		return c.Team.mutate(ctx, m)
 	case *TeamAPIKeyMutation:
@@ -1085,6 +1093,25 @@ func (c *EnvBuildClient) QueryEnv(eb *EnvBuild) *EnvQuery {
 	return query
 }
 
+// QueryCheckpoints queries the checkpoints edge of a EnvBuild.
+func (c *EnvBuildClient) QueryCheckpoints(eb *EnvBuild) *SnapshotCheckpointQuery {
+	query := (&SnapshotCheckpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := eb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envbuild.Table, envbuild.FieldID, id),
+			sqlgraph.To(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, envbuild.CheckpointsTable, envbuild.CheckpointsColumn),
+		)
+		schemaConfig := eb.schemaConfig
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromV = sqlgraph.Neighbors(eb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvBuildClient) Hooks() []Hook {
 	return c.hooks.EnvBuild
@@ -1237,6 +1264,25 @@ func (c *SnapshotClient) QueryEnv(s *Snapshot) *EnvQuery {
 	return query
 }
 
+// QueryCheckpoints queries the checkpoints edge of a Snapshot.
+func (c *SnapshotClient) QueryCheckpoints(s *Snapshot) *SnapshotCheckpointQuery {
+	query := (&SnapshotCheckpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, id),
+			sqlgraph.To(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.CheckpointsTable, snapshot.CheckpointsColumn),
+		)
+		schemaConfig := s.schemaConfig
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SnapshotClient) Hooks() []Hook {
 	return c.hooks.Snapshot
@@ -1262,6 +1308,177 @@ func (c *SnapshotClient) mutate(ctx context.Context, m *SnapshotMutation) (Value
 	}
 }
 
+// SnapshotCheckpointClient is a client for the SnapshotCheckpoint schema.
+type SnapshotCheckpointClient struct {
+	config
+}
+
+// NewSnapshotCheckpointClient returns a client for the SnapshotCheckpoint from the given config.
+func NewSnapshotCheckpointClient(c config) *SnapshotCheckpointClient {
+	return &SnapshotCheckpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `snapshotcheckpoint.Hooks(f(g(h())))`.
+func (c *SnapshotCheckpointClient) Use(hooks ...Hook) {
+	c.hooks.SnapshotCheckpoint = append(c.hooks.SnapshotCheckpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `snapshotcheckpoint.Intercept(f(g(h())))`.
+func (c *SnapshotCheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SnapshotCheckpoint = append(c.inters.SnapshotCheckpoint, interceptors...)
+}
+
+// Create returns a builder for creating a SnapshotCheckpoint entity.
+func (c *SnapshotCheckpointClient) Create() *SnapshotCheckpointCreate {
+	mutation := newSnapshotCheckpointMutation(c.config, OpCreate)
+	return &SnapshotCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SnapshotCheckpoint entities.
+func (c *SnapshotCheckpointClient) CreateBulk(builders ...*SnapshotCheckpointCreate) *SnapshotCheckpointCreateBulk {
+	return &SnapshotCheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SnapshotCheckpointClient) MapCreateBulk(slice any, setFunc func(*SnapshotCheckpointCreate, int)) *SnapshotCheckpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SnapshotCheckpointCreateBulk{err: fmt.Errorf("calling to SnapshotCheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SnapshotCheckpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SnapshotCheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SnapshotCheckpoint.
+func (c *SnapshotCheckpointClient) Update() *SnapshotCheckpointUpdate {
+	mutation := newSnapshotCheckpointMutation(c.config, OpUpdate)
+	return &SnapshotCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SnapshotCheckpointClient) UpdateOne(sc *SnapshotCheckpoint) *SnapshotCheckpointUpdateOne {
+	mutation := newSnapshotCheckpointMutation(c.config, OpUpdateOne, withSnapshotCheckpoint(sc))
+	return &SnapshotCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SnapshotCheckpointClient) UpdateOneID(id uuid.UUID) *SnapshotCheckpointUpdateOne {
+	mutation := newSnapshotCheckpointMutation(c.config, OpUpdateOne, withSnapshotCheckpointID(id))
+	return &SnapshotCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SnapshotCheckpoint.
+func (c *SnapshotCheckpointClient) Delete() *SnapshotCheckpointDelete {
+	mutation := newSnapshotCheckpointMutation(c.config, OpDelete)
+	return &SnapshotCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SnapshotCheckpointClient) DeleteOne(sc *SnapshotCheckpoint) *SnapshotCheckpointDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SnapshotCheckpointClient) DeleteOneID(id uuid.UUID) *SnapshotCheckpointDeleteOne {
+	builder := c.Delete().Where(snapshotcheckpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SnapshotCheckpointDeleteOne{builder}
+}
+
+// Query returns a query builder for SnapshotCheckpoint.
+func (c *SnapshotCheckpointClient) Query() *SnapshotCheckpointQuery {
+	return &SnapshotCheckpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSnapshotCheckpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SnapshotCheckpoint entity by its id.
+func (c *SnapshotCheckpointClient) Get(ctx context.Context, id uuid.UUID) (*SnapshotCheckpoint, error) {
+	return c.Query().Where(snapshotcheckpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SnapshotCheckpointClient) GetX(ctx context.Context, id uuid.UUID) *SnapshotCheckpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySnapshot queries the snapshot edge of a SnapshotCheckpoint.
+func (c *SnapshotCheckpointClient) QuerySnapshot(sc *SnapshotCheckpoint) *SnapshotQuery {
+	query := (&SnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID, id),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotcheckpoint.SnapshotTable, snapshotcheckpoint.SnapshotColumn),
+		)
+		schemaConfig := sc.schemaConfig
+		step.To.Schema = schemaConfig.Snapshot
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuild queries the build edge of a SnapshotCheckpoint.
+func (c *SnapshotCheckpointClient) QueryBuild(sc *SnapshotCheckpoint) *EnvBuildQuery {
+	query := (&EnvBuildClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID, id),
+			sqlgraph.To(envbuild.Table, envbuild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotcheckpoint.BuildTable, snapshotcheckpoint.BuildColumn),
+		)
+		schemaConfig := sc.schemaConfig
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SnapshotCheckpointClient) Hooks() []Hook {
+	return c.hooks.SnapshotCheckpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *SnapshotCheckpointClient) Interceptors() []Interceptor {
+	return c.inters.SnapshotCheckpoint
+}
+
+func (c *SnapshotCheckpointClient) mutate(ctx context.Context, m *SnapshotCheckpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SnapshotCheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SnapshotCheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SnapshotCheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SnapshotCheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("models: unknown SnapshotCheckpoint mutation op: %q", m.Op())
+	}
+}
+
 // TeamClient is a client for the Team schema.
 type TeamClient struct {
 	config
@@ -2215,29 +2432,30 @@ func (c *UsersTeamsClient) mutate(ctx context.Context, m *UsersTeamsMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier,
-		User, UsersTeams []ent.Hook
+		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, SnapshotCheckpoint,
+		Team, TeamAPIKey, Tier, User, UsersTeams []ent.Hook
 	}
 	inters struct {
-		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, Team, TeamAPIKey, Tier,
-		User, UsersTeams []ent.Interceptor
+		AccessToken, Cluster, Env, EnvAlias, EnvBuild, Snapshot, SnapshotCheckpoint,
+		Team, TeamAPIKey, Tier, User, UsersTeams []ent.Interceptor
 	}
 )
 
 var (
 	// DefaultSchemaConfig represents the default schema names for all tables as defined in ent/schema.
 	DefaultSchemaConfig = SchemaConfig{
-		AccessToken: tableSchemas[1],
-		Cluster:     tableSchemas[1],
-		Env:         tableSchemas[1],
-		EnvAlias:    tableSchemas[1],
-		EnvBuild:    tableSchemas[1],
-		Snapshot:    tableSchemas[1],
-		Team:        tableSchemas[1],
-		TeamAPIKey:  tableSchemas[1],
-		Tier:        tableSchemas[1],
-		User:        tableSchemas[0],
-		UsersTeams:  tableSchemas[1],
+		AccessToken:        tableSchemas[1],
+		Cluster:            tableSchemas[1],
+		Env:                tableSchemas[1],
+		EnvAlias:           tableSchemas[1],
+		EnvBuild:           tableSchemas[1],
+		Snapshot:           tableSchemas[1],
+		SnapshotCheckpoint: tableSchemas[1],
+		Team:               tableSchemas[1],
+		TeamAPIKey:         tableSchemas[1],
+		Tier:               tableSchemas[1],
+		User:               tableSchemas[0],
+		UsersTeams:         tableSchemas[1],
 	}
 	tableSchemas = [...]string{"auth", "public"}
 )
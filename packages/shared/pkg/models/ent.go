@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
@@ -83,17 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			accesstoken.Table: accesstoken.ValidColumn,
-			cluster.Table:     cluster.ValidColumn,
-			env.Table:         env.ValidColumn,
-			envalias.Table:    envalias.ValidColumn,
-			envbuild.Table:    envbuild.ValidColumn,
-			snapshot.Table:    snapshot.ValidColumn,
-			team.Table:        team.ValidColumn,
-			teamapikey.Table:  teamapikey.ValidColumn,
-			tier.Table:        tier.ValidColumn,
-			user.Table:        user.ValidColumn,
-			usersteams.Table:  usersteams.ValidColumn,
+			accesstoken.Table:        accesstoken.ValidColumn,
+			cluster.Table:            cluster.ValidColumn,
+			env.Table:                env.ValidColumn,
+			envalias.Table:           envalias.ValidColumn,
+			envbuild.Table:           envbuild.ValidColumn,
+			snapshot.Table:           snapshot.ValidColumn,
+			snapshotcheckpoint.Table: snapshotcheckpoint.ValidColumn,
+			team.Table:               team.ValidColumn,
+			teamapikey.Table:         teamapikey.ValidColumn,
+			tier.Table:               tier.ValidColumn,
+			user.Table:               user.ValidColumn,
+			usersteams.Table:         usersteams.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -53,6 +53,8 @@ type EnvBuild struct {
 	EnvdVersion *string `json:"envd_version,omitempty"`
 	// ClusterNodeID holds the value of the "cluster_node_id" field.
 	ClusterNodeID string `json:"cluster_node_id,omitempty"`
+	// CPUArchitecture holds the value of the "cpu_architecture" field.
+	CPUArchitecture string `json:"cpu_architecture,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason schema.BuildReason `json:"reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -65,9 +67,11 @@ type EnvBuild struct {
 type EnvBuildEdges struct {
 	// Env holds the value of the env edge.
 	Env *Env `json:"env,omitempty"`
+	// Checkpoints holds the value of the checkpoints edge.
+	Checkpoints []*SnapshotCheckpoint `json:"checkpoints,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // EnvOrErr returns the Env value or an error if the edge
@@ -83,6 +87,15 @@ func (e EnvBuildEdges) EnvOrErr() (*Env, error) {
 	return nil, &NotLoadedError{edge: "env"}
 }
 
+// CheckpointsOrErr returns the Checkpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvBuildEdges) CheckpointsOrErr() ([]*SnapshotCheckpoint, error) {
+	if e.loadedTypes[1] {
+		return e.Checkpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "checkpoints"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -92,7 +105,7 @@ func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB:
 			values[i] = new(sql.NullInt64)
-		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID:
+		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID, envbuild.FieldCPUArchitecture:
 			values[i] = new(sql.NullString)
 		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt:
 			values[i] = new(sql.NullTime)
@@ -221,6 +234,12 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				eb.ClusterNodeID = value.String
 			}
+		case envbuild.FieldCPUArchitecture:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cpu_architecture", values[i])
+			} else if value.Valid {
+				eb.CPUArchitecture = value.String
+			}
 		case envbuild.FieldReason:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field reason", values[i])
@@ -247,6 +266,11 @@ func (eb *EnvBuild) QueryEnv() *EnvQuery {
 	return NewEnvBuildClient(eb.config).QueryEnv(eb)
 }
 
+// QueryCheckpoints queries the "checkpoints" edge of the EnvBuild entity.
+func (eb *EnvBuild) QueryCheckpoints() *SnapshotCheckpointQuery {
+	return NewEnvBuildClient(eb.config).QueryCheckpoints(eb)
+}
+
 // Update returns a builder for updating this EnvBuild.
 // Note that you need to call EnvBuild.Unwrap() before calling this method if this EnvBuild
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -330,6 +354,9 @@ func (eb *EnvBuild) String() string {
 	builder.WriteString("cluster_node_id=")
 	builder.WriteString(eb.ClusterNodeID)
 	builder.WriteString(", ")
+	builder.WriteString("cpu_architecture=")
+	builder.WriteString(eb.CPUArchitecture)
+	builder.WriteString(", ")
 	builder.WriteString("reason=")
 	builder.WriteString(fmt.Sprintf("%v", eb.Reason))
 	builder.WriteByte(')')
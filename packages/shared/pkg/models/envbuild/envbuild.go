@@ -48,10 +48,14 @@ const (
 	FieldEnvdVersion = "envd_version"
 	// FieldClusterNodeID holds the string denoting the cluster_node_id field in the database.
 	FieldClusterNodeID = "cluster_node_id"
+	// FieldCPUArchitecture holds the string denoting the cpu_architecture field in the database.
+	FieldCPUArchitecture = "cpu_architecture"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
+	// EdgeCheckpoints holds the string denoting the checkpoints edge name in mutations.
+	EdgeCheckpoints = "checkpoints"
 	// Table holds the table name of the envbuild in the database.
 	Table = "env_builds"
 	// EnvTable is the table that holds the env relation/edge.
@@ -61,6 +65,13 @@ const (
 	EnvInverseTable = "envs"
 	// EnvColumn is the table column denoting the env relation/edge.
 	EnvColumn = "env_id"
+	// CheckpointsTable is the table that holds the checkpoints relation/edge.
+	CheckpointsTable = "snapshot_checkpoints"
+	// CheckpointsInverseTable is the table name for the SnapshotCheckpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshotcheckpoint" package.
+	CheckpointsInverseTable = "snapshot_checkpoints"
+	// CheckpointsColumn is the table column denoting the checkpoints relation/edge.
+	CheckpointsColumn = "build_id"
 )
 
 // Columns holds all SQL columns for envbuild fields.
@@ -82,6 +93,7 @@ var Columns = []string{
 	FieldFirecrackerVersion,
 	FieldEnvdVersion,
 	FieldClusterNodeID,
+	FieldCPUArchitecture,
 	FieldReason,
 }
 
@@ -102,6 +114,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// DefaultKernelVersion holds the default value on creation for the "kernel_version" field.
 	DefaultKernelVersion string
+	// DefaultCPUArchitecture holds the default value on creation for the "cpu_architecture" field.
+	DefaultCPUArchitecture string
 	// DefaultReason holds the default value on creation for the "reason" field.
 	DefaultReason schema.BuildReason
 )
@@ -224,12 +238,31 @@ func ByClusterNodeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClusterNodeID, opts...).ToFunc()
 }
 
+// ByCPUArchitecture orders the results by the cpu_architecture field.
+func ByCPUArchitecture(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCPUArchitecture, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCheckpointsCount orders the results by checkpoints count.
+func ByCheckpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckpointsStep(), opts...)
+	}
+}
+
+// ByCheckpoints orders the results by checkpoints terms.
+func ByCheckpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -237,3 +270,10 @@ func newEnvStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
 	)
 }
+func newCheckpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+	)
+}
@@ -132,6 +132,11 @@ func ClusterNodeID(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldClusterNodeID, v))
 }
 
+// CPUArchitecture applies equality check predicate on the "cpu_architecture" field. It's identical to CPUArchitectureEQ.
+func CPUArchitecture(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldCPUArchitecture, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldCreatedAt, v))
@@ -1012,6 +1017,71 @@ func ClusterNodeIDContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldClusterNodeID, v))
 }
 
+// CPUArchitectureEQ applies the EQ predicate on the "cpu_architecture" field.
+func CPUArchitectureEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureNEQ applies the NEQ predicate on the "cpu_architecture" field.
+func CPUArchitectureNEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureIn applies the In predicate on the "cpu_architecture" field.
+func CPUArchitectureIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldCPUArchitecture, vs...))
+}
+
+// CPUArchitectureNotIn applies the NotIn predicate on the "cpu_architecture" field.
+func CPUArchitectureNotIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldCPUArchitecture, vs...))
+}
+
+// CPUArchitectureGT applies the GT predicate on the "cpu_architecture" field.
+func CPUArchitectureGT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureGTE applies the GTE predicate on the "cpu_architecture" field.
+func CPUArchitectureGTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureLT applies the LT predicate on the "cpu_architecture" field.
+func CPUArchitectureLT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureLTE applies the LTE predicate on the "cpu_architecture" field.
+func CPUArchitectureLTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureContains applies the Contains predicate on the "cpu_architecture" field.
+func CPUArchitectureContains(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContains(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureHasPrefix applies the HasPrefix predicate on the "cpu_architecture" field.
+func CPUArchitectureHasPrefix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasPrefix(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureHasSuffix applies the HasSuffix predicate on the "cpu_architecture" field.
+func CPUArchitectureHasSuffix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasSuffix(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureEqualFold applies the EqualFold predicate on the "cpu_architecture" field.
+func CPUArchitectureEqualFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEqualFold(FieldCPUArchitecture, v))
+}
+
+// CPUArchitectureContainsFold applies the ContainsFold predicate on the "cpu_architecture" field.
+func CPUArchitectureContainsFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContainsFold(FieldCPUArchitecture, v))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.EnvBuild {
 	return predicate.EnvBuild(func(s *sql.Selector) {
@@ -1041,6 +1111,35 @@ func HasEnvWith(preds ...predicate.Env) predicate.EnvBuild {
 	})
 }
 
+// HasCheckpoints applies the HasEdge predicate on the "checkpoints" edge.
+func HasCheckpoints() predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckpointsWith applies the HasEdge predicate on the "checkpoints" edge with a given conditions (other predicates).
+func HasCheckpointsWith(preds ...predicate.SnapshotCheckpoint) predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := newCheckpointsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.EnvBuild) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.AndPredicates(predicates...))
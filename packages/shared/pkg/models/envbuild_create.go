@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
@@ -202,6 +203,20 @@ func (ebc *EnvBuildCreate) SetClusterNodeID(s string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (ebc *EnvBuildCreate) SetCPUArchitecture(s string) *EnvBuildCreate {
+	ebc.mutation.SetCPUArchitecture(s)
+	return ebc
+}
+
+// SetNillableCPUArchitecture sets the "cpu_architecture" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableCPUArchitecture(s *string) *EnvBuildCreate {
+	if s != nil {
+		ebc.SetCPUArchitecture(*s)
+	}
+	return ebc
+}
+
 // SetReason sets the "reason" field.
 func (ebc *EnvBuildCreate) SetReason(sr schema.BuildReason) *EnvBuildCreate {
 	ebc.mutation.SetReason(sr)
@@ -227,6 +242,21 @@ func (ebc *EnvBuildCreate) SetEnv(e *Env) *EnvBuildCreate {
 	return ebc.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (ebc *EnvBuildCreate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.AddCheckpointIDs(ids...)
+	return ebc
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (ebc *EnvBuildCreate) AddCheckpoints(s ...*SnapshotCheckpoint) *EnvBuildCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ebc.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebc *EnvBuildCreate) Mutation() *EnvBuildMutation {
 	return ebc.mutation
@@ -278,6 +308,10 @@ func (ebc *EnvBuildCreate) defaults() {
 		v := envbuild.DefaultKernelVersion
 		ebc.mutation.SetKernelVersion(v)
 	}
+	if _, ok := ebc.mutation.CPUArchitecture(); !ok {
+		v := envbuild.DefaultCPUArchitecture
+		ebc.mutation.SetCPUArchitecture(v)
+	}
 	if _, ok := ebc.mutation.Reason(); !ok {
 		v := envbuild.DefaultReason
 		ebc.mutation.SetReason(v)
@@ -321,6 +355,9 @@ func (ebc *EnvBuildCreate) check() error {
 	if _, ok := ebc.mutation.ClusterNodeID(); !ok {
 		return &ValidationError{Name: "cluster_node_id", err: errors.New(`models: missing required field "EnvBuild.cluster_node_id"`)}
 	}
+	if _, ok := ebc.mutation.CPUArchitecture(); !ok {
+		return &ValidationError{Name: "cpu_architecture", err: errors.New(`models: missing required field "EnvBuild.cpu_architecture"`)}
+	}
 	if _, ok := ebc.mutation.Reason(); !ok {
 		return &ValidationError{Name: "reason", err: errors.New(`models: missing required field "EnvBuild.reason"`)}
 	}
@@ -424,6 +461,10 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldClusterNodeID, field.TypeString, value)
 		_node.ClusterNodeID = value
 	}
+	if value, ok := ebc.mutation.CPUArchitecture(); ok {
+		_spec.SetField(envbuild.FieldCPUArchitecture, field.TypeString, value)
+		_node.CPUArchitecture = value
+	}
 	if value, ok := ebc.mutation.Reason(); ok {
 		_spec.SetField(envbuild.FieldReason, field.TypeJSON, value)
 		_node.Reason = value
@@ -446,6 +487,23 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_node.EnvID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ebc.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebc.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -738,6 +796,18 @@ func (u *EnvBuildUpsert) UpdateClusterNodeID() *EnvBuildUpsert {
 	return u
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (u *EnvBuildUpsert) SetCPUArchitecture(v string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldCPUArchitecture, v)
+	return u
+}
+
+// UpdateCPUArchitecture sets the "cpu_architecture" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateCPUArchitecture() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldCPUArchitecture)
+	return u
+}
+
 // SetReason sets the "reason" field.
 func (u *EnvBuildUpsert) SetReason(v schema.BuildReason) *EnvBuildUpsert {
 	u.Set(envbuild.FieldReason, v)
@@ -1081,6 +1151,20 @@ func (u *EnvBuildUpsertOne) UpdateClusterNodeID() *EnvBuildUpsertOne {
 	})
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (u *EnvBuildUpsertOne) SetCPUArchitecture(v string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetCPUArchitecture(v)
+	})
+}
+
+// UpdateCPUArchitecture sets the "cpu_architecture" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateCPUArchitecture() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateCPUArchitecture()
+	})
+}
+
 // SetReason sets the "reason" field.
 func (u *EnvBuildUpsertOne) SetReason(v schema.BuildReason) *EnvBuildUpsertOne {
 	return u.Update(func(s *EnvBuildUpsert) {
@@ -1593,6 +1677,20 @@ func (u *EnvBuildUpsertBulk) UpdateClusterNodeID() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (u *EnvBuildUpsertBulk) SetCPUArchitecture(v string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetCPUArchitecture(v)
+	})
+}
+
+// UpdateCPUArchitecture sets the "cpu_architecture" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateCPUArchitecture() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateCPUArchitecture()
+	})
+}
+
 // SetReason sets the "reason" field.
 func (u *EnvBuildUpsertBulk) SetReason(v schema.BuildReason) *EnvBuildUpsertBulk {
 	return u.Update(func(s *EnvBuildUpsert) {
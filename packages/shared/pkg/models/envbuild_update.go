@@ -15,7 +15,9 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
+	"github.com/google/uuid"
 )
 
 // EnvBuildUpdate is the builder for updating EnvBuild entities.
@@ -306,6 +308,20 @@ func (ebu *EnvBuildUpdate) SetNillableClusterNodeID(s *string) *EnvBuildUpdate {
 	return ebu
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (ebu *EnvBuildUpdate) SetCPUArchitecture(s string) *EnvBuildUpdate {
+	ebu.mutation.SetCPUArchitecture(s)
+	return ebu
+}
+
+// SetNillableCPUArchitecture sets the "cpu_architecture" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableCPUArchitecture(s *string) *EnvBuildUpdate {
+	if s != nil {
+		ebu.SetCPUArchitecture(*s)
+	}
+	return ebu
+}
+
 // SetReason sets the "reason" field.
 func (ebu *EnvBuildUpdate) SetReason(sr schema.BuildReason) *EnvBuildUpdate {
 	ebu.mutation.SetReason(sr)
@@ -325,6 +341,21 @@ func (ebu *EnvBuildUpdate) SetEnv(e *Env) *EnvBuildUpdate {
 	return ebu.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (ebu *EnvBuildUpdate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.AddCheckpointIDs(ids...)
+	return ebu
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (ebu *EnvBuildUpdate) AddCheckpoints(s ...*SnapshotCheckpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ebu.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebu *EnvBuildUpdate) Mutation() *EnvBuildMutation {
 	return ebu.mutation
@@ -336,6 +367,27 @@ func (ebu *EnvBuildUpdate) ClearEnv() *EnvBuildUpdate {
 	return ebu
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the SnapshotCheckpoint entity.
+func (ebu *EnvBuildUpdate) ClearCheckpoints() *EnvBuildUpdate {
+	ebu.mutation.ClearCheckpoints()
+	return ebu
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to SnapshotCheckpoint entities by IDs.
+func (ebu *EnvBuildUpdate) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.RemoveCheckpointIDs(ids...)
+	return ebu
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to SnapshotCheckpoint entities.
+func (ebu *EnvBuildUpdate) RemoveCheckpoints(s ...*SnapshotCheckpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ebu.RemoveCheckpointIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ebu *EnvBuildUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ebu.sqlSave, ebu.mutation, ebu.hooks)
@@ -466,6 +518,9 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ebu.mutation.ClusterNodeID(); ok {
 		_spec.SetField(envbuild.FieldClusterNodeID, field.TypeString, value)
 	}
+	if value, ok := ebu.mutation.CPUArchitecture(); ok {
+		_spec.SetField(envbuild.FieldCPUArchitecture, field.TypeString, value)
+	}
 	if value, ok := ebu.mutation.Reason(); ok {
 		_spec.SetField(envbuild.FieldReason, field.TypeJSON, value)
 	}
@@ -500,6 +555,54 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebu.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebu.schemaConfig)
 	_spec.AddModifiers(ebu.modifiers...)
@@ -798,6 +901,20 @@ func (ebuo *EnvBuildUpdateOne) SetNillableClusterNodeID(s *string) *EnvBuildUpda
 	return ebuo
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (ebuo *EnvBuildUpdateOne) SetCPUArchitecture(s string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetCPUArchitecture(s)
+	return ebuo
+}
+
+// SetNillableCPUArchitecture sets the "cpu_architecture" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableCPUArchitecture(s *string) *EnvBuildUpdateOne {
+	if s != nil {
+		ebuo.SetCPUArchitecture(*s)
+	}
+	return ebuo
+}
+
 // SetReason sets the "reason" field.
 func (ebuo *EnvBuildUpdateOne) SetReason(sr schema.BuildReason) *EnvBuildUpdateOne {
 	ebuo.mutation.SetReason(sr)
@@ -817,6 +934,21 @@ func (ebuo *EnvBuildUpdateOne) SetEnv(e *Env) *EnvBuildUpdateOne {
 	return ebuo.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (ebuo *EnvBuildUpdateOne) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.AddCheckpointIDs(ids...)
+	return ebuo
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (ebuo *EnvBuildUpdateOne) AddCheckpoints(s ...*SnapshotCheckpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ebuo.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebuo *EnvBuildUpdateOne) Mutation() *EnvBuildMutation {
 	return ebuo.mutation
@@ -828,6 +960,27 @@ func (ebuo *EnvBuildUpdateOne) ClearEnv() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the SnapshotCheckpoint entity.
+func (ebuo *EnvBuildUpdateOne) ClearCheckpoints() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearCheckpoints()
+	return ebuo
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to SnapshotCheckpoint entities by IDs.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.RemoveCheckpointIDs(ids...)
+	return ebuo
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to SnapshotCheckpoint entities.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpoints(s ...*SnapshotCheckpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ebuo.RemoveCheckpointIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvBuildUpdate builder.
 func (ebuo *EnvBuildUpdateOne) Where(ps ...predicate.EnvBuild) *EnvBuildUpdateOne {
 	ebuo.mutation.Where(ps...)
@@ -988,6 +1141,9 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if value, ok := ebuo.mutation.ClusterNodeID(); ok {
 		_spec.SetField(envbuild.FieldClusterNodeID, field.TypeString, value)
 	}
+	if value, ok := ebuo.mutation.CPUArchitecture(); ok {
+		_spec.SetField(envbuild.FieldCPUArchitecture, field.TypeString, value)
+	}
 	if value, ok := ebuo.mutation.Reason(); ok {
 		_spec.SetField(envbuild.FieldReason, field.TypeJSON, value)
 	}
@@ -1022,6 +1178,54 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebuo.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebuo.schemaConfig)
 	_spec.AddModifiers(ebuo.modifiers...)
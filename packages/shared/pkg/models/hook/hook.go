@@ -81,6 +81,18 @@ func (f SnapshotFunc) Mutate(ctx context.Context, m models.Mutation) (models.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.SnapshotMutation", m)
 }
 
+// The SnapshotCheckpointFunc type is an adapter to allow the use of ordinary
+// function as SnapshotCheckpoint mutator.
+type SnapshotCheckpointFunc func(context.Context, *models.SnapshotCheckpointMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SnapshotCheckpointFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.SnapshotCheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.SnapshotCheckpointMutation", m)
+}
+
 // The TeamFunc type is an adapter to allow the use of ordinary
 // function as Team mutator.
 type TeamFunc func(context.Context, *models.TeamMutation) (models.Value, error)
@@ -120,6 +120,7 @@ var (
 		{Name: "firecracker_version", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "envd_version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "cluster_node_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "cpu_architecture", Type: field.TypeString, Default: "amd64", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "reason", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
@@ -131,7 +132,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "env_builds_envs_builds",
-				Columns:    []*schema.Column{EnvBuildsColumns[17]},
+				Columns:    []*schema.Column{EnvBuildsColumns[18]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -150,6 +151,9 @@ var (
 		{Name: "origin_node_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "allow_internet_access", Type: field.TypeBool, Nullable: true},
+		{Name: "network", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "idle_timeout", Type: field.TypeInt64, Default: 0},
+		{Name: "memory_limit_mb", Type: field.TypeInt64, Default: 0},
 		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// SnapshotsTable holds the schema information for the "snapshots" table.
@@ -160,12 +164,40 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "snapshots_envs_snapshots",
-				Columns:    []*schema.Column{SnapshotsColumns[11]},
+				Columns:    []*schema.Column{SnapshotsColumns[14]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// SnapshotCheckpointsColumns holds the columns for the "snapshot_checkpoints" table.
+	SnapshotCheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "build_id", Type: field.TypeUUID},
+		{Name: "snapshot_id", Type: field.TypeUUID},
+	}
+	// SnapshotCheckpointsTable holds the schema information for the "snapshot_checkpoints" table.
+	SnapshotCheckpointsTable = &schema.Table{
+		Name:       "snapshot_checkpoints",
+		Columns:    SnapshotCheckpointsColumns,
+		PrimaryKey: []*schema.Column{SnapshotCheckpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "snapshot_checkpoints_env_builds_checkpoints",
+				Columns:    []*schema.Column{SnapshotCheckpointsColumns[3]},
+				RefColumns: []*schema.Column{EnvBuildsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "snapshot_checkpoints_snapshots_checkpoints",
+				Columns:    []*schema.Column{SnapshotCheckpointsColumns[4]},
+				RefColumns: []*schema.Column{SnapshotsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TeamsColumns holds the columns for the "teams" table.
 	TeamsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
@@ -295,6 +327,7 @@ var (
 		EnvAliasesTable,
 		EnvBuildsTable,
 		SnapshotsTable,
+		SnapshotCheckpointsTable,
 		TeamsTable,
 		TeamAPIKeysTable,
 		TiersTable,
@@ -318,6 +351,9 @@ func init() {
 	EnvBuildsTable.Annotation = &entsql.Annotation{}
 	SnapshotsTable.ForeignKeys[0].RefTable = EnvsTable
 	SnapshotsTable.Annotation = &entsql.Annotation{}
+	SnapshotCheckpointsTable.ForeignKeys[0].RefTable = EnvBuildsTable
+	SnapshotCheckpointsTable.ForeignKeys[1].RefTable = SnapshotsTable
+	SnapshotCheckpointsTable.Annotation = &entsql.Annotation{}
 	TeamsTable.ForeignKeys[0].RefTable = TiersTable
 	TeamsTable.Annotation = &entsql.Annotation{}
 	TeamAPIKeysTable.ForeignKeys[0].RefTable = TeamsTable
@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
@@ -36,17 +37,18 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccessToken = "AccessToken"
-	TypeCluster     = "Cluster"
-	TypeEnv         = "Env"
-	TypeEnvAlias    = "EnvAlias"
-	TypeEnvBuild    = "EnvBuild"
-	TypeSnapshot    = "Snapshot"
-	TypeTeam        = "Team"
-	TypeTeamAPIKey  = "TeamAPIKey"
-	TypeTier        = "Tier"
-	TypeUser        = "User"
-	TypeUsersTeams  = "UsersTeams"
+	TypeAccessToken        = "AccessToken"
+	TypeCluster            = "Cluster"
+	TypeEnv                = "Env"
+	TypeEnvAlias           = "EnvAlias"
+	TypeEnvBuild           = "EnvBuild"
+	TypeSnapshot           = "Snapshot"
+	TypeSnapshotCheckpoint = "SnapshotCheckpoint"
+	TypeTeam               = "Team"
+	TypeTeamAPIKey         = "TeamAPIKey"
+	TypeTier               = "Tier"
+	TypeUser               = "User"
+	TypeUsersTeams         = "UsersTeams"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -3087,10 +3089,14 @@ type EnvBuildMutation struct {
 	firecracker_version   *string
 	envd_version          *string
 	cluster_node_id       *string
+	cpu_architecture      *string
 	reason                *schema.BuildReason
 	clearedFields         map[string]struct{}
 	env                   *string
 	clearedenv            bool
+	checkpoints           map[uuid.UUID]struct{}
+	removedcheckpoints    map[uuid.UUID]struct{}
+	clearedcheckpoints    bool
 	done                  bool
 	oldValue              func(context.Context) (*EnvBuild, error)
 	predicates            []predicate.EnvBuild
@@ -3935,6 +3941,42 @@ func (m *EnvBuildMutation) ResetClusterNodeID() {
 	m.cluster_node_id = nil
 }
 
+// SetCPUArchitecture sets the "cpu_architecture" field.
+func (m *EnvBuildMutation) SetCPUArchitecture(s string) {
+	m.cpu_architecture = &s
+}
+
+// CPUArchitecture returns the value of the "cpu_architecture" field in the mutation.
+func (m *EnvBuildMutation) CPUArchitecture() (r string, exists bool) {
+	v := m.cpu_architecture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCPUArchitecture returns the old "cpu_architecture" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldCPUArchitecture(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCPUArchitecture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCPUArchitecture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCPUArchitecture: %w", err)
+	}
+	return oldValue.CPUArchitecture, nil
+}
+
+// ResetCPUArchitecture resets all changes to the "cpu_architecture" field.
+func (m *EnvBuildMutation) ResetCPUArchitecture() {
+	m.cpu_architecture = nil
+}
+
 // SetReason sets the "reason" field.
 func (m *EnvBuildMutation) SetReason(sr schema.BuildReason) {
 	m.reason = &sr
@@ -3998,6 +4040,60 @@ func (m *EnvBuildMutation) ResetEnv() {
 	m.clearedenv = false
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by ids.
+func (m *EnvBuildMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checkpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheckpoints clears the "checkpoints" edge to the SnapshotCheckpoint entity.
+func (m *EnvBuildMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
+}
+
+// CheckpointsCleared reports if the "checkpoints" edge to the SnapshotCheckpoint entity was cleared.
+func (m *EnvBuildMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (m *EnvBuildMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the SnapshotCheckpoint entity.
+func (m *EnvBuildMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *EnvBuildMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *EnvBuildMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
+}
+
 // Where appends a list predicates to the EnvBuildMutation builder.
 func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
 	m.predicates = append(m.predicates, ps...)
@@ -4032,7 +4128,7 @@ func (m *EnvBuildMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 18)
 	if m.created_at != nil {
 		fields = append(fields, envbuild.FieldCreatedAt)
 	}
@@ -4081,6 +4177,9 @@ func (m *EnvBuildMutation) Fields() []string {
 	if m.cluster_node_id != nil {
 		fields = append(fields, envbuild.FieldClusterNodeID)
 	}
+	if m.cpu_architecture != nil {
+		fields = append(fields, envbuild.FieldCPUArchitecture)
+	}
 	if m.reason != nil {
 		fields = append(fields, envbuild.FieldReason)
 	}
@@ -4124,6 +4223,8 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 		return m.EnvdVersion()
 	case envbuild.FieldClusterNodeID:
 		return m.ClusterNodeID()
+	case envbuild.FieldCPUArchitecture:
+		return m.CPUArchitecture()
 	case envbuild.FieldReason:
 		return m.Reason()
 	}
@@ -4167,6 +4268,8 @@ func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldEnvdVersion(ctx)
 	case envbuild.FieldClusterNodeID:
 		return m.OldClusterNodeID(ctx)
+	case envbuild.FieldCPUArchitecture:
+		return m.OldCPUArchitecture(ctx)
 	case envbuild.FieldReason:
 		return m.OldReason(ctx)
 	}
@@ -4290,6 +4393,13 @@ func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetClusterNodeID(v)
 		return nil
+	case envbuild.FieldCPUArchitecture:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCPUArchitecture(v)
+		return nil
 	case envbuild.FieldReason:
 		v, ok := value.(schema.BuildReason)
 		if !ok {
@@ -4484,6 +4594,9 @@ func (m *EnvBuildMutation) ResetField(name string) error {
 	case envbuild.FieldClusterNodeID:
 		m.ResetClusterNodeID()
 		return nil
+	case envbuild.FieldCPUArchitecture:
+		m.ResetCPUArchitecture()
+		return nil
 	case envbuild.FieldReason:
 		m.ResetReason()
 		return nil
@@ -4493,10 +4606,13 @@ func (m *EnvBuildMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *EnvBuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.env != nil {
 		edges = append(edges, envbuild.EdgeEnv)
 	}
+	if m.checkpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
 	return edges
 }
 
@@ -4508,28 +4624,48 @@ func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
 		if id := m.env; id != nil {
 			return []ent.Value{*id}
 		}
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *EnvBuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
+	if m.removedcheckpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *EnvBuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedenv {
 		edges = append(edges, envbuild.EdgeEnv)
 	}
+	if m.clearedcheckpoints {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
 	return edges
 }
 
@@ -4539,6 +4675,8 @@ func (m *EnvBuildMutation) EdgeCleared(name string) bool {
 	switch name {
 	case envbuild.EdgeEnv:
 		return m.clearedenv
+	case envbuild.EdgeCheckpoints:
+		return m.clearedcheckpoints
 	}
 	return false
 }
@@ -4561,6 +4699,9 @@ func (m *EnvBuildMutation) ResetEdge(name string) error {
 	case envbuild.EdgeEnv:
 		m.ResetEnv()
 		return nil
+	case envbuild.EdgeCheckpoints:
+		m.ResetCheckpoints()
+		return nil
 	}
 	return fmt.Errorf("unknown EnvBuild edge %s", name)
 }
@@ -4581,9 +4722,17 @@ type SnapshotMutation struct {
 	origin_node_id        *string
 	team_id               *uuid.UUID
 	allow_internet_access *bool
+	network               **schema.SandboxNetworkConfig
+	idle_timeout          *int64
+	addidle_timeout       *int64
+	memory_limit_mb       *int64
+	addmemory_limit_mb    *int64
 	clearedFields         map[string]struct{}
 	env                   *string
 	clearedenv            bool
+	checkpoints           map[uuid.UUID]struct{}
+	removedcheckpoints    map[uuid.UUID]struct{}
+	clearedcheckpoints    bool
 	done                  bool
 	oldValue              func(context.Context) (*Snapshot, error)
 	predicates            []predicate.Snapshot
@@ -5102,6 +5251,167 @@ func (m *SnapshotMutation) ResetAllowInternetAccess() {
 	delete(m.clearedFields, snapshot.FieldAllowInternetAccess)
 }
 
+// SetNetwork sets the "network" field.
+func (m *SnapshotMutation) SetNetwork(snc *schema.SandboxNetworkConfig) {
+	m.network = &snc
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *SnapshotMutation) Network() (r *schema.SandboxNetworkConfig, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldNetwork(ctx context.Context) (v *schema.SandboxNetworkConfig, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (m *SnapshotMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[snapshot.FieldNetwork] = struct{}{}
+}
+
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *SnapshotMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldNetwork]
+	return ok
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *SnapshotMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, snapshot.FieldNetwork)
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (m *SnapshotMutation) SetIdleTimeout(i int64) {
+	m.idle_timeout = &i
+	m.addidle_timeout = nil
+}
+
+// IdleTimeout returns the value of the "idle_timeout" field in the mutation.
+func (m *SnapshotMutation) IdleTimeout() (r int64, exists bool) {
+	v := m.idle_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdleTimeout returns the old "idle_timeout" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldIdleTimeout(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdleTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdleTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdleTimeout: %w", err)
+	}
+	return oldValue.IdleTimeout, nil
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (m *SnapshotMutation) AddIdleTimeout(i int64) {
+	if m.addidle_timeout != nil {
+		*m.addidle_timeout += i
+	} else {
+		m.addidle_timeout = &i
+	}
+}
+
+// AddedIdleTimeout returns the value that was added to the "idle_timeout" field in this mutation.
+func (m *SnapshotMutation) AddedIdleTimeout() (r int64, exists bool) {
+	v := m.addidle_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIdleTimeout resets all changes to the "idle_timeout" field.
+func (m *SnapshotMutation) ResetIdleTimeout() {
+	m.idle_timeout = nil
+	m.addidle_timeout = nil
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (m *SnapshotMutation) SetMemoryLimitMB(i int64) {
+	m.memory_limit_mb = &i
+	m.addmemory_limit_mb = nil
+}
+
+// MemoryLimitMB returns the value of the "memory_limit_mb" field in the mutation.
+func (m *SnapshotMutation) MemoryLimitMB() (r int64, exists bool) {
+	v := m.memory_limit_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemoryLimitMB returns the old "memory_limit_mb" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldMemoryLimitMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemoryLimitMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemoryLimitMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemoryLimitMB: %w", err)
+	}
+	return oldValue.MemoryLimitMB, nil
+}
+
+// AddMemoryLimitMB adds i to the "memory_limit_mb" field.
+func (m *SnapshotMutation) AddMemoryLimitMB(i int64) {
+	if m.addmemory_limit_mb != nil {
+		*m.addmemory_limit_mb += i
+	} else {
+		m.addmemory_limit_mb = &i
+	}
+}
+
+// AddedMemoryLimitMB returns the value that was added to the "memory_limit_mb" field in this mutation.
+func (m *SnapshotMutation) AddedMemoryLimitMB() (r int64, exists bool) {
+	v := m.addmemory_limit_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMemoryLimitMB resets all changes to the "memory_limit_mb" field.
+func (m *SnapshotMutation) ResetMemoryLimitMB() {
+	m.memory_limit_mb = nil
+	m.addmemory_limit_mb = nil
+}
+
 // ClearEnv clears the "env" edge to the Env entity.
 func (m *SnapshotMutation) ClearEnv() {
 	m.clearedenv = true
@@ -5129,6 +5439,60 @@ func (m *SnapshotMutation) ResetEnv() {
 	m.clearedenv = false
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by ids.
+func (m *SnapshotMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checkpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheckpoints clears the "checkpoints" edge to the SnapshotCheckpoint entity.
+func (m *SnapshotMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
+}
+
+// CheckpointsCleared reports if the "checkpoints" edge to the SnapshotCheckpoint entity was cleared.
+func (m *SnapshotMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (m *SnapshotMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the SnapshotCheckpoint entity.
+func (m *SnapshotMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *SnapshotMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *SnapshotMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
+}
+
 // Where appends a list predicates to the SnapshotMutation builder.
 func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
 	m.predicates = append(m.predicates, ps...)
@@ -5163,7 +5527,7 @@ func (m *SnapshotMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 14)
 	if m.created_at != nil {
 		fields = append(fields, snapshot.FieldCreatedAt)
 	}
@@ -5197,6 +5561,15 @@ func (m *SnapshotMutation) Fields() []string {
 	if m.allow_internet_access != nil {
 		fields = append(fields, snapshot.FieldAllowInternetAccess)
 	}
+	if m.network != nil {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
+	if m.idle_timeout != nil {
+		fields = append(fields, snapshot.FieldIdleTimeout)
+	}
+	if m.memory_limit_mb != nil {
+		fields = append(fields, snapshot.FieldMemoryLimitMB)
+	}
 	return fields
 }
 
@@ -5227,6 +5600,12 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 		return m.TeamID()
 	case snapshot.FieldAllowInternetAccess:
 		return m.AllowInternetAccess()
+	case snapshot.FieldNetwork:
+		return m.Network()
+	case snapshot.FieldIdleTimeout:
+		return m.IdleTimeout()
+	case snapshot.FieldMemoryLimitMB:
+		return m.MemoryLimitMB()
 	}
 	return nil, false
 }
@@ -5258,6 +5637,12 @@ func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldTeamID(ctx)
 	case snapshot.FieldAllowInternetAccess:
 		return m.OldAllowInternetAccess(ctx)
+	case snapshot.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case snapshot.FieldIdleTimeout:
+		return m.OldIdleTimeout(ctx)
+	case snapshot.FieldMemoryLimitMB:
+		return m.OldMemoryLimitMB(ctx)
 	}
 	return nil, fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5344,6 +5729,27 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAllowInternetAccess(v)
 		return nil
+	case snapshot.FieldNetwork:
+		v, ok := value.(*schema.SandboxNetworkConfig)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case snapshot.FieldIdleTimeout:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdleTimeout(v)
+		return nil
+	case snapshot.FieldMemoryLimitMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryLimitMB(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
@@ -5351,13 +5757,26 @@ func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *SnapshotMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addidle_timeout != nil {
+		fields = append(fields, snapshot.FieldIdleTimeout)
+	}
+	if m.addmemory_limit_mb != nil {
+		fields = append(fields, snapshot.FieldMemoryLimitMB)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldIdleTimeout:
+		return m.AddedIdleTimeout()
+	case snapshot.FieldMemoryLimitMB:
+		return m.AddedMemoryLimitMB()
+	}
 	return nil, false
 }
 
@@ -5366,6 +5785,20 @@ func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case snapshot.FieldIdleTimeout:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIdleTimeout(v)
+		return nil
+	case snapshot.FieldMemoryLimitMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryLimitMB(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot numeric field %s", name)
 }
@@ -5377,6 +5810,9 @@ func (m *SnapshotMutation) ClearedFields() []string {
 	if m.FieldCleared(snapshot.FieldAllowInternetAccess) {
 		fields = append(fields, snapshot.FieldAllowInternetAccess)
 	}
+	if m.FieldCleared(snapshot.FieldNetwork) {
+		fields = append(fields, snapshot.FieldNetwork)
+	}
 	return fields
 }
 
@@ -5394,6 +5830,9 @@ func (m *SnapshotMutation) ClearField(name string) error {
 	case snapshot.FieldAllowInternetAccess:
 		m.ClearAllowInternetAccess()
 		return nil
+	case snapshot.FieldNetwork:
+		m.ClearNetwork()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot nullable field %s", name)
 }
@@ -5435,16 +5874,28 @@ func (m *SnapshotMutation) ResetField(name string) error {
 	case snapshot.FieldAllowInternetAccess:
 		m.ResetAllowInternetAccess()
 		return nil
+	case snapshot.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case snapshot.FieldIdleTimeout:
+		m.ResetIdleTimeout()
+		return nil
+	case snapshot.FieldMemoryLimitMB:
+		m.ResetMemoryLimitMB()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SnapshotMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.env != nil {
 		edges = append(edges, snapshot.EdgeEnv)
 	}
+	if m.checkpoints != nil {
+		edges = append(edges, snapshot.EdgeCheckpoints)
+	}
 	return edges
 }
 
@@ -5456,28 +5907,48 @@ func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 		if id := m.env; id != nil {
 			return []ent.Value{*id}
 		}
+	case snapshot.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SnapshotMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
+	if m.removedcheckpoints != nil {
+		edges = append(edges, snapshot.EdgeCheckpoints)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case snapshot.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SnapshotMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedenv {
 		edges = append(edges, snapshot.EdgeEnv)
 	}
+	if m.clearedcheckpoints {
+		edges = append(edges, snapshot.EdgeCheckpoints)
+	}
 	return edges
 }
 
@@ -5487,6 +5958,8 @@ func (m *SnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
 	case snapshot.EdgeEnv:
 		return m.clearedenv
+	case snapshot.EdgeCheckpoints:
+		return m.clearedcheckpoints
 	}
 	return false
 }
@@ -5509,10 +5982,607 @@ func (m *SnapshotMutation) ResetEdge(name string) error {
 	case snapshot.EdgeEnv:
 		m.ResetEnv()
 		return nil
+	case snapshot.EdgeCheckpoints:
+		m.ResetCheckpoints()
+		return nil
 	}
 	return fmt.Errorf("unknown Snapshot edge %s", name)
 }
 
+// SnapshotCheckpointMutation represents an operation that mutates the SnapshotCheckpoint nodes in the graph.
+type SnapshotCheckpointMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	name            *string
+	clearedFields   map[string]struct{}
+	snapshot        *uuid.UUID
+	clearedsnapshot bool
+	build           *uuid.UUID
+	clearedbuild    bool
+	done            bool
+	oldValue        func(context.Context) (*SnapshotCheckpoint, error)
+	predicates      []predicate.SnapshotCheckpoint
+}
+
+var _ ent.Mutation = (*SnapshotCheckpointMutation)(nil)
+
+// snapshotcheckpointOption allows management of the mutation configuration using functional options.
+type snapshotcheckpointOption func(*SnapshotCheckpointMutation)
+
+// newSnapshotCheckpointMutation creates new mutation for the SnapshotCheckpoint entity.
+func newSnapshotCheckpointMutation(c config, op Op, opts ...snapshotcheckpointOption) *SnapshotCheckpointMutation {
+	m := &SnapshotCheckpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSnapshotCheckpoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSnapshotCheckpointID sets the ID field of the mutation.
+func withSnapshotCheckpointID(id uuid.UUID) snapshotcheckpointOption {
+	return func(m *SnapshotCheckpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SnapshotCheckpoint
+		)
+		m.oldValue = func(ctx context.Context) (*SnapshotCheckpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SnapshotCheckpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSnapshotCheckpoint sets the old SnapshotCheckpoint of the mutation.
+func withSnapshotCheckpoint(node *SnapshotCheckpoint) snapshotcheckpointOption {
+	return func(m *SnapshotCheckpointMutation) {
+		m.oldValue = func(context.Context) (*SnapshotCheckpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SnapshotCheckpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SnapshotCheckpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SnapshotCheckpoint entities.
+func (m *SnapshotCheckpointMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SnapshotCheckpointMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotCheckpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SnapshotCheckpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SnapshotCheckpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SnapshotCheckpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SnapshotCheckpoint entity.
+// If the SnapshotCheckpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotCheckpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SnapshotCheckpointMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (m *SnapshotCheckpointMutation) SetSnapshotID(u uuid.UUID) {
+	m.snapshot = &u
+}
+
+// SnapshotID returns the value of the "snapshot_id" field in the mutation.
+func (m *SnapshotCheckpointMutation) SnapshotID() (r uuid.UUID, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshotID returns the old "snapshot_id" field's value of the SnapshotCheckpoint entity.
+// If the SnapshotCheckpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotCheckpointMutation) OldSnapshotID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshotID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshotID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshotID: %w", err)
+	}
+	return oldValue.SnapshotID, nil
+}
+
+// ResetSnapshotID resets all changes to the "snapshot_id" field.
+func (m *SnapshotCheckpointMutation) ResetSnapshotID() {
+	m.snapshot = nil
+}
+
+// SetBuildID sets the "build_id" field.
+func (m *SnapshotCheckpointMutation) SetBuildID(u uuid.UUID) {
+	m.build = &u
+}
+
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *SnapshotCheckpointMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildID returns the old "build_id" field's value of the SnapshotCheckpoint entity.
+// If the SnapshotCheckpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotCheckpointMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
+	}
+	return oldValue.BuildID, nil
+}
+
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *SnapshotCheckpointMutation) ResetBuildID() {
+	m.build = nil
+}
+
+// SetName sets the "name" field.
+func (m *SnapshotCheckpointMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SnapshotCheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SnapshotCheckpoint entity.
+// If the SnapshotCheckpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotCheckpointMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SnapshotCheckpointMutation) ResetName() {
+	m.name = nil
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (m *SnapshotCheckpointMutation) ClearSnapshot() {
+	m.clearedsnapshot = true
+	m.clearedFields[snapshotcheckpoint.FieldSnapshotID] = struct{}{}
+}
+
+// SnapshotCleared reports if the "snapshot" edge to the Snapshot entity was cleared.
+func (m *SnapshotCheckpointMutation) SnapshotCleared() bool {
+	return m.clearedsnapshot
+}
+
+// SnapshotIDs returns the "snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SnapshotID instead. It exists only for internal usage by the builders.
+func (m *SnapshotCheckpointMutation) SnapshotIDs() (ids []uuid.UUID) {
+	if id := m.snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSnapshot resets all changes to the "snapshot" edge.
+func (m *SnapshotCheckpointMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.clearedsnapshot = false
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (m *SnapshotCheckpointMutation) ClearBuild() {
+	m.clearedbuild = true
+	m.clearedFields[snapshotcheckpoint.FieldBuildID] = struct{}{}
+}
+
+// BuildCleared reports if the "build" edge to the EnvBuild entity was cleared.
+func (m *SnapshotCheckpointMutation) BuildCleared() bool {
+	return m.clearedbuild
+}
+
+// BuildIDs returns the "build" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuildID instead. It exists only for internal usage by the builders.
+func (m *SnapshotCheckpointMutation) BuildIDs() (ids []uuid.UUID) {
+	if id := m.build; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuild resets all changes to the "build" edge.
+func (m *SnapshotCheckpointMutation) ResetBuild() {
+	m.build = nil
+	m.clearedbuild = false
+}
+
+// Where appends a list predicates to the SnapshotCheckpointMutation builder.
+func (m *SnapshotCheckpointMutation) Where(ps ...predicate.SnapshotCheckpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SnapshotCheckpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SnapshotCheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SnapshotCheckpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SnapshotCheckpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SnapshotCheckpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SnapshotCheckpoint).
+func (m *SnapshotCheckpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SnapshotCheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, snapshotcheckpoint.FieldCreatedAt)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, snapshotcheckpoint.FieldSnapshotID)
+	}
+	if m.build != nil {
+		fields = append(fields, snapshotcheckpoint.FieldBuildID)
+	}
+	if m.name != nil {
+		fields = append(fields, snapshotcheckpoint.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SnapshotCheckpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case snapshotcheckpoint.FieldCreatedAt:
+		return m.CreatedAt()
+	case snapshotcheckpoint.FieldSnapshotID:
+		return m.SnapshotID()
+	case snapshotcheckpoint.FieldBuildID:
+		return m.BuildID()
+	case snapshotcheckpoint.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SnapshotCheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case snapshotcheckpoint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case snapshotcheckpoint.FieldSnapshotID:
+		return m.OldSnapshotID(ctx)
+	case snapshotcheckpoint.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case snapshotcheckpoint.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown SnapshotCheckpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotCheckpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case snapshotcheckpoint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case snapshotcheckpoint.FieldSnapshotID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshotID(v)
+		return nil
+	case snapshotcheckpoint.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case snapshotcheckpoint.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotCheckpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SnapshotCheckpointMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SnapshotCheckpointMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotCheckpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SnapshotCheckpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SnapshotCheckpointMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SnapshotCheckpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SnapshotCheckpointMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SnapshotCheckpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SnapshotCheckpointMutation) ResetField(name string) error {
+	switch name {
+	case snapshotcheckpoint.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case snapshotcheckpoint.FieldSnapshotID:
+		m.ResetSnapshotID()
+		return nil
+	case snapshotcheckpoint.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case snapshotcheckpoint.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotCheckpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SnapshotCheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.snapshot != nil {
+		edges = append(edges, snapshotcheckpoint.EdgeSnapshot)
+	}
+	if m.build != nil {
+		edges = append(edges, snapshotcheckpoint.EdgeBuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SnapshotCheckpointMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case snapshotcheckpoint.EdgeSnapshot:
+		if id := m.snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	case snapshotcheckpoint.EdgeBuild:
+		if id := m.build; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SnapshotCheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SnapshotCheckpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SnapshotCheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsnapshot {
+		edges = append(edges, snapshotcheckpoint.EdgeSnapshot)
+	}
+	if m.clearedbuild {
+		edges = append(edges, snapshotcheckpoint.EdgeBuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SnapshotCheckpointMutation) EdgeCleared(name string) bool {
+	switch name {
+	case snapshotcheckpoint.EdgeSnapshot:
+		return m.clearedsnapshot
+	case snapshotcheckpoint.EdgeBuild:
+		return m.clearedbuild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SnapshotCheckpointMutation) ClearEdge(name string) error {
+	switch name {
+	case snapshotcheckpoint.EdgeSnapshot:
+		m.ClearSnapshot()
+		return nil
+	case snapshotcheckpoint.EdgeBuild:
+		m.ClearBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotCheckpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SnapshotCheckpointMutation) ResetEdge(name string) error {
+	switch name {
+	case snapshotcheckpoint.EdgeSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case snapshotcheckpoint.EdgeBuild:
+		m.ResetBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown SnapshotCheckpoint edge %s", name)
+}
+
 // TeamMutation represents an operation that mutates the Team nodes in the graph.
 type TeamMutation struct {
 	config
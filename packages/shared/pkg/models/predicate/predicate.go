@@ -24,6 +24,9 @@ type EnvBuild func(*sql.Selector)
 // Snapshot is the predicate function for snapshot builders.
 type Snapshot func(*sql.Selector)
 
+// SnapshotCheckpoint is the predicate function for snapshotcheckpoint builders.
+type SnapshotCheckpoint func(*sql.Selector)
+
 // Team is the predicate function for team builders.
 type Team func(*sql.Selector)
 
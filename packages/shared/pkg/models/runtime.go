@@ -11,6 +11,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
@@ -80,8 +81,12 @@ func init() {
 	envbuildDescKernelVersion := envbuildFields[13].Descriptor()
 	// envbuild.DefaultKernelVersion holds the default value on creation for the kernel_version field.
 	envbuild.DefaultKernelVersion = envbuildDescKernelVersion.Default.(string)
+	// envbuildDescCPUArchitecture is the schema descriptor for cpu_architecture field.
+	envbuildDescCPUArchitecture := envbuildFields[17].Descriptor()
+	// envbuild.DefaultCPUArchitecture holds the default value on creation for the cpu_architecture field.
+	envbuild.DefaultCPUArchitecture = envbuildDescCPUArchitecture.Default.(string)
 	// envbuildDescReason is the schema descriptor for reason field.
-	envbuildDescReason := envbuildFields[17].Descriptor()
+	envbuildDescReason := envbuildFields[18].Descriptor()
 	// envbuild.DefaultReason holds the default value on creation for the reason field.
 	envbuild.DefaultReason = envbuildDescReason.Default.(schema.BuildReason)
 	snapshotFields := schema.Snapshot{}.Fields()
@@ -98,6 +103,20 @@ func init() {
 	snapshotDescAutoPause := snapshotFields[8].Descriptor()
 	// snapshot.DefaultAutoPause holds the default value on creation for the auto_pause field.
 	snapshot.DefaultAutoPause = snapshotDescAutoPause.Default.(bool)
+	// snapshotDescIdleTimeout is the schema descriptor for idle_timeout field.
+	snapshotDescIdleTimeout := snapshotFields[13].Descriptor()
+	// snapshot.DefaultIdleTimeout holds the default value on creation for the idle_timeout field.
+	snapshot.DefaultIdleTimeout = snapshotDescIdleTimeout.Default.(int64)
+	// snapshotDescMemoryLimitMB is the schema descriptor for memory_limit_mb field.
+	snapshotDescMemoryLimitMB := snapshotFields[14].Descriptor()
+	// snapshot.DefaultMemoryLimitMB holds the default value on creation for the memory_limit_mb field.
+	snapshot.DefaultMemoryLimitMB = snapshotDescMemoryLimitMB.Default.(int64)
+	snapshotcheckpointFields := schema.SnapshotCheckpoint{}.Fields()
+	_ = snapshotcheckpointFields
+	// snapshotcheckpointDescCreatedAt is the schema descriptor for created_at field.
+	snapshotcheckpointDescCreatedAt := snapshotcheckpointFields[1].Descriptor()
+	// snapshotcheckpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snapshotcheckpoint.DefaultCreatedAt = snapshotcheckpointDescCreatedAt.Default.(func() time.Time)
 	teamFields := schema.Team{}.Fields()
 	_ = teamFields
 	// teamDescCreatedAt is the schema descriptor for created_at field.
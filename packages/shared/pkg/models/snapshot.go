@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -42,6 +43,12 @@ type Snapshot struct {
 	TeamID uuid.UUID `json:"team_id,omitempty"`
 	// AllowInternetAccess holds the value of the "allow_internet_access" field.
 	AllowInternetAccess *bool `json:"allow_internet_access,omitempty"`
+	// Network holds the value of the "network" field.
+	Network *schema.SandboxNetworkConfig `json:"network,omitempty"`
+	// IdleTimeout holds the value of the "idle_timeout" field.
+	IdleTimeout int64 `json:"idle_timeout,omitempty"`
+	// MemoryLimitMB holds the value of the "memory_limit_mb" field.
+	MemoryLimitMB int64 `json:"memory_limit_mb,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -52,9 +59,11 @@ type Snapshot struct {
 type SnapshotEdges struct {
 	// Env holds the value of the env edge.
 	Env *Env `json:"env,omitempty"`
+	// Checkpoints holds the value of the checkpoints edge.
+	Checkpoints []*SnapshotCheckpoint `json:"checkpoints,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // EnvOrErr returns the Env value or an error if the edge
@@ -70,15 +79,26 @@ func (e SnapshotEdges) EnvOrErr() (*Env, error) {
 	return nil, &NotLoadedError{edge: "env"}
 }
 
+// CheckpointsOrErr returns the Checkpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e SnapshotEdges) CheckpointsOrErr() ([]*SnapshotCheckpoint, error) {
+	if e.loadedTypes[1] {
+		return e.Checkpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "checkpoints"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldMetadata:
+		case snapshot.FieldMetadata, snapshot.FieldNetwork:
 			values[i] = new([]byte)
 		case snapshot.FieldEnvSecure, snapshot.FieldAutoPause, snapshot.FieldAllowInternetAccess:
 			values[i] = new(sql.NullBool)
+		case snapshot.FieldIdleTimeout, snapshot.FieldMemoryLimitMB:
+			values[i] = new(sql.NullInt64)
 		case snapshot.FieldBaseEnvID, snapshot.FieldEnvID, snapshot.FieldSandboxID, snapshot.FieldOriginNodeID:
 			values[i] = new(sql.NullString)
 		case snapshot.FieldCreatedAt, snapshot.FieldSandboxStartedAt:
@@ -175,6 +195,26 @@ func (s *Snapshot) assignValues(columns []string, values []any) error {
 				s.AllowInternetAccess = new(bool)
 				*s.AllowInternetAccess = value.Bool
 			}
+		case snapshot.FieldNetwork:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field network", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Network); err != nil {
+					return fmt.Errorf("unmarshal field network: %w", err)
+				}
+			}
+		case snapshot.FieldIdleTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field idle_timeout", values[i])
+			} else if value.Valid {
+				s.IdleTimeout = value.Int64
+			}
+		case snapshot.FieldMemoryLimitMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_limit_mb", values[i])
+			} else if value.Valid {
+				s.MemoryLimitMB = value.Int64
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -193,6 +233,11 @@ func (s *Snapshot) QueryEnv() *EnvQuery {
 	return NewSnapshotClient(s.config).QueryEnv(s)
 }
 
+// QueryCheckpoints queries the "checkpoints" edge of the Snapshot entity.
+func (s *Snapshot) QueryCheckpoints() *SnapshotCheckpointQuery {
+	return NewSnapshotClient(s.config).QueryCheckpoints(s)
+}
+
 // Update returns a builder for updating this Snapshot.
 // Note that you need to call Snapshot.Unwrap() before calling this method if this Snapshot
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -250,6 +295,15 @@ func (s *Snapshot) String() string {
 		builder.WriteString("allow_internet_access=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("network=")
+	builder.WriteString(fmt.Sprintf("%v", s.Network))
+	builder.WriteString(", ")
+	builder.WriteString("idle_timeout=")
+	builder.WriteString(fmt.Sprintf("%v", s.IdleTimeout))
+	builder.WriteString(", ")
+	builder.WriteString("memory_limit_mb=")
+	builder.WriteString(fmt.Sprintf("%v", s.MemoryLimitMB))
 	builder.WriteByte(')')
 	return builder.String()
 }
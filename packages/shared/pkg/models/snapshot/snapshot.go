@@ -36,8 +36,16 @@ const (
 	FieldTeamID = "team_id"
 	// FieldAllowInternetAccess holds the string denoting the allow_internet_access field in the database.
 	FieldAllowInternetAccess = "allow_internet_access"
+	// FieldNetwork holds the string denoting the network field in the database.
+	FieldNetwork = "network"
+	// FieldIdleTimeout holds the string denoting the idle_timeout field in the database.
+	FieldIdleTimeout = "idle_timeout"
+	// FieldMemoryLimitMB holds the string denoting the memory_limit_mb field in the database.
+	FieldMemoryLimitMB = "memory_limit_mb"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
+	// EdgeCheckpoints holds the string denoting the checkpoints edge name in mutations.
+	EdgeCheckpoints = "checkpoints"
 	// Table holds the table name of the snapshot in the database.
 	Table = "snapshots"
 	// EnvTable is the table that holds the env relation/edge.
@@ -47,6 +55,13 @@ const (
 	EnvInverseTable = "envs"
 	// EnvColumn is the table column denoting the env relation/edge.
 	EnvColumn = "env_id"
+	// CheckpointsTable is the table that holds the checkpoints relation/edge.
+	CheckpointsTable = "snapshot_checkpoints"
+	// CheckpointsInverseTable is the table name for the SnapshotCheckpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshotcheckpoint" package.
+	CheckpointsInverseTable = "snapshot_checkpoints"
+	// CheckpointsColumn is the table column denoting the checkpoints relation/edge.
+	CheckpointsColumn = "snapshot_id"
 )
 
 // Columns holds all SQL columns for snapshot fields.
@@ -63,6 +78,9 @@ var Columns = []string{
 	FieldOriginNodeID,
 	FieldTeamID,
 	FieldAllowInternetAccess,
+	FieldNetwork,
+	FieldIdleTimeout,
+	FieldMemoryLimitMB,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -82,6 +100,10 @@ var (
 	DefaultEnvSecure bool
 	// DefaultAutoPause holds the default value on creation for the "auto_pause" field.
 	DefaultAutoPause bool
+	// DefaultIdleTimeout holds the default value on creation for the "idle_timeout" field.
+	DefaultIdleTimeout int64
+	// DefaultMemoryLimitMB holds the default value on creation for the "memory_limit_mb" field.
+	DefaultMemoryLimitMB int64
 )
 
 // OrderOption defines the ordering options for the Snapshot queries.
@@ -142,12 +164,36 @@ func ByAllowInternetAccess(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAllowInternetAccess, opts...).ToFunc()
 }
 
+// ByIdleTimeout orders the results by the idle_timeout field.
+func ByIdleTimeout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdleTimeout, opts...).ToFunc()
+}
+
+// ByMemoryLimitMB orders the results by the memory_limit_mb field.
+func ByMemoryLimitMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryLimitMB, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCheckpointsCount orders the results by checkpoints count.
+func ByCheckpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckpointsStep(), opts...)
+	}
+}
+
+// ByCheckpoints orders the results by checkpoints terms.
+func ByCheckpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -155,3 +201,10 @@ func newEnvStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
 	)
 }
+func newCheckpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+	)
+}
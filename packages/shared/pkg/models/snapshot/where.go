@@ -107,6 +107,16 @@ func AllowInternetAccess(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldAllowInternetAccess, v))
 }
 
+// IdleTimeout applies equality check predicate on the "idle_timeout" field. It's identical to IdleTimeoutEQ.
+func IdleTimeout(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// MemoryLimitMB applies equality check predicate on the "memory_limit_mb" field. It's identical to MemoryLimitMBEQ.
+func MemoryLimitMB(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldMemoryLimitMB, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldCreatedAt, v))
@@ -527,6 +537,96 @@ func AllowInternetAccessNotNil() predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldNotNull(FieldAllowInternetAccess))
 }
 
+// NetworkIsNil applies the IsNil predicate on the "network" field.
+func NetworkIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldNetwork))
+}
+
+// NetworkNotNil applies the NotNil predicate on the "network" field.
+func NetworkNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldNetwork))
+}
+
+// IdleTimeoutEQ applies the EQ predicate on the "idle_timeout" field.
+func IdleTimeoutEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutNEQ applies the NEQ predicate on the "idle_timeout" field.
+func IdleTimeoutNEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutIn applies the In predicate on the "idle_timeout" field.
+func IdleTimeoutIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutNotIn applies the NotIn predicate on the "idle_timeout" field.
+func IdleTimeoutNotIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldIdleTimeout, vs...))
+}
+
+// IdleTimeoutGT applies the GT predicate on the "idle_timeout" field.
+func IdleTimeoutGT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutGTE applies the GTE predicate on the "idle_timeout" field.
+func IdleTimeoutGTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLT applies the LT predicate on the "idle_timeout" field.
+func IdleTimeoutLT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldIdleTimeout, v))
+}
+
+// IdleTimeoutLTE applies the LTE predicate on the "idle_timeout" field.
+func IdleTimeoutLTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldIdleTimeout, v))
+}
+
+// MemoryLimitMBEQ applies the EQ predicate on the "memory_limit_mb" field.
+func MemoryLimitMBEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBNEQ applies the NEQ predicate on the "memory_limit_mb" field.
+func MemoryLimitMBNEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBIn applies the In predicate on the "memory_limit_mb" field.
+func MemoryLimitMBIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldMemoryLimitMB, vs...))
+}
+
+// MemoryLimitMBNotIn applies the NotIn predicate on the "memory_limit_mb" field.
+func MemoryLimitMBNotIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldMemoryLimitMB, vs...))
+}
+
+// MemoryLimitMBGT applies the GT predicate on the "memory_limit_mb" field.
+func MemoryLimitMBGT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBGTE applies the GTE predicate on the "memory_limit_mb" field.
+func MemoryLimitMBGTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBLT applies the LT predicate on the "memory_limit_mb" field.
+func MemoryLimitMBLT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBLTE applies the LTE predicate on the "memory_limit_mb" field.
+func MemoryLimitMBLTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldMemoryLimitMB, v))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
@@ -556,6 +656,35 @@ func HasEnvWith(preds ...predicate.Env) predicate.Snapshot {
 	})
 }
 
+// HasCheckpoints applies the HasEdge predicate on the "checkpoints" edge.
+func HasCheckpoints() predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckpointsWith applies the HasEdge predicate on the "checkpoints" edge with a given conditions (other predicates).
+func HasCheckpointsWith(preds ...predicate.SnapshotCheckpoint) predicate.Snapshot {
+	return predicate.Snapshot(func(s *sql.Selector) {
+		step := newCheckpointsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Snapshot) predicate.Snapshot {
 	return predicate.Snapshot(sql.AndPredicates(predicates...))
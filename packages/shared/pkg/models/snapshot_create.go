@@ -14,6 +14,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -123,6 +125,40 @@ func (sc *SnapshotCreate) SetNillableAllowInternetAccess(b *bool) *SnapshotCreat
 	return sc
 }
 
+// SetNetwork sets the "network" field.
+func (sc *SnapshotCreate) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotCreate {
+	sc.mutation.SetNetwork(snc)
+	return sc
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (sc *SnapshotCreate) SetIdleTimeout(i int64) *SnapshotCreate {
+	sc.mutation.SetIdleTimeout(i)
+	return sc
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableIdleTimeout(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetIdleTimeout(*i)
+	}
+	return sc
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (sc *SnapshotCreate) SetMemoryLimitMB(i int64) *SnapshotCreate {
+	sc.mutation.SetMemoryLimitMB(i)
+	return sc
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableMemoryLimitMB(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetMemoryLimitMB(*i)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SnapshotCreate) SetID(u uuid.UUID) *SnapshotCreate {
 	sc.mutation.SetID(u)
@@ -134,6 +170,21 @@ func (sc *SnapshotCreate) SetEnv(e *Env) *SnapshotCreate {
 	return sc.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (sc *SnapshotCreate) AddCheckpointIDs(ids ...uuid.UUID) *SnapshotCreate {
+	sc.mutation.AddCheckpointIDs(ids...)
+	return sc
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (sc *SnapshotCreate) AddCheckpoints(s ...*SnapshotCheckpoint) *SnapshotCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the SnapshotMutation object of the builder.
 func (sc *SnapshotCreate) Mutation() *SnapshotMutation {
 	return sc.mutation
@@ -181,6 +232,14 @@ func (sc *SnapshotCreate) defaults() {
 		v := snapshot.DefaultAutoPause
 		sc.mutation.SetAutoPause(v)
 	}
+	if _, ok := sc.mutation.IdleTimeout(); !ok {
+		v := snapshot.DefaultIdleTimeout
+		sc.mutation.SetIdleTimeout(v)
+	}
+	if _, ok := sc.mutation.MemoryLimitMB(); !ok {
+		v := snapshot.DefaultMemoryLimitMB
+		sc.mutation.SetMemoryLimitMB(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -215,6 +274,12 @@ func (sc *SnapshotCreate) check() error {
 	if _, ok := sc.mutation.TeamID(); !ok {
 		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "Snapshot.team_id"`)}
 	}
+	if _, ok := sc.mutation.IdleTimeout(); !ok {
+		return &ValidationError{Name: "idle_timeout", err: errors.New(`models: missing required field "Snapshot.idle_timeout"`)}
+	}
+	if _, ok := sc.mutation.MemoryLimitMB(); !ok {
+		return &ValidationError{Name: "memory_limit_mb", err: errors.New(`models: missing required field "Snapshot.memory_limit_mb"`)}
+	}
 	if _, ok := sc.mutation.EnvID(); !ok {
 		return &ValidationError{Name: "env", err: errors.New(`models: missing required edge "Snapshot.env"`)}
 	}
@@ -295,6 +360,18 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldAllowInternetAccess, field.TypeBool, value)
 		_node.AllowInternetAccess = &value
 	}
+	if value, ok := sc.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+		_node.Network = value
+	}
+	if value, ok := sc.mutation.IdleTimeout(); ok {
+		_spec.SetField(snapshot.FieldIdleTimeout, field.TypeInt64, value)
+		_node.IdleTimeout = value
+	}
+	if value, ok := sc.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(snapshot.FieldMemoryLimitMB, field.TypeInt64, value)
+		_node.MemoryLimitMB = value
+	}
 	if nodes := sc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -313,6 +390,23 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_node.EnvID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = sc.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -491,6 +585,60 @@ func (u *SnapshotUpsert) ClearAllowInternetAccess() *SnapshotUpsert {
 	return u
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsert) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsert {
+	u.Set(snapshot.FieldNetwork, v)
+	return u
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateNetwork() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldNetwork)
+	return u
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsert) ClearNetwork() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldNetwork)
+	return u
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *SnapshotUpsert) SetIdleTimeout(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldIdleTimeout, v)
+	return u
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateIdleTimeout() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldIdleTimeout)
+	return u
+}
+
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *SnapshotUpsert) AddIdleTimeout(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldIdleTimeout, v)
+	return u
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *SnapshotUpsert) SetMemoryLimitMB(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldMemoryLimitMB, v)
+	return u
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateMemoryLimitMB() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldMemoryLimitMB)
+	return u
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *SnapshotUpsert) AddMemoryLimitMB(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldMemoryLimitMB, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -689,6 +837,69 @@ func (u *SnapshotUpsertOne) ClearAllowInternetAccess() *SnapshotUpsertOne {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertOne) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertOne) ClearNetwork() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *SnapshotUpsertOne) SetIdleTimeout(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *SnapshotUpsertOne) AddIdleTimeout(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateIdleTimeout() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *SnapshotUpsertOne) SetMemoryLimitMB(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetMemoryLimitMB(v)
+	})
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *SnapshotUpsertOne) AddMemoryLimitMB(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddMemoryLimitMB(v)
+	})
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateMemoryLimitMB() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateMemoryLimitMB()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1054,6 +1265,69 @@ func (u *SnapshotUpsertBulk) ClearAllowInternetAccess() *SnapshotUpsertBulk {
 	})
 }
 
+// SetNetwork sets the "network" field.
+func (u *SnapshotUpsertBulk) SetNetwork(v *schema.SandboxNetworkConfig) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetwork(v)
+	})
+}
+
+// UpdateNetwork sets the "network" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetwork()
+	})
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (u *SnapshotUpsertBulk) ClearNetwork() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetwork()
+	})
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (u *SnapshotUpsertBulk) SetIdleTimeout(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetIdleTimeout(v)
+	})
+}
+
+// AddIdleTimeout adds v to the "idle_timeout" field.
+func (u *SnapshotUpsertBulk) AddIdleTimeout(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddIdleTimeout(v)
+	})
+}
+
+// UpdateIdleTimeout sets the "idle_timeout" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateIdleTimeout() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateIdleTimeout()
+	})
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *SnapshotUpsertBulk) SetMemoryLimitMB(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetMemoryLimitMB(v)
+	})
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *SnapshotUpsertBulk) AddMemoryLimitMB(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddMemoryLimitMB(v)
+	})
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateMemoryLimitMB() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateMemoryLimitMB()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
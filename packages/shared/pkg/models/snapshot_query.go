@@ -4,6 +4,7 @@ package models
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,18 +15,20 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
 	"github.com/google/uuid"
 )
 
 // SnapshotQuery is the builder for querying Snapshot entities.
 type SnapshotQuery struct {
 	config
-	ctx        *QueryContext
-	order      []snapshot.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Snapshot
-	withEnv    *EnvQuery
-	modifiers  []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []snapshot.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Snapshot
+	withEnv         *EnvQuery
+	withCheckpoints *SnapshotCheckpointQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +90,31 @@ func (sq *SnapshotQuery) QueryEnv() *EnvQuery {
 	return query
 }
 
+// QueryCheckpoints chains the current query on the "checkpoints" edge.
+func (sq *SnapshotQuery) QueryCheckpoints() *SnapshotCheckpointQuery {
+	query := (&SnapshotCheckpointClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshot.Table, snapshot.FieldID, selector),
+			sqlgraph.To(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snapshot.CheckpointsTable, snapshot.CheckpointsColumn),
+		)
+		schemaConfig := sq.schemaConfig
+		step.To.Schema = schemaConfig.SnapshotCheckpoint
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Snapshot entity from the query.
 // Returns a *NotFoundError when no Snapshot was found.
 func (sq *SnapshotQuery) First(ctx context.Context) (*Snapshot, error) {
@@ -274,12 +302,13 @@ func (sq *SnapshotQuery) Clone() *SnapshotQuery {
 		return nil
 	}
 	return &SnapshotQuery{
-		config:     sq.config,
-		ctx:        sq.ctx.Clone(),
-		order:      append([]snapshot.OrderOption{}, sq.order...),
-		inters:     append([]Interceptor{}, sq.inters...),
-		predicates: append([]predicate.Snapshot{}, sq.predicates...),
-		withEnv:    sq.withEnv.Clone(),
+		config:          sq.config,
+		ctx:             sq.ctx.Clone(),
+		order:           append([]snapshot.OrderOption{}, sq.order...),
+		inters:          append([]Interceptor{}, sq.inters...),
+		predicates:      append([]predicate.Snapshot{}, sq.predicates...),
+		withEnv:         sq.withEnv.Clone(),
+		withCheckpoints: sq.withCheckpoints.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -297,6 +326,17 @@ func (sq *SnapshotQuery) WithEnv(opts ...func(*EnvQuery)) *SnapshotQuery {
 	return sq
 }
 
+// WithCheckpoints tells the query-builder to eager-load the nodes that are connected to
+// the "checkpoints" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SnapshotQuery) WithCheckpoints(opts ...func(*SnapshotCheckpointQuery)) *SnapshotQuery {
+	query := (&SnapshotCheckpointClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withCheckpoints = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -375,8 +415,9 @@ func (sq *SnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sna
 	var (
 		nodes       = []*Snapshot{}
 		_spec       = sq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			sq.withEnv != nil,
+			sq.withCheckpoints != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -408,6 +449,13 @@ func (sq *SnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sna
 			return nil, err
 		}
 	}
+	if query := sq.withCheckpoints; query != nil {
+		if err := sq.loadCheckpoints(ctx, query, nodes,
+			func(n *Snapshot) { n.Edges.Checkpoints = []*SnapshotCheckpoint{} },
+			func(n *Snapshot, e *SnapshotCheckpoint) { n.Edges.Checkpoints = append(n.Edges.Checkpoints, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -440,6 +488,36 @@ func (sq *SnapshotQuery) loadEnv(ctx context.Context, query *EnvQuery, nodes []*
 	}
 	return nil
 }
+func (sq *SnapshotQuery) loadCheckpoints(ctx context.Context, query *SnapshotCheckpointQuery, nodes []*Snapshot, init func(*Snapshot), assign func(*Snapshot, *SnapshotCheckpoint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Snapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(snapshotcheckpoint.FieldSnapshotID)
+	}
+	query.Where(predicate.SnapshotCheckpoint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(snapshot.CheckpointsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SnapshotID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "snapshot_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SnapshotQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
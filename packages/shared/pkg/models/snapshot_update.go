@@ -15,6 +15,8 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 	"github.com/google/uuid"
 )
 
@@ -170,11 +172,80 @@ func (su *SnapshotUpdate) ClearAllowInternetAccess() *SnapshotUpdate {
 	return su
 }
 
+// SetNetwork sets the "network" field.
+func (su *SnapshotUpdate) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotUpdate {
+	su.mutation.SetNetwork(snc)
+	return su
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (su *SnapshotUpdate) ClearNetwork() *SnapshotUpdate {
+	su.mutation.ClearNetwork()
+	return su
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (su *SnapshotUpdate) SetIdleTimeout(i int64) *SnapshotUpdate {
+	su.mutation.ResetIdleTimeout()
+	su.mutation.SetIdleTimeout(i)
+	return su
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableIdleTimeout(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetIdleTimeout(*i)
+	}
+	return su
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (su *SnapshotUpdate) AddIdleTimeout(i int64) *SnapshotUpdate {
+	su.mutation.AddIdleTimeout(i)
+	return su
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (su *SnapshotUpdate) SetMemoryLimitMB(i int64) *SnapshotUpdate {
+	su.mutation.ResetMemoryLimitMB()
+	su.mutation.SetMemoryLimitMB(i)
+	return su
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableMemoryLimitMB(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetMemoryLimitMB(*i)
+	}
+	return su
+}
+
+// AddMemoryLimitMB adds i to the "memory_limit_mb" field.
+func (su *SnapshotUpdate) AddMemoryLimitMB(i int64) *SnapshotUpdate {
+	su.mutation.AddMemoryLimitMB(i)
+	return su
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (su *SnapshotUpdate) SetEnv(e *Env) *SnapshotUpdate {
 	return su.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (su *SnapshotUpdate) AddCheckpointIDs(ids ...uuid.UUID) *SnapshotUpdate {
+	su.mutation.AddCheckpointIDs(ids...)
+	return su
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (su *SnapshotUpdate) AddCheckpoints(s ...*SnapshotCheckpoint) *SnapshotUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the SnapshotMutation object of the builder.
 func (su *SnapshotUpdate) Mutation() *SnapshotMutation {
 	return su.mutation
@@ -186,6 +257,27 @@ func (su *SnapshotUpdate) ClearEnv() *SnapshotUpdate {
 	return su
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the SnapshotCheckpoint entity.
+func (su *SnapshotUpdate) ClearCheckpoints() *SnapshotUpdate {
+	su.mutation.ClearCheckpoints()
+	return su
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to SnapshotCheckpoint entities by IDs.
+func (su *SnapshotUpdate) RemoveCheckpointIDs(ids ...uuid.UUID) *SnapshotUpdate {
+	su.mutation.RemoveCheckpointIDs(ids...)
+	return su
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to SnapshotCheckpoint entities.
+func (su *SnapshotUpdate) RemoveCheckpoints(s ...*SnapshotCheckpoint) *SnapshotUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveCheckpointIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SnapshotUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -269,6 +361,24 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.AllowInternetAccessCleared() {
 		_spec.ClearField(snapshot.FieldAllowInternetAccess, field.TypeBool)
 	}
+	if value, ok := su.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if su.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
+	if value, ok := su.mutation.IdleTimeout(); ok {
+		_spec.SetField(snapshot.FieldIdleTimeout, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedIdleTimeout(); ok {
+		_spec.AddField(snapshot.FieldIdleTimeout, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(snapshot.FieldMemoryLimitMB, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedMemoryLimitMB(); ok {
+		_spec.AddField(snapshot.FieldMemoryLimitMB, field.TypeInt64, value)
+	}
 	if su.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -300,6 +410,54 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = su.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !su.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = su.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = su.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = su.schemaConfig.Snapshot
 	ctx = internal.NewSchemaConfigContext(ctx, su.schemaConfig)
 	_spec.AddModifiers(su.modifiers...)
@@ -462,11 +620,80 @@ func (suo *SnapshotUpdateOne) ClearAllowInternetAccess() *SnapshotUpdateOne {
 	return suo
 }
 
+// SetNetwork sets the "network" field.
+func (suo *SnapshotUpdateOne) SetNetwork(snc *schema.SandboxNetworkConfig) *SnapshotUpdateOne {
+	suo.mutation.SetNetwork(snc)
+	return suo
+}
+
+// ClearNetwork clears the value of the "network" field.
+func (suo *SnapshotUpdateOne) ClearNetwork() *SnapshotUpdateOne {
+	suo.mutation.ClearNetwork()
+	return suo
+}
+
+// SetIdleTimeout sets the "idle_timeout" field.
+func (suo *SnapshotUpdateOne) SetIdleTimeout(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetIdleTimeout()
+	suo.mutation.SetIdleTimeout(i)
+	return suo
+}
+
+// SetNillableIdleTimeout sets the "idle_timeout" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableIdleTimeout(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetIdleTimeout(*i)
+	}
+	return suo
+}
+
+// AddIdleTimeout adds i to the "idle_timeout" field.
+func (suo *SnapshotUpdateOne) AddIdleTimeout(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddIdleTimeout(i)
+	return suo
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (suo *SnapshotUpdateOne) SetMemoryLimitMB(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetMemoryLimitMB()
+	suo.mutation.SetMemoryLimitMB(i)
+	return suo
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableMemoryLimitMB(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetMemoryLimitMB(*i)
+	}
+	return suo
+}
+
+// AddMemoryLimitMB adds i to the "memory_limit_mb" field.
+func (suo *SnapshotUpdateOne) AddMemoryLimitMB(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddMemoryLimitMB(i)
+	return suo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (suo *SnapshotUpdateOne) SetEnv(e *Env) *SnapshotUpdateOne {
 	return suo.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the SnapshotCheckpoint entity by IDs.
+func (suo *SnapshotUpdateOne) AddCheckpointIDs(ids ...uuid.UUID) *SnapshotUpdateOne {
+	suo.mutation.AddCheckpointIDs(ids...)
+	return suo
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the SnapshotCheckpoint entity.
+func (suo *SnapshotUpdateOne) AddCheckpoints(s ...*SnapshotCheckpoint) *SnapshotUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddCheckpointIDs(ids...)
+}
+
 // Mutation returns the SnapshotMutation object of the builder.
 func (suo *SnapshotUpdateOne) Mutation() *SnapshotMutation {
 	return suo.mutation
@@ -478,6 +705,27 @@ func (suo *SnapshotUpdateOne) ClearEnv() *SnapshotUpdateOne {
 	return suo
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the SnapshotCheckpoint entity.
+func (suo *SnapshotUpdateOne) ClearCheckpoints() *SnapshotUpdateOne {
+	suo.mutation.ClearCheckpoints()
+	return suo
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to SnapshotCheckpoint entities by IDs.
+func (suo *SnapshotUpdateOne) RemoveCheckpointIDs(ids ...uuid.UUID) *SnapshotUpdateOne {
+	suo.mutation.RemoveCheckpointIDs(ids...)
+	return suo
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to SnapshotCheckpoint entities.
+func (suo *SnapshotUpdateOne) RemoveCheckpoints(s ...*SnapshotCheckpoint) *SnapshotUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveCheckpointIDs(ids...)
+}
+
 // Where appends a list predicates to the SnapshotUpdate builder.
 func (suo *SnapshotUpdateOne) Where(ps ...predicate.Snapshot) *SnapshotUpdateOne {
 	suo.mutation.Where(ps...)
@@ -591,6 +839,24 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 	if suo.mutation.AllowInternetAccessCleared() {
 		_spec.ClearField(snapshot.FieldAllowInternetAccess, field.TypeBool)
 	}
+	if value, ok := suo.mutation.Network(); ok {
+		_spec.SetField(snapshot.FieldNetwork, field.TypeJSON, value)
+	}
+	if suo.mutation.NetworkCleared() {
+		_spec.ClearField(snapshot.FieldNetwork, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.IdleTimeout(); ok {
+		_spec.SetField(snapshot.FieldIdleTimeout, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedIdleTimeout(); ok {
+		_spec.AddField(snapshot.FieldIdleTimeout, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(snapshot.FieldMemoryLimitMB, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedMemoryLimitMB(); ok {
+		_spec.AddField(snapshot.FieldMemoryLimitMB, field.TypeInt64, value)
+	}
 	if suo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -622,6 +888,54 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = suo.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !suo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = suo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   snapshot.CheckpointsTable,
+			Columns: []string{snapshot.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = suo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = suo.schemaConfig.Snapshot
 	ctx = internal.NewSchemaConfigContext(ctx, suo.schemaConfig)
 	_spec.AddModifiers(suo.modifiers...)
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/google/uuid"
+)
+
+// SnapshotCheckpoint is the model entity for the SnapshotCheckpoint schema.
+type SnapshotCheckpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SnapshotID holds the value of the "snapshot_id" field.
+	SnapshotID uuid.UUID `json:"snapshot_id,omitempty"`
+	// BuildID holds the value of the "build_id" field.
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SnapshotCheckpointQuery when eager-loading is set.
+	Edges        SnapshotCheckpointEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SnapshotCheckpointEdges holds the relations/edges for other nodes in the graph.
+type SnapshotCheckpointEdges struct {
+	// Snapshot holds the value of the snapshot edge.
+	Snapshot *Snapshot `json:"snapshot,omitempty"`
+	// Build holds the value of the build edge.
+	Build *EnvBuild `json:"build,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SnapshotOrErr returns the Snapshot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotCheckpointEdges) SnapshotOrErr() (*Snapshot, error) {
+	if e.loadedTypes[0] {
+		if e.Snapshot == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: snapshot.Label}
+		}
+		return e.Snapshot, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshot"}
+}
+
+// BuildOrErr returns the Build value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotCheckpointEdges) BuildOrErr() (*EnvBuild, error) {
+	if e.loadedTypes[1] {
+		if e.Build == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: envbuild.Label}
+		}
+		return e.Build, nil
+	}
+	return nil, &NotLoadedError{edge: "build"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SnapshotCheckpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case snapshotcheckpoint.FieldName:
+			values[i] = new(sql.NullString)
+		case snapshotcheckpoint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case snapshotcheckpoint.FieldID, snapshotcheckpoint.FieldSnapshotID, snapshotcheckpoint.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SnapshotCheckpoint fields.
+func (sc *SnapshotCheckpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case snapshotcheckpoint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sc.ID = *value
+			}
+		case snapshotcheckpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case snapshotcheckpoint.FieldSnapshotID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_id", values[i])
+			} else if value != nil {
+				sc.SnapshotID = *value
+			}
+		case snapshotcheckpoint.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				sc.BuildID = *value
+			}
+		case snapshotcheckpoint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sc.Name = value.String
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SnapshotCheckpoint.
+// This includes values selected through modifiers, order, etc.
+func (sc *SnapshotCheckpoint) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QuerySnapshot queries the "snapshot" edge of the SnapshotCheckpoint entity.
+func (sc *SnapshotCheckpoint) QuerySnapshot() *SnapshotQuery {
+	return NewSnapshotCheckpointClient(sc.config).QuerySnapshot(sc)
+}
+
+// QueryBuild queries the "build" edge of the SnapshotCheckpoint entity.
+func (sc *SnapshotCheckpoint) QueryBuild() *EnvBuildQuery {
+	return NewSnapshotCheckpointClient(sc.config).QueryBuild(sc)
+}
+
+// Update returns a builder for updating this SnapshotCheckpoint.
+// Note that you need to call SnapshotCheckpoint.Unwrap() before calling this method if this SnapshotCheckpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SnapshotCheckpoint) Update() *SnapshotCheckpointUpdateOne {
+	return NewSnapshotCheckpointClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SnapshotCheckpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SnapshotCheckpoint) Unwrap() *SnapshotCheckpoint {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("models: SnapshotCheckpoint is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SnapshotCheckpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("SnapshotCheckpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot_id=")
+	builder.WriteString(fmt.Sprintf("%v", sc.SnapshotID))
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", sc.BuildID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sc.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SnapshotCheckpoints is a parsable slice of SnapshotCheckpoint.
+type SnapshotCheckpoints []*SnapshotCheckpoint
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package snapshotcheckpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the snapshotcheckpoint type in the database.
+	Label = "snapshot_checkpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldSnapshotID holds the string denoting the snapshot_id field in the database.
+	FieldSnapshotID = "snapshot_id"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeSnapshot holds the string denoting the snapshot edge name in mutations.
+	EdgeSnapshot = "snapshot"
+	// EdgeBuild holds the string denoting the build edge name in mutations.
+	EdgeBuild = "build"
+	// Table holds the table name of the snapshotcheckpoint in the database.
+	Table = "snapshot_checkpoints"
+	// SnapshotTable is the table that holds the snapshot relation/edge.
+	SnapshotTable = "snapshot_checkpoints"
+	// SnapshotInverseTable is the table name for the Snapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshot" package.
+	SnapshotInverseTable = "snapshots"
+	// SnapshotColumn is the table column denoting the snapshot relation/edge.
+	SnapshotColumn = "snapshot_id"
+	// BuildTable is the table that holds the build relation/edge.
+	BuildTable = "snapshot_checkpoints"
+	// BuildInverseTable is the table name for the EnvBuild entity.
+	// It exists in this package in order to avoid circular dependency with the "envbuild" package.
+	BuildInverseTable = "env_builds"
+	// BuildColumn is the table column denoting the build relation/edge.
+	BuildColumn = "build_id"
+)
+
+// Columns holds all SQL columns for snapshotcheckpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldSnapshotID,
+	FieldBuildID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SnapshotCheckpoint queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySnapshotID orders the results by the snapshot_id field.
+func BySnapshotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSnapshotID, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySnapshotField orders the results by snapshot field.
+func BySnapshotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBuildField orders the results by build field.
+func ByBuildField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSnapshotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+	)
+}
+func newBuildStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+	)
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package snapshotcheckpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SnapshotID applies equality check predicate on the "snapshot_id" field. It's identical to SnapshotIDEQ.
+func SnapshotID(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldSnapshotID, v))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldBuildID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// SnapshotIDEQ applies the EQ predicate on the "snapshot_id" field.
+func SnapshotIDEQ(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldSnapshotID, v))
+}
+
+// SnapshotIDNEQ applies the NEQ predicate on the "snapshot_id" field.
+func SnapshotIDNEQ(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNEQ(FieldSnapshotID, v))
+}
+
+// SnapshotIDIn applies the In predicate on the "snapshot_id" field.
+func SnapshotIDIn(vs ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldIn(FieldSnapshotID, vs...))
+}
+
+// SnapshotIDNotIn applies the NotIn predicate on the "snapshot_id" field.
+func SnapshotIDNotIn(vs ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNotIn(FieldSnapshotID, vs...))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...uuid.UUID) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.FieldContainsFold(FieldName, v))
+}
+
+// HasSnapshot applies the HasEdge predicate on the "snapshot" edge.
+func HasSnapshot() predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SnapshotTable, SnapshotColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Snapshot
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotWith applies the HasEdge predicate on the "snapshot" edge with a given conditions (other predicates).
+func HasSnapshotWith(preds ...predicate.Snapshot) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(func(s *sql.Selector) {
+		step := newSnapshotStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Snapshot
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuild applies the HasEdge predicate on the "build" edge.
+func HasBuild() predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildWith applies the HasEdge predicate on the "build" edge with a given conditions (other predicates).
+func HasBuildWith(preds ...predicate.EnvBuild) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(func(s *sql.Selector) {
+		step := newBuildStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SnapshotCheckpoint) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SnapshotCheckpoint) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SnapshotCheckpoint) predicate.SnapshotCheckpoint {
+	return predicate.SnapshotCheckpoint(sql.NotPredicates(p))
+}
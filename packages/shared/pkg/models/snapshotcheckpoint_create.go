@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/google/uuid"
+)
+
+// SnapshotCheckpointCreate is the builder for creating a SnapshotCheckpoint entity.
+type SnapshotCheckpointCreate struct {
+	config
+	mutation *SnapshotCheckpointMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SnapshotCheckpointCreate) SetCreatedAt(t time.Time) *SnapshotCheckpointCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SnapshotCheckpointCreate) SetNillableCreatedAt(t *time.Time) *SnapshotCheckpointCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (scc *SnapshotCheckpointCreate) SetSnapshotID(u uuid.UUID) *SnapshotCheckpointCreate {
+	scc.mutation.SetSnapshotID(u)
+	return scc
+}
+
+// SetBuildID sets the "build_id" field.
+func (scc *SnapshotCheckpointCreate) SetBuildID(u uuid.UUID) *SnapshotCheckpointCreate {
+	scc.mutation.SetBuildID(u)
+	return scc
+}
+
+// SetName sets the "name" field.
+func (scc *SnapshotCheckpointCreate) SetName(s string) *SnapshotCheckpointCreate {
+	scc.mutation.SetName(s)
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SnapshotCheckpointCreate) SetID(u uuid.UUID) *SnapshotCheckpointCreate {
+	scc.mutation.SetID(u)
+	return scc
+}
+
+// SetSnapshot sets the "snapshot" edge to the Snapshot entity.
+func (scc *SnapshotCheckpointCreate) SetSnapshot(s *Snapshot) *SnapshotCheckpointCreate {
+	return scc.SetSnapshotID(s.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (scc *SnapshotCheckpointCreate) SetBuild(e *EnvBuild) *SnapshotCheckpointCreate {
+	return scc.SetBuildID(e.ID)
+}
+
+// Mutation returns the SnapshotCheckpointMutation object of the builder.
+func (scc *SnapshotCheckpointCreate) Mutation() *SnapshotCheckpointMutation {
+	return scc.mutation
+}
+
+// Save creates the SnapshotCheckpoint in the database.
+func (scc *SnapshotCheckpointCreate) Save(ctx context.Context) (*SnapshotCheckpoint, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SnapshotCheckpointCreate) SaveX(ctx context.Context) *SnapshotCheckpoint {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SnapshotCheckpointCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SnapshotCheckpointCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SnapshotCheckpointCreate) defaults() {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := snapshotcheckpoint.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SnapshotCheckpointCreate) check() error {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "SnapshotCheckpoint.created_at"`)}
+	}
+	if _, ok := scc.mutation.SnapshotID(); !ok {
+		return &ValidationError{Name: "snapshot_id", err: errors.New(`models: missing required field "SnapshotCheckpoint.snapshot_id"`)}
+	}
+	if _, ok := scc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "SnapshotCheckpoint.build_id"`)}
+	}
+	if _, ok := scc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`models: missing required field "SnapshotCheckpoint.name"`)}
+	}
+	if _, ok := scc.mutation.SnapshotID(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`models: missing required edge "SnapshotCheckpoint.snapshot"`)}
+	}
+	if _, ok := scc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build", err: errors.New(`models: missing required edge "SnapshotCheckpoint.build"`)}
+	}
+	return nil
+}
+
+func (scc *SnapshotCheckpointCreate) sqlSave(ctx context.Context) (*SnapshotCheckpoint, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SnapshotCheckpointCreate) createSpec() (*SnapshotCheckpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SnapshotCheckpoint{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(snapshotcheckpoint.Table, sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = scc.schemaConfig.SnapshotCheckpoint
+	_spec.OnConflict = scc.conflict
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(snapshotcheckpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scc.mutation.Name(); ok {
+		_spec.SetField(snapshotcheckpoint.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := scc.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.SnapshotTable,
+			Columns: []string{snapshotcheckpoint.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scc.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SnapshotID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.BuildTable,
+			Columns: []string{snapshotcheckpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scc.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SnapshotCheckpoint.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SnapshotCheckpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scc *SnapshotCheckpointCreate) OnConflict(opts ...sql.ConflictOption) *SnapshotCheckpointUpsertOne {
+	scc.conflict = opts
+	return &SnapshotCheckpointUpsertOne{
+		create: scc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scc *SnapshotCheckpointCreate) OnConflictColumns(columns ...string) *SnapshotCheckpointUpsertOne {
+	scc.conflict = append(scc.conflict, sql.ConflictColumns(columns...))
+	return &SnapshotCheckpointUpsertOne{
+		create: scc,
+	}
+}
+
+type (
+	// SnapshotCheckpointUpsertOne is the builder for "upsert"-ing
+	//  one SnapshotCheckpoint node.
+	SnapshotCheckpointUpsertOne struct {
+		create *SnapshotCheckpointCreate
+	}
+
+	// SnapshotCheckpointUpsert is the "OnConflict" setter.
+	SnapshotCheckpointUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (u *SnapshotCheckpointUpsert) SetSnapshotID(v uuid.UUID) *SnapshotCheckpointUpsert {
+	u.Set(snapshotcheckpoint.FieldSnapshotID, v)
+	return u
+}
+
+// UpdateSnapshotID sets the "snapshot_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsert) UpdateSnapshotID() *SnapshotCheckpointUpsert {
+	u.SetExcluded(snapshotcheckpoint.FieldSnapshotID)
+	return u
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SnapshotCheckpointUpsert) SetBuildID(v uuid.UUID) *SnapshotCheckpointUpsert {
+	u.Set(snapshotcheckpoint.FieldBuildID, v)
+	return u
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsert) UpdateBuildID() *SnapshotCheckpointUpsert {
+	u.SetExcluded(snapshotcheckpoint.FieldBuildID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotCheckpointUpsert) SetName(v string) *SnapshotCheckpointUpsert {
+	u.Set(snapshotcheckpoint.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsert) UpdateName() *SnapshotCheckpointUpsert {
+	u.SetExcluded(snapshotcheckpoint.FieldName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(snapshotcheckpoint.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SnapshotCheckpointUpsertOne) UpdateNewValues() *SnapshotCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(snapshotcheckpoint.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(snapshotcheckpoint.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SnapshotCheckpointUpsertOne) Ignore() *SnapshotCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SnapshotCheckpointUpsertOne) DoNothing() *SnapshotCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SnapshotCheckpointCreate.OnConflict
+// documentation for more info.
+func (u *SnapshotCheckpointUpsertOne) Update(set func(*SnapshotCheckpointUpsert)) *SnapshotCheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SnapshotCheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (u *SnapshotCheckpointUpsertOne) SetSnapshotID(v uuid.UUID) *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetSnapshotID(v)
+	})
+}
+
+// UpdateSnapshotID sets the "snapshot_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertOne) UpdateSnapshotID() *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateSnapshotID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SnapshotCheckpointUpsertOne) SetBuildID(v uuid.UUID) *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertOne) UpdateBuildID() *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotCheckpointUpsertOne) SetName(v string) *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertOne) UpdateName() *SnapshotCheckpointUpsertOne {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *SnapshotCheckpointUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SnapshotCheckpointCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SnapshotCheckpointUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SnapshotCheckpointUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: SnapshotCheckpointUpsertOne.ID is not supported by MySQL driver. Use SnapshotCheckpointUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SnapshotCheckpointUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SnapshotCheckpointCreateBulk is the builder for creating many SnapshotCheckpoint entities in bulk.
+type SnapshotCheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*SnapshotCheckpointCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SnapshotCheckpoint entities in the database.
+func (sccb *SnapshotCheckpointCreateBulk) Save(ctx context.Context) ([]*SnapshotCheckpoint, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SnapshotCheckpoint, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SnapshotCheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SnapshotCheckpointCreateBulk) SaveX(ctx context.Context) []*SnapshotCheckpoint {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SnapshotCheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SnapshotCheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SnapshotCheckpoint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SnapshotCheckpointUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sccb *SnapshotCheckpointCreateBulk) OnConflict(opts ...sql.ConflictOption) *SnapshotCheckpointUpsertBulk {
+	sccb.conflict = opts
+	return &SnapshotCheckpointUpsertBulk{
+		create: sccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sccb *SnapshotCheckpointCreateBulk) OnConflictColumns(columns ...string) *SnapshotCheckpointUpsertBulk {
+	sccb.conflict = append(sccb.conflict, sql.ConflictColumns(columns...))
+	return &SnapshotCheckpointUpsertBulk{
+		create: sccb,
+	}
+}
+
+// SnapshotCheckpointUpsertBulk is the builder for "upsert"-ing
+// a bulk of SnapshotCheckpoint nodes.
+type SnapshotCheckpointUpsertBulk struct {
+	create *SnapshotCheckpointCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(snapshotcheckpoint.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SnapshotCheckpointUpsertBulk) UpdateNewValues() *SnapshotCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(snapshotcheckpoint.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(snapshotcheckpoint.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SnapshotCheckpoint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SnapshotCheckpointUpsertBulk) Ignore() *SnapshotCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SnapshotCheckpointUpsertBulk) DoNothing() *SnapshotCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SnapshotCheckpointCreateBulk.OnConflict
+// documentation for more info.
+func (u *SnapshotCheckpointUpsertBulk) Update(set func(*SnapshotCheckpointUpsert)) *SnapshotCheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SnapshotCheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (u *SnapshotCheckpointUpsertBulk) SetSnapshotID(v uuid.UUID) *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetSnapshotID(v)
+	})
+}
+
+// UpdateSnapshotID sets the "snapshot_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertBulk) UpdateSnapshotID() *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateSnapshotID()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *SnapshotCheckpointUpsertBulk) SetBuildID(v uuid.UUID) *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertBulk) UpdateBuildID() *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SnapshotCheckpointUpsertBulk) SetName(v string) *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SnapshotCheckpointUpsertBulk) UpdateName() *SnapshotCheckpointUpsertBulk {
+	return u.Update(func(s *SnapshotCheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *SnapshotCheckpointUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the SnapshotCheckpointCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for SnapshotCheckpointCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SnapshotCheckpointUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+)
+
+// SnapshotCheckpointDelete is the builder for deleting a SnapshotCheckpoint entity.
+type SnapshotCheckpointDelete struct {
+	config
+	hooks    []Hook
+	mutation *SnapshotCheckpointMutation
+}
+
+// Where appends a list predicates to the SnapshotCheckpointDelete builder.
+func (scd *SnapshotCheckpointDelete) Where(ps ...predicate.SnapshotCheckpoint) *SnapshotCheckpointDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *SnapshotCheckpointDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *SnapshotCheckpointDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *SnapshotCheckpointDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(snapshotcheckpoint.Table, sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID))
+	_spec.Node.Schema = scd.schemaConfig.SnapshotCheckpoint
+	ctx = internal.NewSchemaConfigContext(ctx, scd.schemaConfig)
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// SnapshotCheckpointDeleteOne is the builder for deleting a single SnapshotCheckpoint entity.
+type SnapshotCheckpointDeleteOne struct {
+	scd *SnapshotCheckpointDelete
+}
+
+// Where appends a list predicates to the SnapshotCheckpointDelete builder.
+func (scdo *SnapshotCheckpointDeleteOne) Where(ps ...predicate.SnapshotCheckpoint) *SnapshotCheckpointDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *SnapshotCheckpointDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{snapshotcheckpoint.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *SnapshotCheckpointDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
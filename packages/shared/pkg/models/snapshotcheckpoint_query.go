@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/google/uuid"
+)
+
+// SnapshotCheckpointQuery is the builder for querying SnapshotCheckpoint entities.
+type SnapshotCheckpointQuery struct {
+	config
+	ctx          *QueryContext
+	order        []snapshotcheckpoint.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.SnapshotCheckpoint
+	withSnapshot *SnapshotQuery
+	withBuild    *EnvBuildQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SnapshotCheckpointQuery builder.
+func (scq *SnapshotCheckpointQuery) Where(ps ...predicate.SnapshotCheckpoint) *SnapshotCheckpointQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *SnapshotCheckpointQuery) Limit(limit int) *SnapshotCheckpointQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *SnapshotCheckpointQuery) Offset(offset int) *SnapshotCheckpointQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *SnapshotCheckpointQuery) Unique(unique bool) *SnapshotCheckpointQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *SnapshotCheckpointQuery) Order(o ...snapshotcheckpoint.OrderOption) *SnapshotCheckpointQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QuerySnapshot chains the current query on the "snapshot" edge.
+func (scq *SnapshotCheckpointQuery) QuerySnapshot() *SnapshotQuery {
+	query := (&SnapshotClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID, selector),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotcheckpoint.SnapshotTable, snapshotcheckpoint.SnapshotColumn),
+		)
+		schemaConfig := scq.schemaConfig
+		step.To.Schema = schemaConfig.Snapshot
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuild chains the current query on the "build" edge.
+func (scq *SnapshotCheckpointQuery) QueryBuild() *EnvBuildQuery {
+	query := (&EnvBuildClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(snapshotcheckpoint.Table, snapshotcheckpoint.FieldID, selector),
+			sqlgraph.To(envbuild.Table, envbuild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snapshotcheckpoint.BuildTable, snapshotcheckpoint.BuildColumn),
+		)
+		schemaConfig := scq.schemaConfig
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.SnapshotCheckpoint
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SnapshotCheckpoint entity from the query.
+// Returns a *NotFoundError when no SnapshotCheckpoint was found.
+func (scq *SnapshotCheckpointQuery) First(ctx context.Context) (*SnapshotCheckpoint, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{snapshotcheckpoint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) FirstX(ctx context.Context) *SnapshotCheckpoint {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SnapshotCheckpoint ID from the query.
+// Returns a *NotFoundError when no SnapshotCheckpoint ID was found.
+func (scq *SnapshotCheckpointQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{snapshotcheckpoint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SnapshotCheckpoint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SnapshotCheckpoint entity is found.
+// Returns a *NotFoundError when no SnapshotCheckpoint entities are found.
+func (scq *SnapshotCheckpointQuery) Only(ctx context.Context) (*SnapshotCheckpoint, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{snapshotcheckpoint.Label}
+	default:
+		return nil, &NotSingularError{snapshotcheckpoint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) OnlyX(ctx context.Context) *SnapshotCheckpoint {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SnapshotCheckpoint ID in the query.
+// Returns a *NotSingularError when more than one SnapshotCheckpoint ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *SnapshotCheckpointQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{snapshotcheckpoint.Label}
+	default:
+		err = &NotSingularError{snapshotcheckpoint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SnapshotCheckpoints.
+func (scq *SnapshotCheckpointQuery) All(ctx context.Context) ([]*SnapshotCheckpoint, error) {
+	ctx = setContextOp(ctx, scq.ctx, "All")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SnapshotCheckpoint, *SnapshotCheckpointQuery]()
+	return withInterceptors[[]*SnapshotCheckpoint](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) AllX(ctx context.Context) []*SnapshotCheckpoint {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SnapshotCheckpoint IDs.
+func (scq *SnapshotCheckpointQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, "IDs")
+	if err = scq.Select(snapshotcheckpoint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *SnapshotCheckpointQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Count")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*SnapshotCheckpointQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *SnapshotCheckpointQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Exist")
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *SnapshotCheckpointQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SnapshotCheckpointQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *SnapshotCheckpointQuery) Clone() *SnapshotCheckpointQuery {
+	if scq == nil {
+		return nil
+	}
+	return &SnapshotCheckpointQuery{
+		config:       scq.config,
+		ctx:          scq.ctx.Clone(),
+		order:        append([]snapshotcheckpoint.OrderOption{}, scq.order...),
+		inters:       append([]Interceptor{}, scq.inters...),
+		predicates:   append([]predicate.SnapshotCheckpoint{}, scq.predicates...),
+		withSnapshot: scq.withSnapshot.Clone(),
+		withBuild:    scq.withBuild.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithSnapshot tells the query-builder to eager-load the nodes that are connected to
+// the "snapshot" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SnapshotCheckpointQuery) WithSnapshot(opts ...func(*SnapshotQuery)) *SnapshotCheckpointQuery {
+	query := (&SnapshotClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withSnapshot = query
+	return scq
+}
+
+// WithBuild tells the query-builder to eager-load the nodes that are connected to
+// the "build" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SnapshotCheckpointQuery) WithBuild(opts ...func(*EnvBuildQuery)) *SnapshotCheckpointQuery {
+	query := (&EnvBuildClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withBuild = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SnapshotCheckpoint.Query().
+//		GroupBy(snapshotcheckpoint.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (scq *SnapshotCheckpointQuery) GroupBy(field string, fields ...string) *SnapshotCheckpointGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SnapshotCheckpointGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = snapshotcheckpoint.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SnapshotCheckpoint.Query().
+//		Select(snapshotcheckpoint.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (scq *SnapshotCheckpointQuery) Select(fields ...string) *SnapshotCheckpointSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &SnapshotCheckpointSelect{SnapshotCheckpointQuery: scq}
+	sbuild.label = snapshotcheckpoint.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SnapshotCheckpointSelect configured with the given aggregations.
+func (scq *SnapshotCheckpointQuery) Aggregate(fns ...AggregateFunc) *SnapshotCheckpointSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *SnapshotCheckpointQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !snapshotcheckpoint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *SnapshotCheckpointQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SnapshotCheckpoint, error) {
+	var (
+		nodes       = []*SnapshotCheckpoint{}
+		_spec       = scq.querySpec()
+		loadedTypes = [2]bool{
+			scq.withSnapshot != nil,
+			scq.withBuild != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SnapshotCheckpoint).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SnapshotCheckpoint{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = scq.schemaConfig.SnapshotCheckpoint
+	ctx = internal.NewSchemaConfigContext(ctx, scq.schemaConfig)
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withSnapshot; query != nil {
+		if err := scq.loadSnapshot(ctx, query, nodes, nil,
+			func(n *SnapshotCheckpoint, e *Snapshot) { n.Edges.Snapshot = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withBuild; query != nil {
+		if err := scq.loadBuild(ctx, query, nodes, nil,
+			func(n *SnapshotCheckpoint, e *EnvBuild) { n.Edges.Build = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *SnapshotCheckpointQuery) loadSnapshot(ctx context.Context, query *SnapshotQuery, nodes []*SnapshotCheckpoint, init func(*SnapshotCheckpoint), assign func(*SnapshotCheckpoint, *Snapshot)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SnapshotCheckpoint)
+	for i := range nodes {
+		fk := nodes[i].SnapshotID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(snapshot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "snapshot_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scq *SnapshotCheckpointQuery) loadBuild(ctx context.Context, query *EnvBuildQuery, nodes []*SnapshotCheckpoint, init func(*SnapshotCheckpoint), assign func(*SnapshotCheckpoint, *EnvBuild)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SnapshotCheckpoint)
+	for i := range nodes {
+		fk := nodes[i].BuildID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(envbuild.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "build_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (scq *SnapshotCheckpointQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	_spec.Node.Schema = scq.schemaConfig.SnapshotCheckpoint
+	ctx = internal.NewSchemaConfigContext(ctx, scq.schemaConfig)
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *SnapshotCheckpointQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(snapshotcheckpoint.Table, snapshotcheckpoint.Columns, sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshotcheckpoint.FieldID)
+		for i := range fields {
+			if fields[i] != snapshotcheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if scq.withSnapshot != nil {
+			_spec.Node.AddColumnOnce(snapshotcheckpoint.FieldSnapshotID)
+		}
+		if scq.withBuild != nil {
+			_spec.Node.AddColumnOnce(snapshotcheckpoint.FieldBuildID)
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *SnapshotCheckpointQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(snapshotcheckpoint.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = snapshotcheckpoint.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(scq.schemaConfig.SnapshotCheckpoint)
+	ctx = internal.NewSchemaConfigContext(ctx, scq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range scq.modifiers {
+		m(selector)
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scq *SnapshotCheckpointQuery) Modify(modifiers ...func(s *sql.Selector)) *SnapshotCheckpointSelect {
+	scq.modifiers = append(scq.modifiers, modifiers...)
+	return scq.Select()
+}
+
+// SnapshotCheckpointGroupBy is the group-by builder for SnapshotCheckpoint entities.
+type SnapshotCheckpointGroupBy struct {
+	selector
+	build *SnapshotCheckpointQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *SnapshotCheckpointGroupBy) Aggregate(fns ...AggregateFunc) *SnapshotCheckpointGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *SnapshotCheckpointGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, "GroupBy")
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SnapshotCheckpointQuery, *SnapshotCheckpointGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *SnapshotCheckpointGroupBy) sqlScan(ctx context.Context, root *SnapshotCheckpointQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SnapshotCheckpointSelect is the builder for selecting fields of SnapshotCheckpoint entities.
+type SnapshotCheckpointSelect struct {
+	*SnapshotCheckpointQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *SnapshotCheckpointSelect) Aggregate(fns ...AggregateFunc) *SnapshotCheckpointSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *SnapshotCheckpointSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, "Select")
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SnapshotCheckpointQuery, *SnapshotCheckpointSelect](ctx, scs.SnapshotCheckpointQuery, scs, scs.inters, v)
+}
+
+func (scs *SnapshotCheckpointSelect) sqlScan(ctx context.Context, root *SnapshotCheckpointQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (scs *SnapshotCheckpointSelect) Modify(modifiers ...func(s *sql.Selector)) *SnapshotCheckpointSelect {
+	scs.modifiers = append(scs.modifiers, modifiers...)
+	return scs
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshotcheckpoint"
+	"github.com/google/uuid"
+)
+
+// SnapshotCheckpointUpdate is the builder for updating SnapshotCheckpoint entities.
+type SnapshotCheckpointUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SnapshotCheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SnapshotCheckpointUpdate builder.
+func (scu *SnapshotCheckpointUpdate) Where(ps ...predicate.SnapshotCheckpoint) *SnapshotCheckpointUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (scu *SnapshotCheckpointUpdate) SetSnapshotID(u uuid.UUID) *SnapshotCheckpointUpdate {
+	scu.mutation.SetSnapshotID(u)
+	return scu
+}
+
+// SetNillableSnapshotID sets the "snapshot_id" field if the given value is not nil.
+func (scu *SnapshotCheckpointUpdate) SetNillableSnapshotID(u *uuid.UUID) *SnapshotCheckpointUpdate {
+	if u != nil {
+		scu.SetSnapshotID(*u)
+	}
+	return scu
+}
+
+// SetBuildID sets the "build_id" field.
+func (scu *SnapshotCheckpointUpdate) SetBuildID(u uuid.UUID) *SnapshotCheckpointUpdate {
+	scu.mutation.SetBuildID(u)
+	return scu
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (scu *SnapshotCheckpointUpdate) SetNillableBuildID(u *uuid.UUID) *SnapshotCheckpointUpdate {
+	if u != nil {
+		scu.SetBuildID(*u)
+	}
+	return scu
+}
+
+// SetName sets the "name" field.
+func (scu *SnapshotCheckpointUpdate) SetName(s string) *SnapshotCheckpointUpdate {
+	scu.mutation.SetName(s)
+	return scu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scu *SnapshotCheckpointUpdate) SetNillableName(s *string) *SnapshotCheckpointUpdate {
+	if s != nil {
+		scu.SetName(*s)
+	}
+	return scu
+}
+
+// SetSnapshot sets the "snapshot" edge to the Snapshot entity.
+func (scu *SnapshotCheckpointUpdate) SetSnapshot(s *Snapshot) *SnapshotCheckpointUpdate {
+	return scu.SetSnapshotID(s.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (scu *SnapshotCheckpointUpdate) SetBuild(e *EnvBuild) *SnapshotCheckpointUpdate {
+	return scu.SetBuildID(e.ID)
+}
+
+// Mutation returns the SnapshotCheckpointMutation object of the builder.
+func (scu *SnapshotCheckpointUpdate) Mutation() *SnapshotCheckpointMutation {
+	return scu.mutation
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (scu *SnapshotCheckpointUpdate) ClearSnapshot() *SnapshotCheckpointUpdate {
+	scu.mutation.ClearSnapshot()
+	return scu
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (scu *SnapshotCheckpointUpdate) ClearBuild() *SnapshotCheckpointUpdate {
+	scu.mutation.ClearBuild()
+	return scu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SnapshotCheckpointUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SnapshotCheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SnapshotCheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SnapshotCheckpointUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SnapshotCheckpointUpdate) check() error {
+	if _, ok := scu.mutation.SnapshotID(); scu.mutation.SnapshotCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "SnapshotCheckpoint.snapshot"`)
+	}
+	if _, ok := scu.mutation.BuildID(); scu.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "SnapshotCheckpoint.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scu *SnapshotCheckpointUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SnapshotCheckpointUpdate {
+	scu.modifiers = append(scu.modifiers, modifiers...)
+	return scu
+}
+
+func (scu *SnapshotCheckpointUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshotcheckpoint.Table, snapshotcheckpoint.Columns, sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Name(); ok {
+		_spec.SetField(snapshotcheckpoint.FieldName, field.TypeString, value)
+	}
+	if scu.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.SnapshotTable,
+			Columns: []string{snapshotcheckpoint.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scu.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.SnapshotTable,
+			Columns: []string{snapshotcheckpoint.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scu.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.BuildTable,
+			Columns: []string{snapshotcheckpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scu.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.BuildTable,
+			Columns: []string{snapshotcheckpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scu.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = scu.schemaConfig.SnapshotCheckpoint
+	ctx = internal.NewSchemaConfigContext(ctx, scu.schemaConfig)
+	_spec.AddModifiers(scu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshotcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SnapshotCheckpointUpdateOne is the builder for updating a single SnapshotCheckpoint entity.
+type SnapshotCheckpointUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SnapshotCheckpointMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSnapshotID sets the "snapshot_id" field.
+func (scuo *SnapshotCheckpointUpdateOne) SetSnapshotID(u uuid.UUID) *SnapshotCheckpointUpdateOne {
+	scuo.mutation.SetSnapshotID(u)
+	return scuo
+}
+
+// SetNillableSnapshotID sets the "snapshot_id" field if the given value is not nil.
+func (scuo *SnapshotCheckpointUpdateOne) SetNillableSnapshotID(u *uuid.UUID) *SnapshotCheckpointUpdateOne {
+	if u != nil {
+		scuo.SetSnapshotID(*u)
+	}
+	return scuo
+}
+
+// SetBuildID sets the "build_id" field.
+func (scuo *SnapshotCheckpointUpdateOne) SetBuildID(u uuid.UUID) *SnapshotCheckpointUpdateOne {
+	scuo.mutation.SetBuildID(u)
+	return scuo
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (scuo *SnapshotCheckpointUpdateOne) SetNillableBuildID(u *uuid.UUID) *SnapshotCheckpointUpdateOne {
+	if u != nil {
+		scuo.SetBuildID(*u)
+	}
+	return scuo
+}
+
+// SetName sets the "name" field.
+func (scuo *SnapshotCheckpointUpdateOne) SetName(s string) *SnapshotCheckpointUpdateOne {
+	scuo.mutation.SetName(s)
+	return scuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scuo *SnapshotCheckpointUpdateOne) SetNillableName(s *string) *SnapshotCheckpointUpdateOne {
+	if s != nil {
+		scuo.SetName(*s)
+	}
+	return scuo
+}
+
+// SetSnapshot sets the "snapshot" edge to the Snapshot entity.
+func (scuo *SnapshotCheckpointUpdateOne) SetSnapshot(s *Snapshot) *SnapshotCheckpointUpdateOne {
+	return scuo.SetSnapshotID(s.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (scuo *SnapshotCheckpointUpdateOne) SetBuild(e *EnvBuild) *SnapshotCheckpointUpdateOne {
+	return scuo.SetBuildID(e.ID)
+}
+
+// Mutation returns the SnapshotCheckpointMutation object of the builder.
+func (scuo *SnapshotCheckpointUpdateOne) Mutation() *SnapshotCheckpointMutation {
+	return scuo.mutation
+}
+
+// ClearSnapshot clears the "snapshot" edge to the Snapshot entity.
+func (scuo *SnapshotCheckpointUpdateOne) ClearSnapshot() *SnapshotCheckpointUpdateOne {
+	scuo.mutation.ClearSnapshot()
+	return scuo
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (scuo *SnapshotCheckpointUpdateOne) ClearBuild() *SnapshotCheckpointUpdateOne {
+	scuo.mutation.ClearBuild()
+	return scuo
+}
+
+// Where appends a list predicates to the SnapshotCheckpointUpdate builder.
+func (scuo *SnapshotCheckpointUpdateOne) Where(ps ...predicate.SnapshotCheckpoint) *SnapshotCheckpointUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SnapshotCheckpointUpdateOne) Select(field string, fields ...string) *SnapshotCheckpointUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SnapshotCheckpoint entity.
+func (scuo *SnapshotCheckpointUpdateOne) Save(ctx context.Context) (*SnapshotCheckpoint, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SnapshotCheckpointUpdateOne) SaveX(ctx context.Context) *SnapshotCheckpoint {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SnapshotCheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SnapshotCheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SnapshotCheckpointUpdateOne) check() error {
+	if _, ok := scuo.mutation.SnapshotID(); scuo.mutation.SnapshotCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "SnapshotCheckpoint.snapshot"`)
+	}
+	if _, ok := scuo.mutation.BuildID(); scuo.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "SnapshotCheckpoint.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (scuo *SnapshotCheckpointUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SnapshotCheckpointUpdateOne {
+	scuo.modifiers = append(scuo.modifiers, modifiers...)
+	return scuo
+}
+
+func (scuo *SnapshotCheckpointUpdateOne) sqlSave(ctx context.Context) (_node *SnapshotCheckpoint, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(snapshotcheckpoint.Table, snapshotcheckpoint.Columns, sqlgraph.NewFieldSpec(snapshotcheckpoint.FieldID, field.TypeUUID))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "SnapshotCheckpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, snapshotcheckpoint.FieldID)
+		for _, f := range fields {
+			if !snapshotcheckpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != snapshotcheckpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Name(); ok {
+		_spec.SetField(snapshotcheckpoint.FieldName, field.TypeString, value)
+	}
+	if scuo.mutation.SnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.SnapshotTable,
+			Columns: []string{snapshotcheckpoint.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scuo.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.SnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.SnapshotTable,
+			Columns: []string{snapshotcheckpoint.SnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scuo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.BuildTable,
+			Columns: []string{snapshotcheckpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scuo.schemaConfig.SnapshotCheckpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshotcheckpoint.BuildTable,
+			Columns: []string{snapshotcheckpoint.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = scuo.schemaConfig.SnapshotCheckpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = scuo.schemaConfig.SnapshotCheckpoint
+	ctx = internal.NewSchemaConfigContext(ctx, scuo.schemaConfig)
+	_spec.AddModifiers(scuo.modifiers...)
+	_node = &SnapshotCheckpoint{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{snapshotcheckpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -24,6 +24,8 @@ type Tx struct {
 	EnvBuild *EnvBuildClient
 	// Snapshot is the client for interacting with the Snapshot builders.
 	Snapshot *SnapshotClient
+	// SnapshotCheckpoint is the client for interacting with the SnapshotCheckpoint builders.
+	SnapshotCheckpoint *SnapshotCheckpointClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamAPIKey is the client for interacting with the TeamAPIKey builders.
@@ -171,6 +173,7 @@ func (tx *Tx) init() {
 	tx.EnvAlias = NewEnvAliasClient(tx.config)
 	tx.EnvBuild = NewEnvBuildClient(tx.config)
 	tx.Snapshot = NewSnapshotClient(tx.config)
+	tx.SnapshotCheckpoint = NewSnapshotCheckpointClient(tx.config)
 	tx.Team = NewTeamClient(tx.config)
 	tx.TeamAPIKey = NewTeamAPIKeyClient(tx.config)
 	tx.Tier = NewTierClient(tx.config)
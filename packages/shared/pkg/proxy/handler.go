@@ -38,6 +38,21 @@ func (e *SandboxNotFoundError) Error() string {
 	return "sandbox not found"
 }
 
+type PortAccessDeniedError struct {
+	SandboxId string
+	Port      uint64
+	// Closed is set when the port is closed by the ingress policy, otherwise the access token is missing or invalid.
+	Closed bool
+}
+
+func (e *PortAccessDeniedError) Error() string {
+	if e.Closed {
+		return "sandbox port is closed"
+	}
+
+	return "invalid traffic access token"
+}
+
 func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Destination, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		d, err := getDestination(r)
@@ -75,6 +90,26 @@ func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Dest
 			return
 		}
 
+		var accessDeniedErr *PortAccessDeniedError
+		if errors.As(err, &accessDeniedErr) {
+			zap.L().Debug("sandbox port access denied", zap.String("host", r.Host), zap.Error(err))
+
+			templatedErr := template.NewPortClosedError(accessDeniedErr.SandboxId, r.Host, accessDeniedErr.Port)
+			if !accessDeniedErr.Closed {
+				templatedErr = template.NewPortAccessDeniedError(accessDeniedErr.SandboxId, r.Host, accessDeniedErr.Port)
+			}
+
+			err := templatedErr.HandleError(w, r)
+			if err != nil {
+				zap.L().Error("failed to handle port access denied error", zap.Error(err))
+				http.Error(w, "Failed to handle port access denied error", http.StatusInternalServerError)
+
+				return
+			}
+
+			return
+		}
+
 		if err != nil {
 			zap.L().Error("failed to route request", zap.Error(err), zap.String("host", r.Host))
 			http.Error(w, fmt.Sprintf("Unexpected error when routing request: %s", err), http.StatusInternalServerError)
@@ -0,0 +1,134 @@
+package proxy
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const (
+	// TrafficAccessTokenHeader is the header with the token for the ports that require it.
+	TrafficAccessTokenHeader = "e2b-traffic-access-token"
+	// TrafficAccessTokenQueryParam can be used instead of the header, e.g. when the port is opened in the browser.
+	TrafficAccessTokenQueryParam = "e2b_traffic_access_token"
+)
+
+type PortAccess string
+
+const (
+	PortAccessPublic PortAccess = "public"
+	PortAccessToken  PortAccess = "token"
+	PortAccessClosed PortAccess = "closed"
+)
+
+// Ingress is the inbound traffic policy of the sandbox ports exposed through the proxy.
+// Only the hash of the traffic access token is kept, so the policy can be stored in the catalog.
+type Ingress struct {
+	DefaultAccess PortAccess            `json:"defaultAccess,omitempty"`
+	Ports         map[uint64]PortAccess `json:"ports,omitempty"`
+	TokenHash     string                `json:"tokenHash,omitempty"`
+}
+
+func NewIngress(defaultAccess PortAccess, ports map[uint64]PortAccess, token string) *Ingress {
+	ingress := &Ingress{
+		DefaultAccess: defaultAccess,
+		Ports:         ports,
+	}
+
+	if token != "" {
+		ingress.TokenHash = hashTrafficAccessToken(token)
+	}
+
+	return ingress
+}
+
+// NewIngressFromConfig converts the ingress from the sandbox config, nil config means all ports are public.
+func NewIngressFromConfig(config *orchestrator.SandboxIngressConfig) *Ingress {
+	if config == nil {
+		return nil
+	}
+
+	ports := make(map[uint64]PortAccess, len(config.GetPorts()))
+	for port, access := range config.GetPorts() {
+		ports[port] = portAccessFromConfig(access)
+	}
+
+	return NewIngress(portAccessFromConfig(config.GetDefaultAccess()), ports, config.GetTrafficAccessToken())
+}
+
+func portAccessFromConfig(access orchestrator.SandboxPortAccess) PortAccess {
+	switch access {
+	case orchestrator.SandboxPortAccess_PortToken:
+		return PortAccessToken
+	case orchestrator.SandboxPortAccess_PortClosed:
+		return PortAccessClosed
+	default:
+		return PortAccessPublic
+	}
+}
+
+func hashTrafficAccessToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (i *Ingress) access(port uint64) PortAccess {
+	// The envd port is protected by the envd access token, the SDK must always be able to reach it.
+	if port == uint64(consts.DefaultEnvdServerPort) {
+		return PortAccessPublic
+	}
+
+	if access, ok := i.Ports[port]; ok {
+		return access
+	}
+
+	if i.DefaultAccess == "" {
+		return PortAccessPublic
+	}
+
+	return i.DefaultAccess
+}
+
+// Authorize checks if the request can be routed to the sandbox port. Nil ingress allows all ports.
+func (i *Ingress) Authorize(r *http.Request, sandboxId string, port uint64) error {
+	if i == nil {
+		return nil
+	}
+
+	switch i.access(port) {
+	case PortAccessPublic:
+		return nil
+	case PortAccessToken:
+		token := r.Header.Get(TrafficAccessTokenHeader)
+		if token == "" {
+			token = r.URL.Query().Get(TrafficAccessTokenQueryParam)
+		}
+
+		if token != "" && i.TokenHash != "" {
+			hash := hashTrafficAccessToken(token)
+			if subtle.ConstantTimeCompare([]byte(hash), []byte(i.TokenHash)) == 1 {
+				return nil
+			}
+		}
+
+		return &PortAccessDeniedError{SandboxId: sandboxId, Port: port}
+	default:
+		return &PortAccessDeniedError{SandboxId: sandboxId, Port: port, Closed: true}
+	}
+}
+
+// RemoveTrafficAccessToken removes the token from the request, so it isn't forwarded to the sandbox.
+func RemoveTrafficAccessToken(r *http.Request) {
+	r.Header.Del(TrafficAccessTokenHeader)
+
+	query := r.URL.Query()
+	if query.Has(TrafficAccessTokenQueryParam) {
+		query.Del(TrafficAccessTokenQueryParam)
+		r.URL.RawQuery = query.Encode()
+	}
+}
@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestIngressAuthorize(t *testing.T) {
+	ingress := NewIngress(PortAccessToken, map[uint64]PortAccess{
+		80:   PortAccessPublic,
+		5432: PortAccessClosed,
+	}, "secret")
+
+	tests := []struct {
+		name       string
+		url        string
+		header     string
+		port       uint64
+		wantErr    bool
+		wantClosed bool
+	}{
+		{name: "public port", url: "/", port: 80},
+		{name: "closed port", url: "/", port: 5432, wantErr: true, wantClosed: true},
+		{name: "closed port with token", url: "/", header: "secret", port: 5432, wantErr: true, wantClosed: true},
+		{name: "default token port without token", url: "/", port: 3000, wantErr: true},
+		{name: "default token port with header", url: "/", header: "secret", port: 3000},
+		{name: "default token port with query", url: "/?" + TrafficAccessTokenQueryParam + "=secret", port: 3000},
+		{name: "default token port with invalid token", url: "/", header: "invalid", port: 3000, wantErr: true},
+		{name: "envd port", url: "/", port: 49983},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.url, nil)
+			if tt.header != "" {
+				r.Header.Set(TrafficAccessTokenHeader, tt.header)
+			}
+
+			err := ingress.Authorize(r, "sandbox-id", tt.port)
+			if !tt.wantErr {
+				assert.NilError(t, err)
+
+				return
+			}
+
+			var accessDeniedErr *PortAccessDeniedError
+			assert.Assert(t, errors.As(err, &accessDeniedErr))
+			assert.Equal(t, tt.wantClosed, accessDeniedErr.Closed)
+			assert.Equal(t, tt.port, accessDeniedErr.Port)
+		})
+	}
+}
+
+func TestIngressAuthorizeNil(t *testing.T) {
+	var ingress *Ingress
+
+	assert.NilError(t, ingress.Authorize(httptest.NewRequest("GET", "/", nil), "sandbox-id", 8080))
+}
+
+func TestRemoveTrafficAccessToken(t *testing.T) {
+	r := httptest.NewRequest("GET", "/path?a=1&"+TrafficAccessTokenQueryParam+"=secret", nil)
+	r.Header.Set(TrafficAccessTokenHeader, "secret")
+
+	RemoveTrafficAccessToken(r)
+
+	assert.Equal(t, "", r.Header.Get(TrafficAccessTokenHeader))
+	assert.Equal(t, "a=1", r.URL.RawQuery)
+}
@@ -27,6 +27,7 @@ func newProxyClient(
 	idleTimeout time.Duration,
 	totalConnsCounter *atomic.Uint64,
 	currentConnsCounter *atomic.Int64,
+	activity *tracking.Activity,
 	logger *log.Logger,
 ) *proxyClient {
 	transport := &http.Transport{
@@ -49,7 +50,7 @@ func newProxyClient(
 
 			totalConnsCounter.Add(1)
 
-			return tracking.NewConnection(conn, currentConnsCounter), nil
+			return tracking.NewConnection(conn, currentConnsCounter, activity), nil
 		},
 		DisableCompression: true, // No need to request or manipulate compression
 	}
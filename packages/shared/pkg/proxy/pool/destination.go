@@ -4,6 +4,8 @@ import (
 	"net/url"
 
 	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/tracking"
 )
 
 type DestinationContextKey struct{}
@@ -20,4 +22,7 @@ type Destination struct {
 	// This is evaluated before checking for existing connection to the IP:port pair.
 	ConnectionKey                      string
 	IncludeSandboxIdInProxyErrorLogger bool
+	// Activity is marked on every read and write over the connections to the destination, optional.
+	// The connections are shared by the ConnectionKey, so the activity of the first destination with the key is used.
+	Activity *tracking.Activity
 }
@@ -61,6 +61,7 @@ func (p *ProxyPool) Get(d *Destination) *proxyClient {
 			p.idleTimeout,
 			&p.totalConnsCounter,
 			&p.currentConnsCounter,
+			d.Activity,
 			logger,
 		)
 	})
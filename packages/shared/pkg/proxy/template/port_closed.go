@@ -34,3 +34,17 @@ func NewPortClosedError(sandboxId, host string, port uint64) *TemplatedError[por
 		},
 	}
 }
+
+// NewPortAccessDeniedError is returned when the port requires the traffic access token.
+func NewPortAccessDeniedError(sandboxId, host string, port uint64) *TemplatedError[portClosedError] {
+	return &TemplatedError[portClosedError]{
+		template: portClosedHtmlTemplate,
+		vars: portClosedError{
+			Message:   "The sandbox port requires a valid traffic access token",
+			SandboxId: sandboxId,
+			Host:      host,
+			Port:      port,
+			Code:      http.StatusForbidden,
+		},
+	}
+}
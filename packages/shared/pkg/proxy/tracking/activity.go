@@ -0,0 +1,20 @@
+package tracking
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Activity is the time of the last data transfer over the connections it's attached to.
+// The zero value is ready to use.
+type Activity struct {
+	last atomic.Int64
+}
+
+func (a *Activity) Mark() {
+	a.last.Store(time.Now().UnixNano())
+}
+
+func (a *Activity) Last() time.Time {
+	return time.Unix(0, a.last.Load())
+}
@@ -9,17 +9,38 @@ type Connection struct {
 	net.Conn
 
 	counter *atomic.Int64
+	// activity is marked on every read and write, optional.
+	activity *Activity
 }
 
-func NewConnection(conn net.Conn, counter *atomic.Int64) *Connection {
+func NewConnection(conn net.Conn, counter *atomic.Int64, activity *Activity) *Connection {
 	counter.Add(1)
 
 	return &Connection{
-		Conn:    conn,
-		counter: counter,
+		Conn:     conn,
+		counter:  counter,
+		activity: activity,
 	}
 }
 
+func (c *Connection) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 && c.activity != nil {
+		c.activity.Mark()
+	}
+
+	return n, err
+}
+
+func (c *Connection) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 && c.activity != nil {
+		c.activity.Mark()
+	}
+
+	return n, err
+}
+
 func (c *Connection) Close() error {
 	err := c.Conn.Close()
 	if err != nil {
@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 )
 
 const (
@@ -44,6 +46,7 @@ func (EnvBuild) Fields() []ent.Field {
 		field.String("firecracker_version").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("envd_version").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
 		field.String("cluster_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("cpu_architecture").Default(consts.DefaultCPUArchitecture).SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.JSON("reason", BuildReason{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Default(BuildReason{}),
 	}
 }
@@ -51,6 +54,7 @@ func (EnvBuild) Fields() []ent.Field {
 func (EnvBuild) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("env", Env.Type).Ref("builds").Unique().Field("env_id").Required(),
+		edge.To("checkpoints", SnapshotCheckpoint.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
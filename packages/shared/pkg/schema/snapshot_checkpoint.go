@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+type SnapshotCheckpoint struct {
+	ent.Schema
+}
+
+func (SnapshotCheckpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("snapshot_id", uuid.UUID{}),
+		field.UUID("build_id", uuid.UUID{}),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (SnapshotCheckpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("snapshot", Snapshot.Type).Ref("checkpoints").Unique().Field("snapshot_id").Required(),
+		edge.From("build", EnvBuild.Type).Ref("checkpoints").Unique().Field("build_id").Required(),
+	}
+}
+
+func (SnapshotCheckpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
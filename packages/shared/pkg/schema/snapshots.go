@@ -32,12 +32,16 @@ func (Snapshot) Fields() []ent.Field {
 		field.String("origin_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.UUID("team_id", uuid.UUID{}),
 		field.Bool("allow_internet_access").Nillable().Optional(),
+		field.JSON("network", &SandboxNetworkConfig{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Optional(),
+		field.Int64("idle_timeout").Default(0),
+		field.Int64("memory_limit_mb").Default(0),
 	}
 }
 
 func (Snapshot) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("env", Env.Type).Ref("snapshots").Unique().Field("env_id").Required(),
+		edge.To("checkpoints", SnapshotCheckpoint.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -46,3 +50,43 @@ func (Snapshot) Mixin() []ent.Mixin {
 		Mixin{},
 	}
 }
+
+type SandboxNetworkConfig struct {
+	// AllowOut Allowed egress destinations (IPv4 addresses, CIDRs or domains)
+	AllowOut []string `json:"allowOut,omitempty"`
+
+	// DenyOut Denied egress destinations (IPv4 addresses, CIDRs or domains)
+	DenyOut []string `json:"denyOut,omitempty"`
+
+	// Ingress Access to the sandbox ports exposed through the proxy, all ports are public when not set
+	Ingress *SandboxIngressConfig `json:"ingress,omitempty"`
+
+	// DNS Resolver config and static host entries of the sandbox, the template config is kept when not set
+	DNS *SandboxDNSConfig `json:"dns,omitempty"`
+}
+
+type SandboxIngressConfig struct {
+	// DefaultAccess Access to the ports that are not listed (public, token or closed)
+	DefaultAccess string `json:"defaultAccess,omitempty"`
+
+	Ports []SandboxPortIngress `json:"ports,omitempty"`
+
+	// TrafficAccessToken Token for the ports with the token access
+	TrafficAccessToken string `json:"trafficAccessToken,omitempty"`
+}
+
+type SandboxPortIngress struct {
+	Port   uint64 `json:"port"`
+	Access string `json:"access"`
+}
+
+type SandboxDNSConfig struct {
+	Nameservers []string           `json:"nameservers,omitempty"`
+	Searches    []string           `json:"searches,omitempty"`
+	Hosts       []SandboxHostEntry `json:"hosts,omitempty"`
+}
+
+type SandboxHostEntry struct {
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
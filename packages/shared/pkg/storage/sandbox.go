@@ -55,3 +55,7 @@ func (s *SandboxFiles) SandboxUffdSocketPath() string {
 func (s *SandboxFiles) SandboxCacheRootfsLinkPath() string {
 	return filepath.Join(sandboxCacheDir, fmt.Sprintf("rootfs-%s-%s.link", s.SandboxID, s.randomID))
 }
+
+func (s *SandboxFiles) SandboxCacheVolumeLinkPath(idx int) string {
+	return filepath.Join(sandboxCacheDir, fmt.Sprintf("volume%d-%s-%s.link", idx, s.SandboxID, s.randomID))
+}
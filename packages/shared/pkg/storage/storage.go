@@ -35,6 +35,7 @@ const (
 
 type StorageProvider interface {
 	DeleteObjectsWithPrefix(ctx context.Context, prefix string) error
+	ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error)
 	UploadSignedURL(ctx context.Context, path string, ttl time.Duration) (string, error)
 	OpenObject(ctx context.Context, path string) (StorageObjectProvider, error)
 	GetDetails() string
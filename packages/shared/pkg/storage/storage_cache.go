@@ -63,6 +63,10 @@ func (c CachedProvider) DeleteObjectsWithPrefix(ctx context.Context, prefix stri
 	return c.inner.DeleteObjectsWithPrefix(ctx, prefix)
 }
 
+func (c CachedProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	return c.inner.ListObjectsWithPrefix(ctx, prefix)
+}
+
 func (c CachedProvider) UploadSignedURL(ctx context.Context, path string, ttl time.Duration) (string, error) {
 	return c.inner.UploadSignedURL(ctx, path, ttl)
 }
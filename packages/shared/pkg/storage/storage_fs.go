@@ -2,10 +2,12 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -34,6 +36,36 @@ func (fs *FileSystemStorageProvider) DeleteObjectsWithPrefix(_ context.Context,
 	return os.RemoveAll(filePath)
 }
 
+func (fs *FileSystemStorageProvider) ListObjectsWithPrefix(_ context.Context, prefix string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(fs.basePath, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(fs.basePath, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if strings.HasPrefix(rel, prefix) {
+			paths = append(paths, rel)
+		}
+
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	return paths, err
+}
+
 func (fs *FileSystemStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[Local file storage, base path set to %s]", fs.basePath)
 }
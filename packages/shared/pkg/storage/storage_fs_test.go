@@ -113,6 +113,26 @@ func TestDeleteObjectsWithPrefix(t *testing.T) {
 	}
 }
 
+func TestListObjectsWithPrefix(t *testing.T) {
+	p := newTempProvider(t)
+	ctx := t.Context()
+
+	for _, pth := range []string{"data/a.txt", "data/sub/b.txt", "other/c.txt"} {
+		obj, err := p.OpenObject(ctx, pth)
+		require.NoError(t, err)
+		_, err = obj.Write(t.Context(), []byte("x"))
+		require.NoError(t, err)
+	}
+
+	paths, err := p.ListObjectsWithPrefix(ctx, "data/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"data/a.txt", "data/sub/b.txt"}, paths)
+
+	paths, err = p.ListObjectsWithPrefix(ctx, "missing/")
+	require.NoError(t, err)
+	require.Empty(t, paths)
+}
+
 func TestWriteToNonExistentObject(t *testing.T) {
 	p := newTempProvider(t)
 
@@ -103,6 +103,26 @@ func (g *GCPBucketStorageProvider) DeleteObjectsWithPrefix(ctx context.Context,
 	return nil
 }
 
+func (g *GCPBucketStorageProvider) ListObjectsWithPrefix(ctx context.Context, prefix string) ([]string, error) {
+	objects := g.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+
+	var paths []string
+	for {
+		object, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error when iterating over objects: %w", err)
+		}
+
+		paths = append(paths, object.Name)
+	}
+
+	return paths, nil
+}
+
 func (g *GCPBucketStorageProvider) GetDetails() string {
 	return fmt.Sprintf("[GCP Storage, bucket set to %s]", g.bucket.BucketName())
 }
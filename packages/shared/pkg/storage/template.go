@@ -13,6 +13,9 @@ const (
 	SnapfileName = "snapfile"
 	MetadataName = "metadata.json"
 
+	ProvenanceName   = "provenance.json"
+	VerificationName = "verification.json"
+
 	HeaderSuffix = ".header"
 )
 
@@ -54,3 +57,11 @@ func (t TemplateFiles) StorageSnapfilePath() string {
 func (t TemplateFiles) StorageMetadataPath() string {
 	return fmt.Sprintf("%s/%s", t.StorageDir(), MetadataName)
 }
+
+func (t TemplateFiles) StorageProvenancePath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), ProvenanceName)
+}
+
+func (t TemplateFiles) StorageVerificationPath() string {
+	return fmt.Sprintf("%s/%s", t.StorageDir(), VerificationName)
+}
@@ -78,6 +78,8 @@ const (
 	SandboxCpuTotalGaugeName  GaugeIntType = "e2b.sandbox.cpu.total"
 	SandboxDiskUsedGaugeName  GaugeIntType = "e2b.sandbox.disk.used"
 	SandboxDiskTotalGaugeName GaugeIntType = "e2b.sandbox.disk.total"
+	SandboxNetworkRxGaugeName GaugeIntType = "e2b.sandbox.network.rx"
+	SandboxNetworkTxGaugeName GaugeIntType = "e2b.sandbox.network.tx"
 
 	// Team metrics
 	TeamSandboxRunningGaugeName GaugeIntType = "e2b.team.sandbox.running"
@@ -163,6 +165,8 @@ var gaugeIntDesc = map[GaugeIntType]string{
 	SandboxCpuTotalGaugeName:      "Amount of CPU available to the sandbox.",
 	SandboxDiskUsedGaugeName:      "Amount of disk space used by the sandbox.",
 	SandboxDiskTotalGaugeName:     "Amount of disk space available to the sandbox.",
+	SandboxNetworkRxGaugeName:     "Amount of network traffic received by the sandbox.",
+	SandboxNetworkTxGaugeName:     "Amount of network traffic sent by the sandbox.",
 	TeamSandboxRunningGaugeName:   "The number of sandboxes running for the team in the interval.",
 }
 
@@ -173,6 +177,8 @@ var gaugeIntUnits = map[GaugeIntType]string{
 	SandboxCpuTotalGaugeName:      "{count}",
 	SandboxDiskUsedGaugeName:      "{By}",
 	SandboxDiskTotalGaugeName:     "{By}",
+	SandboxNetworkRxGaugeName:     "{By}",
+	SandboxNetworkTxGaugeName:     "{By}",
 	TeamSandboxRunningGaugeName:   "{sandbox}",
 }
 
@@ -25,6 +25,12 @@ const (
 	Aws AWSRegistryType = "aws"
 )
 
+// Defines values for CPUArchitecture.
+const (
+	Amd64 CPUArchitecture = "amd64"
+	Arm64 CPUArchitecture = "arm64"
+)
+
 // Defines values for GCPRegistryType.
 const (
 	Gcp GCPRegistryType = "gcp"
@@ -51,6 +57,20 @@ const (
 	NodeStatusUnhealthy  NodeStatus = "unhealthy"
 )
 
+// Defines values for SandboxPortAccess.
+const (
+	Closed SandboxPortAccess = "closed"
+	Public SandboxPortAccess = "public"
+	Token  SandboxPortAccess = "token"
+)
+
+// Defines values for SandboxScheduleAction.
+const (
+	Kill   SandboxScheduleAction = "kill"
+	Pause  SandboxScheduleAction = "pause"
+	Resume SandboxScheduleAction = "resume"
+)
+
 // Defines values for SandboxState.
 const (
 	Paused  SandboxState = "paused"
@@ -65,6 +85,33 @@ const (
 	TemplateBuildStatusWaiting  TemplateBuildStatus = "waiting"
 )
 
+// Defines values for TemplateBuildVerificationStatus.
+const (
+	TemplateBuildVerificationFailed   TemplateBuildVerificationStatus = "failed"
+	TemplateBuildVerificationMismatch TemplateBuildVerificationStatus = "mismatch"
+	TemplateBuildVerificationRunning  TemplateBuildVerificationStatus = "running"
+	TemplateBuildVerificationVerified TemplateBuildVerificationStatus = "verified"
+)
+
+// Defines values for TemplateBuildWebhookEventStatus.
+const (
+	TemplateBuildWebhookEventStatusError TemplateBuildWebhookEventStatus = "error"
+	TemplateBuildWebhookEventStatusReady TemplateBuildWebhookEventStatus = "ready"
+)
+
+// Defines values for TemplateRootfsDifferenceKind.
+const (
+	TemplateRootfsDifferenceAdded    TemplateRootfsDifferenceKind = "added"
+	TemplateRootfsDifferenceModified TemplateRootfsDifferenceKind = "modified"
+	TemplateRootfsDifferenceRemoved  TemplateRootfsDifferenceKind = "removed"
+)
+
+// Defines values for TemplateStepMountType.
+const (
+	Cache  TemplateStepMountType = "cache"
+	Secret TemplateStepMountType = "secret"
+)
+
 // Defines values for GetTeamsTeamIDMetricsMaxParamsMetric.
 const (
 	ConcurrentSandboxes GetTeamsTeamIDMetricsMaxParamsMetric = "concurrent_sandboxes"
@@ -116,6 +163,9 @@ type BuildStatusReason struct {
 	Step *string `json:"step,omitempty"`
 }
 
+// CPUArchitecture CPU architecture of the template, its sandboxes run only on the nodes with the same architecture
+type CPUArchitecture string
+
 // CPUCount CPU cores for the sandbox
 type CPUCount = int32
 
@@ -307,18 +357,52 @@ type NewSandbox struct {
 	AllowInternetAccess *bool `json:"allow_internet_access,omitempty"`
 
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool            `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars         `json:"envVars,omitempty"`
-	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+	AutoPause *bool    `json:"autoPause,omitempty"`
+	EnvVars   *EnvVars `json:"envVars,omitempty"`
+
+	// IdleTimeout Pause the sandbox when it's idle for this many seconds (no traffic through the proxy, no process activity and CPU usage under the threshold). Idle pausing is disabled when not set.
+	IdleTimeout *int32                `json:"idleTimeout,omitempty"`
+	Metadata    *SandboxMetadata      `json:"metadata,omitempty"`
+	Network     *SandboxNetworkConfig `json:"network,omitempty"`
 
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
 
-	// TemplateID Identifier of the required template
+	// TemplateID Identifier or alias of the required template, a tagged build can be used with the `template:tag` format
 	TemplateID string `json:"templateID"`
 
 	// Timeout Time to live for the sandbox in seconds.
 	Timeout *int32 `json:"timeout,omitempty"`
+
+	// VolumeMounts Volumes mounted to the sandbox, a volume can be mounted only to one sandbox at a time
+	VolumeMounts *[]SandboxVolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// NewSandboxCheckpoint defines model for NewSandboxCheckpoint.
+type NewSandboxCheckpoint struct {
+	// Name Name of the checkpoint, unique per sandbox
+	Name string `json:"name"`
+}
+
+// NewSandboxSchedule defines model for NewSandboxSchedule.
+type NewSandboxSchedule struct {
+	// Action Action run on the sandbox
+	Action SandboxScheduleAction `json:"action"`
+
+	// Command Command run in the sandbox after the scheduled resume, the sandbox is paused again after the command exits
+	Command *string `json:"command,omitempty"`
+
+	// Cron Cron expression in UTC for a recurring schedule, either cron or runAt must be set
+	Cron *string `json:"cron,omitempty"`
+
+	// RunAt Time of a one-off schedule, either cron or runAt must be set
+	RunAt *time.Time `json:"runAt,omitempty"`
+
+	// Timeout Time to live in seconds of the sandbox resumed by the schedule, the command must finish within it
+	Timeout *int32 `json:"timeout,omitempty"`
+
+	// User User the command runs as, the default sandbox user is used when not set
+	User *string `json:"user,omitempty"`
 }
 
 // NewTeamAPIKey defines model for NewTeamAPIKey.
@@ -327,6 +411,15 @@ type NewTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// NewVolume defines model for NewVolume.
+type NewVolume struct {
+	// Name Name of the volume, unique per team
+	Name string `json:"name"`
+
+	// SizeMB Size of the volume in MiB
+	SizeMB int64 `json:"sizeMB"`
+}
+
 // Node defines model for Node.
 type Node struct {
 	// ClusterID Identifier of the cluster
@@ -335,6 +428,9 @@ type Node struct {
 	// Commit Commit of the orchestrator
 	Commit string `json:"commit"`
 
+	// CpuArchitecture CPU architecture of the node
+	CpuArchitecture *string `json:"cpuArchitecture,omitempty"`
+
 	// CreateFails Number of sandbox create fails
 	CreateFails uint64 `json:"createFails"`
 
@@ -449,6 +545,9 @@ type ResumedSandbox struct {
 	// Deprecated:
 	AutoPause *bool `json:"autoPause,omitempty"`
 
+	// IdleTimeout Pause the sandbox when it's idle for this many seconds (no traffic through the proxy, no process activity and CPU usage under the threshold). The value from the paused sandbox is used when not set.
+	IdleTimeout *int32 `json:"idleTimeout,omitempty"`
+
 	// Timeout Time to live for the sandbox in seconds.
 	Timeout *int32 `json:"timeout,omitempty"`
 }
@@ -476,6 +575,30 @@ type Sandbox struct {
 
 	// TemplateID Identifier of the template from which is the sandbox created
 	TemplateID string `json:"templateID"`
+
+	// TrafficAccessToken Access token for the sandbox ports that require it, sent in the e2b-traffic-access-token header or e2b_traffic_access_token query parameter
+	TrafficAccessToken *string `json:"trafficAccessToken,omitempty"`
+}
+
+// SandboxCheckpoint defines model for SandboxCheckpoint.
+type SandboxCheckpoint struct {
+	// CreatedAt Time when the checkpoint was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the checkpoint
+	Name string `json:"name"`
+}
+
+// SandboxDNSConfig Resolver config and static host entries applied in the sandbox, the template config is kept when not set. The nameservers have to be reachable under the egress rules.
+type SandboxDNSConfig struct {
+	// Hosts Static host entries added to /etc/hosts
+	Hosts *[]SandboxHostEntry `json:"hosts,omitempty"`
+
+	// Nameservers IP addresses of the nameservers, they replace the nameservers from the template
+	Nameservers *[]string `json:"nameservers,omitempty"`
+
+	// Searches Search domains, they replace the search domains from the template
+	Searches *[]string `json:"searches,omitempty"`
 }
 
 // SandboxDetail defines model for SandboxDetail.
@@ -522,6 +645,53 @@ type SandboxDetail struct {
 	TemplateID string `json:"templateID"`
 }
 
+// SandboxForkRequest defines model for SandboxForkRequest.
+type SandboxForkRequest struct {
+	// Count Number of sandboxes to fork from the sandbox
+	Count    *int32           `json:"count,omitempty"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Timeout Time to live for the forked sandboxes in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// SandboxForks defines model for SandboxForks.
+type SandboxForks struct {
+	// FailedSandboxIDs IDs of the forks that failed to start, they don't count towards the concurrent sandboxes
+	FailedSandboxIDs []string `json:"failedSandboxIDs"`
+
+	// Sandboxes The started forks
+	Sandboxes []Sandbox `json:"sandboxes"`
+}
+
+// SandboxHostEntry defines model for SandboxHostEntry.
+type SandboxHostEntry struct {
+	// Hostname Hostname pinned to the IP address
+	Hostname string `json:"hostname"`
+
+	// Ip IP address the hostname resolves to
+	Ip string `json:"ip"`
+}
+
+// SandboxIngress defines model for SandboxIngress.
+type SandboxIngress struct {
+	// DefaultAccess Access to the sandbox port exposed through the proxy
+	DefaultAccess SandboxPortAccess    `json:"defaultAccess"`
+	Ports         []SandboxPortIngress `json:"ports"`
+
+	// TrafficAccessToken Access token for the ports with the token access
+	TrafficAccessToken string `json:"trafficAccessToken"`
+}
+
+// SandboxIngressConfig Access to the sandbox ports exposed through the proxy. The envd port is always reachable, it's protected by the envd access token.
+type SandboxIngressConfig struct {
+	// DefaultAccess Access to the sandbox port exposed through the proxy
+	DefaultAccess *SandboxPortAccess `json:"defaultAccess,omitempty"`
+
+	// Ports Access to the specific ports, overrides the default access
+	Ports *[]SandboxPortIngress `json:"ports,omitempty"`
+}
+
 // SandboxLog Log entry with timestamp and line
 type SandboxLog struct {
 	// Line Log line content
@@ -577,6 +747,12 @@ type SandboxMetric struct {
 	// MemUsed Memory used in bytes
 	MemUsed int64 `json:"memUsed"`
 
+	// NetworkRx Network traffic received by the sandbox in bytes
+	NetworkRx int64 `json:"networkRx"`
+
+	// NetworkTx Network traffic sent by the sandbox in bytes
+	NetworkTx int64 `json:"networkTx"`
+
 	// Timestamp Timestamp of the metric entry
 	// Deprecated:
 	Timestamp time.Time `json:"timestamp"`
@@ -585,9 +761,97 @@ type SandboxMetric struct {
 	TimestampUnix int64 `json:"timestampUnix"`
 }
 
+// SandboxNetworkConfig defines model for SandboxNetworkConfig.
+type SandboxNetworkConfig struct {
+	// AllowOut Allowed egress destinations, each entry is an IPv4 address, CIDR or a domain. Allowed destinations take precedence over the denied ones.
+	AllowOut *[]string `json:"allowOut,omitempty"`
+
+	// DenyOut Denied egress destinations, each entry is an IPv4 address, CIDR or a domain. Use 0.0.0.0/0 to deny all destinations that are not allowed.
+	DenyOut *[]string `json:"denyOut,omitempty"`
+
+	// Dns Resolver config and static host entries applied in the sandbox, the template config is kept when not set. The nameservers have to be reachable under the egress rules.
+	Dns *SandboxDNSConfig `json:"dns,omitempty"`
+
+	// Ingress Access to the sandbox ports exposed through the proxy. The envd port is always reachable, it's protected by the envd access token.
+	Ingress *SandboxIngressConfig `json:"ingress,omitempty"`
+}
+
+// SandboxPortAccess Access to the sandbox port exposed through the proxy
+type SandboxPortAccess string
+
+// SandboxPortIngress defines model for SandboxPortIngress.
+type SandboxPortIngress struct {
+	// Access Access to the sandbox port exposed through the proxy
+	Access SandboxPortAccess `json:"access"`
+	Port   int32             `json:"port"`
+}
+
+// SandboxResources defines model for SandboxResources.
+type SandboxResources struct {
+	// CpuCount CPU cores for the sandbox
+	CpuCount CPUCount `json:"cpuCount"`
+
+	// MaxMemoryMB Memory of the sandbox template in MiB, the sandbox can't be resized over it
+	MaxMemoryMB int32 `json:"maxMemoryMB"`
+
+	// MemoryMB Memory for the sandbox in MiB
+	MemoryMB MemoryMB `json:"memoryMB"`
+}
+
+// SandboxResourcesUpdate defines model for SandboxResourcesUpdate.
+type SandboxResourcesUpdate struct {
+	// MemoryMB Memory for the sandbox in MiB
+	MemoryMB MemoryMB `json:"memoryMB"`
+}
+
+// SandboxSchedule defines model for SandboxSchedule.
+type SandboxSchedule struct {
+	// Action Action run on the sandbox
+	Action SandboxScheduleAction `json:"action"`
+
+	// Command Command run in the sandbox after the scheduled resume
+	Command *string `json:"command,omitempty"`
+
+	// CreatedAt Time when the schedule was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Cron Cron expression of the recurring schedule
+	Cron *string `json:"cron,omitempty"`
+
+	// LastError Error of the last run, empty when the last run succeeded
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastRunAt Time of the last run
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+
+	// NextRunAt Time of the next run
+	NextRunAt time.Time `json:"nextRunAt"`
+
+	// ScheduleID Identifier of the schedule
+	ScheduleID string `json:"scheduleID"`
+
+	// Timeout Time to live in seconds of the sandbox resumed by the schedule
+	Timeout int32 `json:"timeout"`
+
+	// User User the command runs as
+	User *string `json:"user,omitempty"`
+}
+
+// SandboxScheduleAction Action run on the sandbox
+type SandboxScheduleAction string
+
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxVolumeMount defines model for SandboxVolumeMount.
+type SandboxVolumeMount struct {
+	// Path Absolute path in the sandbox where the volume is mounted
+	Path string `json:"path"`
+
+	// VolumeID Identifier of the volume
+	VolumeID string `json:"volumeID"`
+}
+
 // SandboxesWithMetrics defines model for SandboxesWithMetrics.
 type SandboxesWithMetrics struct {
 	Sandboxes map[string]SandboxMetric `json:"sandboxes"`
@@ -698,6 +962,9 @@ type TemplateBuild struct {
 	// BuildID Identifier of the build
 	BuildID string `json:"buildID"`
 
+	// CacheResults Layer cache results of the build steps, reported while the build info is kept by the builder
+	CacheResults *[]TemplateBuildStepCacheResult `json:"cacheResults,omitempty"`
+
 	// LogEntries Build logs structured
 	LogEntries []BuildLogEntry `json:"logEntries"`
 
@@ -724,6 +991,30 @@ type TemplateBuildFileUpload struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// TemplateBuildProvenance Provenance of the template build, the layer hashes are content addressed from the inputs of the layers
+type TemplateBuildProvenance struct {
+	Base            TemplateProvenanceBase `json:"base"`
+	BuildID         string                 `json:"buildID"`
+	CpuArchitecture string                 `json:"cpuArchitecture"`
+
+	// CreatedAt Time when the build finished
+	CreatedAt   time.Time `json:"createdAt"`
+	EnvdVersion string    `json:"envdVersion"`
+
+	// FinalizeHash Hash of the last layer, it covers the whole chain of the layers
+	FinalizeHash       string                     `json:"finalizeHash"`
+	FirecrackerVersion string                     `json:"firecrackerVersion"`
+	KernelVersion      string                     `json:"kernelVersion"`
+	ReadyCmd           *string                    `json:"readyCmd,omitempty"`
+	Stages             *[]TemplateProvenanceStage `json:"stages,omitempty"`
+	StartCmd           *string                    `json:"startCmd,omitempty"`
+	Steps              []TemplateProvenanceStep   `json:"steps"`
+	TemplateID         string                     `json:"templateID"`
+
+	// Version Version of the provenance format
+	Version int `json:"version"`
+}
+
 // TemplateBuildRequest defines model for TemplateBuildRequest.
 type TemplateBuildRequest struct {
 	// Alias Alias of the template
@@ -753,6 +1044,9 @@ type TemplateBuildRequestV2 struct {
 	// Alias Alias of the template
 	Alias string `json:"alias"`
 
+	// CpuArchitecture CPU architecture of the template, its sandboxes run only on the nodes with the same architecture
+	CpuArchitecture *CPUArchitecture `json:"cpuArchitecture,omitempty"`
+
 	// CpuCount CPU cores for the sandbox
 	CpuCount *CPUCount `json:"cpuCount,omitempty"`
 
@@ -763,8 +1057,35 @@ type TemplateBuildRequestV2 struct {
 	TeamID *string `json:"teamID,omitempty"`
 }
 
+// TemplateBuildStage Named build stage, it's built before the template and the template steps can copy files from it
+type TemplateBuildStage struct {
+	// FromImage Image to use as a base for the stage
+	FromImage string `json:"fromImage"`
+
+	// Name Name of the stage
+	Name string `json:"name"`
+
+	// Steps List of steps to execute in the stage
+	Steps *[]TemplateStep `json:"steps,omitempty"`
+}
+
 // TemplateBuildStartV2 defines model for TemplateBuildStartV2.
 type TemplateBuildStartV2 struct {
+	// BuildArgs Values of the ARG instructions of the Dockerfile
+	BuildArgs *map[string]string `json:"buildArgs,omitempty"`
+
+	// BuildCpuCount CPU cores for the sandbox
+	BuildCpuCount *CPUCount `json:"buildCpuCount,omitempty"`
+
+	// BuildMemoryMB Memory for the sandbox in MiB
+	BuildMemoryMB *MemoryMB `json:"buildMemoryMB,omitempty"`
+
+	// ContextHash Hash of the uploaded tar file with the build context used by the COPY instructions of the Dockerfile
+	ContextHash *string `json:"contextHash,omitempty"`
+
+	// Dockerfile Dockerfile parsed into the template build steps, it can't be combined with fromImage, fromTemplate, steps or stages
+	Dockerfile *string `json:"dockerfile,omitempty"`
+
 	// Force Whether the whole build should be forced to run regardless of the cache
 	Force *bool `json:"force,omitempty"`
 
@@ -778,28 +1099,240 @@ type TemplateBuildStartV2 struct {
 	// ReadyCmd Ready check command to execute in the template after the build
 	ReadyCmd *string `json:"readyCmd,omitempty"`
 
+	// Secrets Values of the secrets mounted to the RUN steps, they are not stored
+	Secrets *map[string]string `json:"secrets,omitempty"`
+
+	// Stages Build stages built before the template steps, in the order they are built
+	Stages *[]TemplateBuildStage `json:"stages,omitempty"`
+
 	// StartCmd Start command to execute in the template after the build
 	StartCmd *string `json:"startCmd,omitempty"`
 
 	// Steps List of steps to execute in the template build
-	Steps *[]TemplateStep `json:"steps,omitempty"`
+	Steps   *[]TemplateStep       `json:"steps,omitempty"`
+	Webhook *TemplateBuildWebhook `json:"webhook,omitempty"`
+}
+
+// TemplateBuildStepCacheResult defines model for TemplateBuildStepCacheResult.
+type TemplateBuildStepCacheResult struct {
+	// Cached Whether the step layer was reused from the cache
+	Cached bool `json:"cached"`
+
+	// Hash Cache hash of the step layer
+	Hash string `json:"hash"`
+
+	// Phase Build phase of the step
+	Phase string `json:"phase"`
+
+	// Stage Build stage of the step, empty for the template itself
+	Stage *string `json:"stage,omitempty"`
+
+	// StepNumber Number of the step in the template steps
+	StepNumber *int32 `json:"stepNumber,omitempty"`
+
+	// StepType Type of the step
+	StepType string `json:"stepType"`
+}
+
+// TemplateBuildVerification Result of the last rebuild of the template build compared with the original rootfs
+type TemplateBuildVerification struct {
+	BuildID string `json:"buildID"`
+
+	// Differences Paths differing from the original rootfs, limited to the first 1000
+	Differences *[]TemplateRootfsDifference `json:"differences,omitempty"`
+
+	// DifferencesTotal Count of all the differing paths
+	DifferencesTotal int        `json:"differencesTotal"`
+	FinishedAt       *time.Time `json:"finishedAt,omitempty"`
+
+	// IgnoredPaths Paths changing with every build, they are not compared
+	IgnoredPaths []string `json:"ignoredPaths"`
+	Reason       *string  `json:"reason,omitempty"`
+
+	// RebuildID Build ID of the rebuild, its files are removed after the comparison
+	RebuildID string                          `json:"rebuildID"`
+	StartedAt time.Time                       `json:"startedAt"`
+	Status    TemplateBuildVerificationStatus `json:"status"`
+}
+
+// TemplateBuildVerificationStatus defines model for TemplateBuildVerification.Status.
+type TemplateBuildVerificationStatus string
+
+// TemplateBuildVerify Values not stored with the build, they are needed to rebuild it
+type TemplateBuildVerify struct {
+	FromImageRegistry *FromImageRegistry `json:"fromImageRegistry,omitempty"`
+
+	// Secrets Values of the secrets mounted to the RUN steps, they are not stored
+	Secrets *map[string]string `json:"secrets,omitempty"`
+}
+
+// TemplateBuildWebhook defines model for TemplateBuildWebhook.
+type TemplateBuildWebhook struct {
+	// Secret Secret used to sign the webhook request body, the HMAC-SHA256 signature is sent in the X-Webhook-Signature header
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL called with a POST request when the build finishes or fails
+	Url string `json:"url"`
+}
+
+// TemplateBuildWebhookEvent defines model for TemplateBuildWebhookEvent.
+type TemplateBuildWebhookEvent struct {
+	// BuildID Identifier of the build
+	BuildID string             `json:"buildID"`
+	Reason  *BuildStatusReason `json:"reason,omitempty"`
+
+	// Status Final status of the build
+	Status TemplateBuildWebhookEventStatus `json:"status"`
+
+	// TemplateID Identifier of the template
+	TemplateID string `json:"templateID"`
+
+	// Timestamp Time when the build finished
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TemplateBuildWebhookEventStatus Final status of the build
+type TemplateBuildWebhookEventStatus string
+
+// TemplateCacheInvalidation defines model for TemplateCacheInvalidation.
+type TemplateCacheInvalidation struct {
+	// Hashes Hashes of the invalidated layers
+	Hashes []string `json:"hashes"`
+}
+
+// TemplateCachedLayer defines model for TemplateCachedLayer.
+type TemplateCachedLayer struct {
+	// BuildID Identifier of the build containing the layer
+	BuildID string `json:"buildID"`
+
+	// CreatedAt Time when the layer was cached
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Hash Cache hash of the layer
+	Hash string `json:"hash"`
+
+	// SizeBytes Size of the layer in bytes
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// Step Build step that created the layer
+	Step string `json:"step"`
+}
+
+// TemplateProvenanceBase defines model for TemplateProvenanceBase.
+type TemplateProvenanceBase struct {
+	Cached bool `json:"cached"`
+
+	// FromBuildID Build of the base template
+	FromBuildID *string `json:"fromBuildID,omitempty"`
+	FromImage   *string `json:"fromImage,omitempty"`
+
+	// FromImageDigest Digest of the pulled base image
+	FromImageDigest *string `json:"fromImageDigest,omitempty"`
+	FromTemplate    *string `json:"fromTemplate,omitempty"`
+	Hash            string  `json:"hash"`
+}
+
+// TemplateProvenanceStage defines model for TemplateProvenanceStage.
+type TemplateProvenanceStage struct {
+	Base  TemplateProvenanceBase   `json:"base"`
+	Name  string                   `json:"name"`
+	Steps []TemplateProvenanceStep `json:"steps"`
+}
+
+// TemplateProvenanceStep defines model for TemplateProvenanceStep.
+type TemplateProvenanceStep struct {
+	Args      []string `json:"args"`
+	Cached    bool     `json:"cached"`
+	FilesHash *string  `json:"filesHash,omitempty"`
+	FromStage *string  `json:"fromStage,omitempty"`
+	Hash      string   `json:"hash"`
+	Number    int      `json:"number"`
+	Type      string   `json:"type"`
+}
+
+// TemplateRootfsDifference defines model for TemplateRootfsDifference.
+type TemplateRootfsDifference struct {
+	// Detail What changed on the modified path (content, mode, owner, type or link)
+	Detail *string                      `json:"detail,omitempty"`
+	Kind   TemplateRootfsDifferenceKind `json:"kind"`
+	Path   string                       `json:"path"`
 }
 
+// TemplateRootfsDifferenceKind defines model for TemplateRootfsDifference.Kind.
+type TemplateRootfsDifferenceKind string
+
 // TemplateStep Step in the template build process
 type TemplateStep struct {
 	// Args Arguments for the step
 	Args *[]string `json:"args,omitempty"`
 
+	// CpuCount CPU cores for the sandbox
+	CpuCount *CPUCount `json:"cpuCount,omitempty"`
+
 	// FilesHash Hash of the files used in the step
 	FilesHash *string `json:"filesHash,omitempty"`
 
 	// Force Whether the step should be forced to run regardless of the cache
 	Force *bool `json:"force,omitempty"`
 
+	// FromStage Name of the build stage the files are copied from, only used by the COPY step
+	FromStage *string `json:"fromStage,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MiB
+	MemoryMB *MemoryMB `json:"memoryMB,omitempty"`
+
+	// Mounts Mounts available only while the command runs, only used by the RUN step
+	Mounts *[]TemplateStepMount `json:"mounts,omitempty"`
+
+	// Timeout Maximum duration of the step in seconds, the build fails when the step exceeds it. Only used by the RUN step
+	Timeout *int32 `json:"timeout,omitempty"`
+
 	// Type Type of the step
 	Type string `json:"type"`
 }
 
+// TemplateStepMount Mount of the RUN step, its content is never saved in the template
+type TemplateStepMount struct {
+	// Env Environment variable the secret is exposed in instead of the file
+	Env *string `json:"env,omitempty"`
+
+	// Id Name of the secret, or the key of the cache shared between the builds of the team (defaults to the target)
+	Id *string `json:"id,omitempty"`
+
+	// Required Whether the step fails if the secret is not provided
+	Required *bool `json:"required,omitempty"`
+
+	// Target Path of the secret file (defaults to /run/secrets/<id>) or of the cache directory
+	Target *string `json:"target,omitempty"`
+
+	// Type Type of the mount
+	Type TemplateStepMountType `json:"type"`
+}
+
+// TemplateStepMountType Type of the mount
+type TemplateStepMountType string
+
+// TemplateTag defines model for TemplateTag.
+type TemplateTag struct {
+	// BuildID Identifier of the tagged build
+	BuildID string `json:"buildID"`
+
+	// CreatedAt Time when the tag was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Tag Name of the tag
+	Tag string `json:"tag"`
+
+	// UpdatedAt Time when the tag was last moved to a build
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TemplateTagAssign defines model for TemplateTagAssign.
+type TemplateTagAssign struct {
+	// BuildID Identifier of the build the tag should point to, it has to be a finished build of the template
+	BuildID string `json:"buildID"`
+}
+
 // TemplateUpdateRequest defines model for TemplateUpdateRequest.
 type TemplateUpdateRequest struct {
 	// Public Whether the template is public or only accessible by the team
@@ -812,6 +1345,27 @@ type UpdateTeamAPIKey struct {
 	Name string `json:"name"`
 }
 
+// Volume defines model for Volume.
+type Volume struct {
+	// CreatedAt Time when the volume was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// MountPath Path in the sandbox where the volume is mounted
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// Name Name of the volume
+	Name string `json:"name"`
+
+	// SandboxID Identifier of the sandbox the volume is mounted to
+	SandboxID *string `json:"sandboxID,omitempty"`
+
+	// SizeMB Size of the volume in MiB
+	SizeMB int64 `json:"sizeMB"`
+
+	// VolumeID Identifier of the volume
+	VolumeID string `json:"volumeID"`
+}
+
 // AccessTokenID defines model for accessTokenID.
 type AccessTokenID = string
 
@@ -821,18 +1375,30 @@ type ApiKeyID = string
 // BuildID defines model for buildID.
 type BuildID = string
 
+// CheckpointName defines model for checkpointName.
+type CheckpointName = string
+
 // NodeID defines model for nodeID.
 type NodeID = string
 
 // SandboxID defines model for sandboxID.
 type SandboxID = string
 
+// ScheduleID defines model for scheduleID.
+type ScheduleID = string
+
+// Tag defines model for tag.
+type Tag = string
+
 // TeamID defines model for teamID.
 type TeamID = string
 
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// VolumeID defines model for volumeID.
+type VolumeID = string
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -848,6 +1414,9 @@ type N404 = Error
 // N409 defines model for 409.
 type N409 = Error
 
+// N429 defines model for 429.
+type N429 = Error
+
 // N500 defines model for 500.
 type N500 = Error
 
@@ -922,6 +1491,19 @@ type GetTemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
 }
 
+// GetTemplatesTemplateIDBuildsBuildIDExportParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDExport.
+type GetTemplatesTemplateIDBuildsBuildIDExportParams struct {
+	// Tag Tag of the exported image, defaults to <templateID>:<buildID>
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDLogsStreamParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDLogsStream.
+type GetTemplatesTemplateIDBuildsBuildIDLogsStreamParams struct {
+	// LogsOffset Index of the starting build log that should be streamed
+	LogsOffset *int32    `form:"logsOffset,omitempty" json:"logsOffset,omitempty"`
+	Level      *LogLevel `form:"level,omitempty" json:"level,omitempty"`
+}
+
 // GetTemplatesTemplateIDBuildsBuildIDStatusParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDStatus.
 type GetTemplatesTemplateIDBuildsBuildIDStatusParams struct {
 	// LogsOffset Index of the starting build log that should be returned with the template
@@ -929,6 +1511,12 @@ type GetTemplatesTemplateIDBuildsBuildIDStatusParams struct {
 	Level      *LogLevel `form:"level,omitempty" json:"level,omitempty"`
 }
 
+// DeleteTemplatesTemplateIDCacheParams defines parameters for DeleteTemplatesTemplateIDCache.
+type DeleteTemplatesTemplateIDCacheParams struct {
+	// Hash Hashes of the layers to invalidate, all the layers of the template are invalidated if not set
+	Hash *[]string `form:"hash,omitempty" json:"hash,omitempty"`
+}
+
 // GetV2SandboxesParams defines parameters for GetV2Sandboxes.
 type GetV2SandboxesParams struct {
 	// Metadata Metadata query used to filter the sandboxes (e.g. "user=abc&app=prod"). Each key and values must be URL encoded.
@@ -959,12 +1547,30 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PostSandboxesSandboxIDCheckpointsJSONRequestBody defines body for PostSandboxesSandboxIDCheckpoints for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsJSONRequestBody = NewSandboxCheckpoint
+
+// PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreJSONRequestBody defines body for PostSandboxesSandboxIDCheckpointsCheckpointNameRestore for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreJSONRequestBody = ResumedSandbox
+
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = SandboxForkRequest
+
+// PutSandboxesSandboxIDIngressJSONRequestBody defines body for PutSandboxesSandboxIDIngress for application/json ContentType.
+type PutSandboxesSandboxIDIngressJSONRequestBody = SandboxIngressConfig
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
+// PatchSandboxesSandboxIDResourcesJSONRequestBody defines body for PatchSandboxesSandboxIDResources for application/json ContentType.
+type PatchSandboxesSandboxIDResourcesJSONRequestBody = SandboxResourcesUpdate
+
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
+// PostSandboxesSandboxIDSchedulesJSONRequestBody defines body for PostSandboxesSandboxIDSchedules for application/json ContentType.
+type PostSandboxesSandboxIDSchedulesJSONRequestBody = NewSandboxSchedule
+
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
 
@@ -977,12 +1583,21 @@ type PatchTemplatesTemplateIDJSONRequestBody = TemplateUpdateRequest
 // PostTemplatesTemplateIDJSONRequestBody defines body for PostTemplatesTemplateID for application/json ContentType.
 type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
 
+// PostTemplatesTemplateIDBuildsBuildIDVerifyJSONRequestBody defines body for PostTemplatesTemplateIDBuildsBuildIDVerify for application/json ContentType.
+type PostTemplatesTemplateIDBuildsBuildIDVerifyJSONRequestBody = TemplateBuildVerify
+
+// PutTemplatesTemplateIDTagsTagJSONRequestBody defines body for PutTemplatesTemplateIDTagsTag for application/json ContentType.
+type PutTemplatesTemplateIDTagsTagJSONRequestBody = TemplateTagAssign
+
 // PostV2TemplatesJSONRequestBody defines body for PostV2Templates for application/json ContentType.
 type PostV2TemplatesJSONRequestBody = TemplateBuildRequestV2
 
 // PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody defines body for PostV2TemplatesTemplateIDBuildsBuildID for application/json ContentType.
 type PostV2TemplatesTemplateIDBuildsBuildIDJSONRequestBody = TemplateBuildStartV2
 
+// PostVolumesJSONRequestBody defines body for PostVolumes for application/json ContentType.
+type PostVolumesJSONRequestBody = NewVolume
+
 // AsAWSRegistry returns the union data inside the FromImageRegistry as a AWSRegistry
 func (t FromImageRegistry) AsAWSRegistry() (AWSRegistry, error) {
 	var body AWSRegistry
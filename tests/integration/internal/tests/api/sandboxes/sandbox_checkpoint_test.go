@@ -0,0 +1,111 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxCheckpoint(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	t.Run("create, restore and delete checkpoint", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, api.NewSandboxCheckpoint{Name: "first"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+		require.NotNil(t, createResp.JSON201)
+		assert.Equal(t, "first", createResp.JSON201.Name)
+
+		// The sandbox keeps running after the checkpoint
+		res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Running, res.JSON200.State)
+
+		duplicateResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, api.NewSandboxCheckpoint{Name: "first"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, duplicateResp.StatusCode())
+
+		listResp, err := c.GetSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, listResp.StatusCode())
+		require.NotNil(t, listResp.JSON200)
+		require.Len(t, *listResp.JSON200, 1)
+		assert.Equal(t, "first", (*listResp.JSON200)[0].Name)
+
+		restoreResp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreWithResponse(t.Context(), sbxId, "first", api.ResumedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, restoreResp.StatusCode())
+		require.NotNil(t, restoreResp.JSON201)
+		assert.Equal(t, sbxId, restoreResp.JSON201.SandboxID)
+
+		deleteResp, err := c.DeleteSandboxesSandboxIDCheckpointsCheckpointNameWithResponse(t.Context(), sbxId, "first", setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+		restoreResp, err = c.PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreWithResponse(t.Context(), sbxId, "first", api.ResumedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, restoreResp.StatusCode())
+	})
+
+	t.Run("restore the config of the checkpoint", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		detail, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.NotNil(t, detail.JSON200)
+		templateMemoryMB := detail.JSON200.MemoryMB
+
+		createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, api.NewSandboxCheckpoint{Name: "full"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		resizeResp, err := c.PatchSandboxesSandboxIDResourcesWithResponse(t.Context(), sbxId, api.SandboxResourcesUpdate{
+			MemoryMB: templateMemoryMB / 2,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resizeResp.StatusCode(), string(resizeResp.Body))
+
+		// The later checkpoint saves the reduced memory to the sandbox snapshot
+		createResp, err = c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, api.NewSandboxCheckpoint{Name: "reduced"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		restoreResp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreWithResponse(t.Context(), sbxId, "full", api.ResumedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, restoreResp.StatusCode())
+
+		detail, err = c.GetSandboxesSandboxIDWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.NotNil(t, detail.JSON200)
+		assert.Equal(t, templateMemoryMB, detail.JSON200.MemoryMB)
+	})
+
+	t.Run("checkpoint paused sandbox", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		pauseResp, err := c.PostSandboxesSandboxIDPauseWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, pauseResp.StatusCode())
+
+		createResp, err := c.PostSandboxesSandboxIDCheckpointsWithResponse(t.Context(), sbxId, api.NewSandboxCheckpoint{Name: "paused"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		restoreResp, err := c.PostSandboxesSandboxIDCheckpointsCheckpointNameRestoreWithResponse(t.Context(), sbxId, "paused", api.ResumedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, restoreResp.StatusCode())
+	})
+}
@@ -0,0 +1,66 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxDNS(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+		Network: &api.SandboxNetworkConfig{
+			Dns: &api.SandboxDNSConfig{
+				Searches: &[]string{"internal.e2b.dev"},
+				Hosts: &[]api.SandboxHostEntry{
+					{Ip: "10.20.30.40", Hostname: "pinned.internal.e2b.dev"},
+				},
+			},
+		},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+	t.Cleanup(func() {
+		client.DeleteSandboxesSandboxIDWithResponse(ctx, resp.JSON201.SandboxID, setup.WithAPIKey())
+	})
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	// The DNS config is applied asynchronously after the init
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "bash", "-c", "for i in $(seq 20); do getent hosts pinned.internal.e2b.dev | grep -q 10.20.30.40 && grep -q 'search internal.e2b.dev' /etc/resolv.conf && exit 0; sleep 0.5; done; exit 1")
+	require.NoError(t, err, "Expected the host entry and the search domain to be applied")
+}
+
+func TestSandboxDNSInvalid(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	for _, dns := range []api.SandboxDNSConfig{
+		{Nameservers: &[]string{"not an ip"}},
+		{Hosts: &[]api.SandboxHostEntry{{Ip: "10.20.30.40", Hostname: "bad host"}}},
+		{Hosts: &[]api.SandboxHostEntry{{Ip: "invalid", Hostname: "pinned.internal.e2b.dev"}}},
+	} {
+		resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID: setup.SandboxTemplateID,
+			Timeout:    &sbxTimeout,
+			Network:    &api.SandboxNetworkConfig{Dns: &dns},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	}
+}
@@ -0,0 +1,100 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestEgressRules(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	testCases := []struct {
+		name         string
+		network      api.SandboxNetworkConfig
+		googleAccess bool
+		cloudflare   bool
+	}{
+		{
+			name: "deny_cidr",
+			network: api.SandboxNetworkConfig{
+				DenyOut: &[]string{"1.1.1.1"},
+			},
+			googleAccess: true,
+			cloudflare:   false,
+		},
+		{
+			name: "allow_only_domain",
+			network: api.SandboxNetworkConfig{
+				AllowOut: &[]string{"www.google.com"},
+				DenyOut:  &[]string{"0.0.0.0/0"},
+			},
+			googleAccess: true,
+			cloudflare:   false,
+		},
+		{
+			name: "deny_domain",
+			network: api.SandboxNetworkConfig{
+				DenyOut: &[]string{"www.google.com"},
+			},
+			googleAccess: false,
+			cloudflare:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+				TemplateID: setup.SandboxTemplateID,
+				Timeout:    &sbxTimeout,
+				Network:    &tc.network,
+			}, setup.WithAPIKey())
+			require.NoError(t, err)
+			require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+			require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+			envdClient := setup.GetEnvdClient(t, ctx)
+
+			err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "curl", "--connect-timeout", "3", "--max-time", "5", "-Is", "https://www.google.com")
+			if tc.googleAccess {
+				require.NoError(t, err, "Expected curl command to succeed when the domain is allowed")
+			} else {
+				require.Error(t, err, "Expected curl command to fail when the domain is denied")
+			}
+
+			err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "curl", "--connect-timeout", "3", "--max-time", "5", "-Is", "https://1.1.1.1")
+			if tc.cloudflare {
+				require.NoError(t, err, "Expected curl command to succeed when the address is allowed")
+			} else {
+				require.Error(t, err, "Expected curl command to fail when the address is denied")
+			}
+		})
+	}
+}
+
+func TestEgressRulesInvalid(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(30)
+
+	client := setup.GetAPIClient()
+
+	for _, entry := range []string{"2001:db8::/32", "not a domain", "0.0.0.0/0"} {
+		resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID: setup.SandboxTemplateID,
+			Timeout:    &sbxTimeout,
+			Network: &api.SandboxNetworkConfig{
+				AllowOut: &[]string{entry},
+			},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode(), "Expected status code 400 Bad Request for '%s'", entry)
+	}
+}
@@ -0,0 +1,62 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxFork(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	t.Run("fork running sandbox", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c)
+
+		count := int32(2)
+		resp, err := c.PostSandboxesSandboxIDForkWithResponse(t.Context(), sbx.SandboxID, api.PostSandboxesSandboxIDForkJSONRequestBody{
+			Count:    &count,
+			Metadata: &api.SandboxMetadata{"fork": "true"},
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+		require.Len(t, resp.JSON201.Sandboxes, int(count))
+		assert.Empty(t, resp.JSON201.FailedSandboxIDs)
+
+		for _, fork := range resp.JSON201.Sandboxes {
+			t.Cleanup(func() {
+				utils.TeardownSandbox(t, c, fork.SandboxID)
+			})
+
+			assert.NotEqual(t, sbx.SandboxID, fork.SandboxID)
+			assert.Equal(t, sbx.TemplateID, fork.TemplateID)
+
+			res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), fork.SandboxID, setup.WithAPIKey())
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, res.StatusCode())
+			require.NotNil(t, res.JSON200)
+			assert.Equal(t, api.Running, res.JSON200.State)
+			require.NotNil(t, res.JSON200.Metadata)
+			assert.Equal(t, "true", (*res.JSON200.Metadata)["fork"])
+		}
+
+		// The original sandbox keeps running
+		res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Running, res.JSON200.State)
+	})
+
+	t.Run("fork non-existing sandbox", func(t *testing.T) {
+		resp, err := c.PostSandboxesSandboxIDForkWithResponse(t.Context(), "non-existing", api.PostSandboxesSandboxIDForkJSONRequestBody{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
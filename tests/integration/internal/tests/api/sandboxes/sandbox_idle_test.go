@@ -0,0 +1,61 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestSandboxIdlePause(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(600)
+	idleTimeout := int32(60)
+
+	client := setup.GetAPIClient()
+
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID:  setup.SandboxTemplateID,
+		Timeout:     &sbxTimeout,
+		IdleTimeout: &idleTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201, "Expected non-nil response body")
+
+	sbxId := resp.JSON201.SandboxID
+
+	t.Cleanup(func() {
+		client.DeleteSandboxesSandboxIDWithResponse(ctx, sbxId, setup.WithAPIKey())
+	})
+
+	// The sandbox is paused well before its timeout, the activity is reported with the node sync
+	require.Eventually(t, func() bool {
+		res, err := client.GetSandboxesSandboxIDWithResponse(ctx, sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		return res.StatusCode() == http.StatusOK && res.JSON200 != nil && res.JSON200.State == api.Paused
+	}, 3*time.Minute, time.Second, "Sandbox was not paused when idle")
+
+	// The idle timeout is kept when the sandbox is resumed
+	resumeResp, err := client.PostSandboxesSandboxIDResumeWithResponse(ctx, sbxId, api.PostSandboxesSandboxIDResumeJSONRequestBody{}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resumeResp.StatusCode())
+}
+
+func TestSandboxIdleTimeoutInvalid(t *testing.T) {
+	ctx := t.Context()
+	idleTimeout := int32(10)
+
+	client := setup.GetAPIClient()
+
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID:  setup.SandboxTemplateID,
+		IdleTimeout: &idleTimeout,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
@@ -0,0 +1,92 @@
+package sandboxes
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func proxyPortRequest(t *testing.T, sandboxID string, port int, trafficAccessToken string) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, setup.EnvdProxy, nil)
+	require.NoError(t, err)
+
+	setup.SetSandboxHeader(req.Header, sandboxID)
+	req.Host = strings.Replace(req.Header.Get("Host"), "49983-", fmt.Sprintf("%d-", port), 1)
+
+	if trafficAccessToken != "" {
+		req.Header.Set("e2b-traffic-access-token", trafficAccessToken)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestSandboxIngress(t *testing.T) {
+	ctx := t.Context()
+	sbxTimeout := int32(60)
+
+	client := setup.GetAPIClient()
+
+	defaultAccess := api.Token
+	resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+		TemplateID: setup.SandboxTemplateID,
+		Timeout:    &sbxTimeout,
+		Network: &api.SandboxNetworkConfig{
+			Ingress: &api.SandboxIngressConfig{
+				DefaultAccess: &defaultAccess,
+				Ports: &[]api.SandboxPortIngress{
+					{Port: 8000, Access: api.Public},
+					{Port: 9000, Access: api.Closed},
+				},
+			},
+		},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+	require.NotNil(t, resp.JSON201.TrafficAccessToken)
+
+	sbxId := resp.JSON201.SandboxID
+	token := *resp.JSON201.TrafficAccessToken
+
+	t.Cleanup(func() {
+		client.DeleteSandboxesSandboxIDWithResponse(ctx, sbxId, setup.WithAPIKey())
+	})
+
+	assert.Equal(t, http.StatusForbidden, proxyPortRequest(t, sbxId, 3000, ""))
+	assert.Equal(t, http.StatusForbidden, proxyPortRequest(t, sbxId, 3000, "invalid"))
+	assert.NotEqual(t, http.StatusForbidden, proxyPortRequest(t, sbxId, 3000, token))
+	assert.NotEqual(t, http.StatusForbidden, proxyPortRequest(t, sbxId, 8000, ""))
+
+	publicAccess := api.Public
+	updateResp, err := client.PutSandboxesSandboxIDIngressWithResponse(ctx, sbxId, api.SandboxIngressConfig{
+		DefaultAccess: &publicAccess,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, updateResp.StatusCode())
+	require.NotNil(t, updateResp.JSON200)
+	assert.Equal(t, token, updateResp.JSON200.TrafficAccessToken)
+
+	assert.NotEqual(t, http.StatusForbidden, proxyPortRequest(t, sbxId, 3000, ""))
+
+	duplicateResp, err := client.PutSandboxesSandboxIDIngressWithResponse(ctx, sbxId, api.SandboxIngressConfig{
+		Ports: &[]api.SandboxPortIngress{
+			{Port: 8000, Access: api.Public},
+			{Port: 8000, Access: api.Closed},
+		},
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, duplicateResp.StatusCode())
+}
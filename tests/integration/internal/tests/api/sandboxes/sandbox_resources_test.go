@@ -0,0 +1,67 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxResizeMemory(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	sbx := utils.SetupSandboxWithCleanup(t, c)
+
+	detail, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, detail.StatusCode())
+	require.NotNil(t, detail.JSON200)
+
+	templateMemoryMB := detail.JSON200.MemoryMB
+	reducedMemoryMB := templateMemoryMB / 2
+
+	// Shrink the memory
+	resp, err := c.PatchSandboxesSandboxIDResourcesWithResponse(t.Context(), sbx.SandboxID, api.SandboxResourcesUpdate{
+		MemoryMB: reducedMemoryMB,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, reducedMemoryMB, resp.JSON200.MemoryMB)
+	assert.Equal(t, templateMemoryMB, resp.JSON200.MaxMemoryMB)
+
+	detail, err = c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.NotNil(t, detail.JSON200)
+	assert.Equal(t, reducedMemoryMB, detail.JSON200.MemoryMB)
+
+	// Grow it back
+	resp, err = c.PatchSandboxesSandboxIDResourcesWithResponse(t.Context(), sbx.SandboxID, api.SandboxResourcesUpdate{
+		MemoryMB: templateMemoryMB,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode(), string(resp.Body))
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, templateMemoryMB, resp.JSON200.MemoryMB)
+}
+
+func TestSandboxResizeMemoryOverTemplate(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	sbx := utils.SetupSandboxWithCleanup(t, c)
+
+	detail, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbx.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.NotNil(t, detail.JSON200)
+
+	resp, err := c.PatchSandboxesSandboxIDResourcesWithResponse(t.Context(), sbx.SandboxID, api.SandboxResourcesUpdate{
+		MemoryMB: detail.JSON200.MemoryMB * 2,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+}
@@ -0,0 +1,111 @@
+package sandboxes
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestSandboxSchedule(t *testing.T) {
+	c := setup.GetAPIClient()
+
+	t.Run("create, list and delete schedule", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		cron := "0 3 * * *"
+		command := "echo maintenance"
+		createResp, err := c.PostSandboxesSandboxIDSchedulesWithResponse(t.Context(), sbxId, api.NewSandboxSchedule{
+			Action:  api.Resume,
+			Cron:    &cron,
+			Command: &command,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+		require.NotNil(t, createResp.JSON201)
+		assert.Equal(t, api.Resume, createResp.JSON201.Action)
+		assert.True(t, createResp.JSON201.NextRunAt.After(time.Now()))
+
+		listResp, err := c.GetSandboxesSandboxIDSchedulesWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, listResp.StatusCode())
+		require.NotNil(t, listResp.JSON200)
+		require.Len(t, *listResp.JSON200, 1)
+
+		deleteResp, err := c.DeleteSandboxesSandboxIDSchedulesScheduleIDWithResponse(t.Context(), sbxId, createResp.JSON201.ScheduleID, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+		deleteResp, err = c.DeleteSandboxesSandboxIDSchedulesScheduleIDWithResponse(t.Context(), sbxId, createResp.JSON201.ScheduleID, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode())
+	})
+
+	t.Run("scheduled resume runs the command and pauses again", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		pauseResp, err := c.PostSandboxesSandboxIDPauseWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, pauseResp.StatusCode())
+
+		runAt := time.Now()
+		command := "echo maintenance > /tmp/scheduled"
+		createResp, err := c.PostSandboxesSandboxIDSchedulesWithResponse(t.Context(), sbxId, api.NewSandboxSchedule{
+			Action:  api.Resume,
+			RunAt:   &runAt,
+			Command: &command,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+		// The one-off schedule is removed after the run
+		require.Eventually(t, func() bool {
+			listResp, err := c.GetSandboxesSandboxIDSchedulesWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+			return err == nil && listResp.JSON200 != nil && len(*listResp.JSON200) == 0
+		}, 2*time.Minute, time.Second)
+
+		res, err := c.GetSandboxesSandboxIDWithResponse(t.Context(), sbxId, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		assert.Equal(t, api.Paused, res.JSON200.State)
+
+		resumeResp, err := c.PostSandboxesSandboxIDResumeWithResponse(t.Context(), sbxId, api.ResumedSandbox{}, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resumeResp.StatusCode())
+
+		envdClient := setup.GetEnvdClient(t, t.Context())
+		err = utils.ExecCommand(t, t.Context(), resumeResp.JSON201, envdClient, "grep", "-q", "maintenance", "/tmp/scheduled")
+		require.NoError(t, err, "Expected the file written by the scheduled command")
+	})
+
+	t.Run("invalid schedules", func(t *testing.T) {
+		sbx := utils.SetupSandboxWithCleanup(t, c, utils.WithAutoPause(false))
+		sbxId := sbx.SandboxID
+
+		cron := "not a cron"
+		runAt := time.Now().Add(time.Hour)
+		command := "echo maintenance"
+		for _, schedule := range []api.NewSandboxSchedule{
+			{Action: api.Pause},
+			{Action: api.Pause, Cron: &cron},
+			{Action: api.Pause, RunAt: &runAt, Command: &command},
+		} {
+			resp, err := c.PostSandboxesSandboxIDSchedulesWithResponse(t.Context(), sbxId, schedule, setup.WithAPIKey())
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		}
+
+		resp, err := c.PostSandboxesSandboxIDSchedulesWithResponse(t.Context(), "nonexistent", api.NewSandboxSchedule{Action: api.Kill, RunAt: &runAt}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
@@ -0,0 +1,122 @@
+package sandboxes
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func createVolume(t *testing.T, client *api.ClientWithResponses) api.Volume {
+	t.Helper()
+
+	resp, err := client.PostVolumesWithResponse(t.Context(), api.NewVolume{
+		Name:   fmt.Sprintf("test-volume-%d", time.Now().UnixNano()),
+		SizeMB: 64,
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+
+	t.Cleanup(func() {
+		client.DeleteVolumesVolumeIDWithResponse(t.Context(), resp.JSON201.VolumeID, setup.WithAPIKey())
+	})
+
+	return *resp.JSON201
+}
+
+func createSandboxWithVolume(t *testing.T, client *api.ClientWithResponses, volumeID string) (*api.PostSandboxesResponse, error) {
+	t.Helper()
+
+	sbxTimeout := int32(60)
+
+	return client.PostSandboxesWithResponse(t.Context(), api.NewSandbox{
+		TemplateID:   setup.SandboxTemplateID,
+		Timeout:      &sbxTimeout,
+		VolumeMounts: &[]api.SandboxVolumeMount{{VolumeID: volumeID, Path: "/mnt/data"}},
+	}, setup.WithAPIKey())
+}
+
+func TestSandboxVolumes(t *testing.T) {
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	volume := createVolume(t, client)
+
+	duplicateResp, err := client.PostVolumesWithResponse(ctx, api.NewVolume{Name: volume.Name, SizeMB: 64}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, duplicateResp.StatusCode())
+
+	resp, err := createSandboxWithVolume(t, client, volume.VolumeID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	// The volume is mounted asynchronously after the init
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "bash", "-c", "for i in $(seq 20); do mountpoint -q /mnt/data && echo persisted > /mnt/data/file && exit 0; sleep 0.5; done; exit 1")
+	require.NoError(t, err, "Expected the volume to be mounted")
+
+	getResp, err := client.GetVolumesVolumeIDWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, getResp.StatusCode())
+	require.NotNil(t, getResp.JSON200)
+	assert.Equal(t, resp.JSON201.SandboxID, *getResp.JSON200.SandboxID)
+
+	// The volume can be mounted only to one sandbox at a time
+	conflictResp, err := createSandboxWithVolume(t, client, volume.VolumeID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, conflictResp.StatusCode())
+
+	deleteResp, err := client.DeleteVolumesVolumeIDWithResponse(ctx, volume.VolumeID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, deleteResp.StatusCode())
+
+	killResp, err := client.DeleteSandboxesSandboxIDWithResponse(ctx, resp.JSON201.SandboxID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, killResp.StatusCode())
+
+	resp, err = createSandboxWithVolume(t, client, volume.VolumeID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode(), "Expected status code 201 Created, got %d", resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+
+	t.Cleanup(func() {
+		client.DeleteSandboxesSandboxIDWithResponse(ctx, resp.JSON201.SandboxID, setup.WithAPIKey())
+	})
+
+	err = utils.ExecCommand(t, ctx, resp.JSON201, envdClient, "bash", "-c", "for i in $(seq 20); do grep -q persisted /mnt/data/file && exit 0; sleep 0.5; done; exit 1")
+	require.NoError(t, err, "Expected the file written by the previous sandbox")
+}
+
+func TestSandboxVolumesInvalid(t *testing.T) {
+	ctx := t.Context()
+	client := setup.GetAPIClient()
+
+	volume := createVolume(t, client)
+
+	for _, mounts := range [][]api.SandboxVolumeMount{
+		{{VolumeID: volume.VolumeID, Path: "relative/path"}},
+		{{VolumeID: volume.VolumeID, Path: "/"}},
+		{{VolumeID: volume.VolumeID, Path: "/mnt/a"}, {VolumeID: volume.VolumeID, Path: "/mnt/b"}},
+	} {
+		resp, err := client.PostSandboxesWithResponse(ctx, api.NewSandbox{
+			TemplateID:   setup.SandboxTemplateID,
+			VolumeMounts: &mounts,
+		}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	}
+
+	resp, err := createSandboxWithVolume(t, client, "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
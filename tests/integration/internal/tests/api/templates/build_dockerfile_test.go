@@ -0,0 +1,103 @@
+package api_templates
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateBuildDockerfile(t *testing.T) {
+	t.Parallel()
+
+	dockerfile := `ARG BASE=ubuntu:20.04
+FROM ${BASE}
+
+ARG GREETING=hello
+ENV APP_HOME=/app \
+    GREETING_VAR=${GREETING}
+
+WORKDIR $APP_HOME
+RUN [[ "$(pwd)" == "/app" ]] || exit 1; \
+    [[ "$GREETING_VAR" == "world" ]] || exit 2
+CMD echo "$GREETING_VAR"
+`
+
+	assert.True(t, buildTemplate(t, "test-ubuntu-dockerfile", api.TemplateBuildStartV2{
+		Force:      utils.ToPtr(ForceBaseBuild),
+		Dockerfile: utils.ToPtr(dockerfile),
+		BuildArgs:  utils.ToPtr(map[string]string{"BASE": "ubuntu:22.04", "GREETING": "world"}),
+		ReadyCmd:   utils.ToPtr("sleep 2"),
+	}, defaultBuildLogHandler(t)))
+}
+
+func TestTemplateBuildDockerfileMultiStage(t *testing.T) {
+	t.Parallel()
+
+	dockerfile := `FROM ubuntu:22.04 AS build
+RUN mkdir -p /out/bin && echo "built in stage" > /out/bin/artifact
+
+FROM ubuntu:22.04
+COPY --from=build /out/bin /opt/bin/
+RUN [[ "$(cat /opt/bin/artifact)" == "built in stage" ]] || exit 1
+`
+
+	assert.True(t, buildTemplate(t, "test-ubuntu-dockerfile-multi-stage", api.TemplateBuildStartV2{
+		Force:      utils.ToPtr(true),
+		Dockerfile: utils.ToPtr(dockerfile),
+	}, defaultBuildLogHandler(t)))
+}
+
+func TestTemplateBuildDockerfileInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesWithResponse(ctx, api.TemplateBuildRequestV2{
+		Alias: "test-ubuntu-dockerfile-invalid",
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode())
+	require.NotNil(t, resp.JSON202)
+
+	startResp, err := c.PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(
+		ctx,
+		resp.JSON202.TemplateID,
+		resp.JSON202.BuildID,
+		api.TemplateBuildStartV2{
+			Dockerfile: utils.ToPtr("FROM ubuntu:22.04\nONBUILD RUN echo\n"),
+		},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, startResp.StatusCode())
+	assert.Contains(t, string(startResp.Body), "dockerfile line 2")
+}
+
+func TestTemplateBuildDockerfileRunMounts(t *testing.T) {
+	t.Parallel()
+
+	dockerfile := `FROM ubuntu:22.04
+RUN --mount=type=secret,id=token,required \
+    --mount=type=secret,id=token,env=TOKEN \
+    --mount=type=cache,target=/var/cache/test \
+    [[ "$(cat /run/secrets/token)" == "secret-value" ]] || exit 1; \
+    [[ "$TOKEN" == "secret-value" ]] || exit 2; \
+    echo "cached" > /var/cache/test/file
+RUN [[ ! -e /run/secrets/token ]] || exit 1; \
+    [[ -z "$TOKEN" ]] || exit 2; \
+    [[ ! -e /var/cache/test/file ]] || exit 3
+`
+
+	assert.True(t, buildTemplate(t, "test-ubuntu-dockerfile-run-mounts", api.TemplateBuildStartV2{
+		Force:      utils.ToPtr(true),
+		Dockerfile: utils.ToPtr(dockerfile),
+		Secrets:    utils.ToPtr(map[string]string{"token": "secret-value"}),
+	}, defaultBuildLogHandler(t)))
+}
@@ -0,0 +1,70 @@
+package api_templates
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateBuildLogsStream(t *testing.T) {
+	t.Parallel()
+
+	template := buildTemplateWithResponse(t, "test-ubuntu-logs-stream", api.TemplateBuildStartV2{
+		Force:     utils.ToPtr(true),
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"echo 'streamed log'"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t))
+	require.NotNil(t, template)
+
+	c := setup.GetAPIClient()
+	resp, err := c.GetTemplatesTemplateIDBuildsBuildIDLogsStream(t.Context(), template.TemplateID, template.BuildID, nil, setup.WithAPIKey())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream"))
+
+	// The build is finished, the stream sends all the logs and closes with the status event
+	var event string
+	var logs []api.BuildLogEntry
+	var status *api.TemplateBuild
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data := []byte(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+			switch event {
+			case "log":
+				var entry api.BuildLogEntry
+				require.NoError(t, json.Unmarshal(data, &entry))
+				logs = append(logs, entry)
+			case "status":
+				status = &api.TemplateBuild{}
+				require.NoError(t, json.Unmarshal(data, status))
+			}
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.NotEmpty(t, logs)
+	require.NotNil(t, status)
+	assert.Equal(t, api.TemplateBuildStatusReady, status.Status)
+}
@@ -0,0 +1,104 @@
+package api_templates
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateBuildProvenance(t *testing.T) {
+	t.Parallel()
+
+	template := buildTemplateWithResponse(t, "test-ubuntu-provenance", api.TemplateBuildStartV2{
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"echo provenance > /provenance.txt"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t))
+	require.NotNil(t, template)
+
+	c := setup.GetAPIClient()
+	resp, err := c.GetTemplatesTemplateIDBuildsBuildIDProvenanceWithResponse(t.Context(), template.TemplateID, template.BuildID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+
+	provenance := resp.JSON200
+	assert.Equal(t, template.BuildID, provenance.BuildID)
+	assert.Equal(t, "ubuntu:22.04", utils.FromPtr(provenance.Base.FromImage))
+	assert.NotEmpty(t, utils.FromPtr(provenance.Base.FromImageDigest))
+	assert.NotEmpty(t, provenance.Base.Hash)
+	require.Len(t, provenance.Steps, 1)
+	assert.Equal(t, "RUN", provenance.Steps[0].Type)
+	assert.NotEmpty(t, provenance.Steps[0].Hash)
+	assert.NotEmpty(t, provenance.FinalizeHash)
+}
+
+func TestTemplateBuildVerify(t *testing.T) {
+	t.Parallel()
+
+	template := buildTemplateWithResponse(t, "test-ubuntu-verify", api.TemplateBuildStartV2{
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "ENV",
+				Args: utils.ToPtr([]string{"VERIFIED", "true"}),
+			},
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"date +%s%N > /build-time.txt"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t))
+	require.NotNil(t, template)
+
+	c := setup.GetAPIClient()
+
+	getResp, err := c.GetTemplatesTemplateIDBuildsBuildIDVerifyWithResponse(t.Context(), template.TemplateID, template.BuildID, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResp.StatusCode())
+
+	verifyResp, err := c.PostTemplatesTemplateIDBuildsBuildIDVerifyWithResponse(t.Context(), template.TemplateID, template.BuildID, api.TemplateBuildVerify{}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, verifyResp.StatusCode())
+
+	ctx, cancel := context.WithTimeout(t.Context(), BuildTimeout)
+	defer cancel()
+
+	for {
+		getResp, err := c.GetTemplatesTemplateIDBuildsBuildIDVerifyWithResponse(ctx, template.TemplateID, template.BuildID, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, getResp.StatusCode())
+		require.NotNil(t, getResp.JSON200)
+
+		verification := getResp.JSON200
+		if verification.Status == api.TemplateBuildVerificationRunning {
+			time.Sleep(time.Second)
+
+			continue
+		}
+
+		// The file with the build time differs in every rebuild
+		require.Equal(t, api.TemplateBuildVerificationMismatch, verification.Status, utils.FromPtr(verification.Reason))
+		require.NotNil(t, verification.Differences)
+		assert.Contains(t, *verification.Differences, api.TemplateRootfsDifference{
+			Path:   "/build-time.txt",
+			Kind:   api.TemplateRootfsDifferenceModified,
+			Detail: utils.ToPtr("content"),
+		})
+		assert.NotEqual(t, template.BuildID, verification.RebuildID)
+
+		return
+	}
+}
@@ -0,0 +1,122 @@
+package api_templates
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateBuildResources(t *testing.T) {
+	t.Parallel()
+
+	// The template runs with 2 vCPUs and 1 GiB of RAM, the build sandboxes get more
+	assert.True(t, buildTemplate(t, "test-ubuntu-build-resources", api.TemplateBuildStartV2{
+		Force:         utils.ToPtr(true),
+		FromImage:     utils.ToPtr("ubuntu:22.04"),
+		BuildCpuCount: utils.ToPtr[int32](4),
+		BuildMemoryMB: utils.ToPtr[int32](2048),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"test $(nproc) -eq 4"}),
+			},
+			{
+				Type:     "RUN",
+				Args:     utils.ToPtr([]string{"test $(nproc) -eq 1"}),
+				CpuCount: utils.ToPtr[int32](1),
+			},
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"test $(nproc) -eq 4"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t)))
+}
+
+func TestTemplateBuildStepTimeout(t *testing.T) {
+	t.Parallel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesWithResponse(t.Context(), api.TemplateBuildRequestV2{
+		Alias:    "test-ubuntu-step-timeout",
+		CpuCount: utils.ToPtr[int32](2),
+		MemoryMB: utils.ToPtr[int32](1024),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode())
+	require.NotNil(t, resp.JSON202)
+
+	startResp, err := c.PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(t.Context(), resp.JSON202.TemplateID, resp.JSON202.BuildID, api.TemplateBuildStartV2{
+		Force:     utils.ToPtr(true),
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type:    "RUN",
+				Args:    utils.ToPtr([]string{"sleep 300"}),
+				Timeout: utils.ToPtr[int32](5),
+			},
+		}),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, startResp.StatusCode())
+
+	ctx, cancel := context.WithTimeout(t.Context(), BuildTimeout)
+	defer cancel()
+
+	for {
+		statusResp, err := c.GetTemplatesTemplateIDBuildsBuildIDStatusWithResponse(ctx, resp.JSON202.TemplateID, resp.JSON202.BuildID, nil, setup.WithAPIKey())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusResp.StatusCode())
+		require.NotNil(t, statusResp.JSON200)
+
+		switch statusResp.JSON200.Status {
+		case api.TemplateBuildStatusReady:
+			t.Fatal("build with the timed out step succeeded")
+		case api.TemplateBuildStatusError:
+			require.NotNil(t, statusResp.JSON200.Reason)
+			assert.Contains(t, statusResp.JSON200.Reason.Message, "timeout of 5s")
+			assert.NotNil(t, statusResp.JSON200.Reason.Step)
+
+			return
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+func TestTemplateBuildStepTimeoutInvalid(t *testing.T) {
+	t.Parallel()
+
+	c := setup.GetAPIClient()
+
+	resp, err := c.PostV2TemplatesWithResponse(t.Context(), api.TemplateBuildRequestV2{
+		Alias:    "test-ubuntu-step-timeout-invalid",
+		CpuCount: utils.ToPtr[int32](2),
+		MemoryMB: utils.ToPtr[int32](1024),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode())
+	require.NotNil(t, resp.JSON202)
+
+	startResp, err := c.PostV2TemplatesTemplateIDBuildsBuildIDWithResponse(t.Context(), resp.JSON202.TemplateID, resp.JSON202.BuildID, api.TemplateBuildStartV2{
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type:    "ENV",
+				Args:    utils.ToPtr([]string{"KEY", "value"}),
+				Timeout: utils.ToPtr[int32](5),
+			},
+		}),
+	}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, startResp.StatusCode())
+}
@@ -32,6 +32,18 @@ func buildTemplate(
 ) bool {
 	tb.Helper()
 
+	return buildTemplateWithResponse(tb, templateAlias, data, logHandler) != nil
+}
+
+// buildTemplateWithResponse builds the template and returns the IDs of the template and the build, nil if the build failed.
+func buildTemplateWithResponse(
+	tb testing.TB,
+	templateAlias string,
+	data api.TemplateBuildStartV2,
+	logHandler BuildLogHandler,
+) *api.Template {
+	tb.Helper()
+
 	ctx, cancel := context.WithTimeout(tb.Context(), BuildTimeout)
 	defer cancel()
 
@@ -88,10 +100,10 @@ func buildTemplate(
 		switch statusResp.JSON200.Status {
 		case api.TemplateBuildStatusReady:
 			tb.Log("Build completed successfully")
-			return true
+			return resp.JSON202
 		case api.TemplateBuildStatusError:
 			tb.Fatalf("Build failed: %v", safe(statusResp.JSON200.Reason))
-			return false
+			return nil
 		}
 
 		time.Sleep(time.Second)
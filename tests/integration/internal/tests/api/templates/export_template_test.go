@@ -0,0 +1,84 @@
+package api_templates
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateBuildExport(t *testing.T) {
+	t.Parallel()
+
+	template := buildTemplateWithResponse(t, "test-ubuntu-export", api.TemplateBuildStartV2{
+		Force:     utils.ToPtr(true),
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "ENV",
+				Args: utils.ToPtr([]string{"EXPORTED", "true"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t))
+	require.NotNil(t, template)
+
+	c := setup.GetAPIClient()
+	resp, err := c.GetTemplatesTemplateIDBuildsBuildIDExport(
+		t.Context(),
+		template.TemplateID,
+		template.BuildID,
+		&api.GetTemplatesTemplateIDBuildsBuildIDExportParams{Tag: utils.ToPtr("exported:latest")},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-tar", resp.Header.Get("Content-Type"))
+
+	var manifest []struct {
+		Config   string   `json:"Config"`
+		RepoTags []string `json:"RepoTags"`
+		Layers   []string `json:"Layers"`
+	}
+
+	tr := tar.NewReader(resp.Body)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		if hdr.Name == "manifest.json" {
+			require.NoError(t, json.NewDecoder(tr).Decode(&manifest))
+		}
+	}
+
+	require.Len(t, manifest, 1)
+	assert.Equal(t, []string{"exported:latest"}, manifest[0].RepoTags)
+	assert.Len(t, manifest[0].Layers, 1)
+}
+
+func TestTemplateBuildExportNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := setup.GetAPIClient()
+	resp, err := c.GetTemplatesTemplateIDBuildsBuildIDExportWithResponse(
+		t.Context(),
+		"nonexistent",
+		"00000000-0000-0000-0000-000000000000",
+		nil,
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
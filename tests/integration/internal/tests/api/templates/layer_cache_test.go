@@ -0,0 +1,67 @@
+package api_templates
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateLayerCache(t *testing.T) {
+	t.Parallel()
+
+	template := buildTemplateWithResponse(t, "test-ubuntu-layer-cache", api.TemplateBuildStartV2{
+		Force:     utils.ToPtr(true),
+		FromImage: utils.ToPtr("ubuntu:22.04"),
+		Steps: utils.ToPtr([]api.TemplateStep{
+			{
+				Type: "RUN",
+				Args: utils.ToPtr([]string{"echo 'cached layer'"}),
+			},
+		}),
+	}, defaultBuildLogHandler(t))
+	require.NotNil(t, template)
+
+	c := setup.GetAPIClient()
+	listResp, err := c.GetTemplatesTemplateIDCacheWithResponse(t.Context(), template.TemplateID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	require.NotEmpty(t, *listResp.JSON200)
+
+	layer := (*listResp.JSON200)[0]
+	assert.NotEmpty(t, layer.Hash)
+	assert.NotEmpty(t, layer.BuildID)
+
+	deleteResp, err := c.DeleteTemplatesTemplateIDCacheWithResponse(
+		t.Context(),
+		template.TemplateID,
+		&api.DeleteTemplatesTemplateIDCacheParams{Hash: utils.ToPtr([]string{layer.Hash})},
+		setup.WithAPIKey(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode())
+	require.NotNil(t, deleteResp.JSON200)
+	assert.Equal(t, []string{layer.Hash}, deleteResp.JSON200.Hashes)
+
+	listResp, err = c.GetTemplatesTemplateIDCacheWithResponse(t.Context(), template.TemplateID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	for _, l := range *listResp.JSON200 {
+		assert.NotEqual(t, layer.Hash, l.Hash)
+	}
+}
+
+func TestTemplateLayerCacheNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := setup.GetAPIClient()
+	resp, err := c.GetTemplatesTemplateIDCacheWithResponse(t.Context(), "nonexistent", setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+}
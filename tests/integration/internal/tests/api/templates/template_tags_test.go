@@ -0,0 +1,96 @@
+package api_templates
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/api"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+func TestTemplateTags(t *testing.T) {
+	t.Parallel()
+
+	alias := "test-ubuntu-tags"
+	buildData := func(value string) api.TemplateBuildStartV2 {
+		return api.TemplateBuildStartV2{
+			Force:     utils.ToPtr(true),
+			FromImage: utils.ToPtr("ubuntu:22.04"),
+			Steps: utils.ToPtr([]api.TemplateStep{
+				{
+					Type: "ENV",
+					Args: utils.ToPtr([]string{"VERSION", value}),
+				},
+			}),
+		}
+	}
+
+	first := buildTemplateWithResponse(t, alias, buildData("1"), defaultBuildLogHandler(t))
+	require.NotNil(t, first)
+	second := buildTemplateWithResponse(t, alias, buildData("2"), defaultBuildLogHandler(t))
+	require.NotNil(t, second)
+	require.Equal(t, first.TemplateID, second.TemplateID)
+
+	c := setup.GetAPIClient()
+
+	// Tag the first build
+	tagResp, err := c.PutTemplatesTemplateIDTagsTagWithResponse(t.Context(), first.TemplateID, "stable", api.TemplateTagAssign{BuildID: first.BuildID}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, tagResp.StatusCode())
+	require.NotNil(t, tagResp.JSON200)
+	assert.Equal(t, first.BuildID, tagResp.JSON200.BuildID)
+
+	sbxResp, err := c.PostSandboxesWithResponse(t.Context(), api.NewSandbox{TemplateID: alias + ":stable"}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, sbxResp.StatusCode())
+	require.NotNil(t, sbxResp.JSON201)
+	t.Cleanup(func() {
+		c.DeleteSandboxesSandboxIDWithResponse(t.Context(), sbxResp.JSON201.SandboxID, setup.WithAPIKey())
+	})
+	assert.Equal(t, first.TemplateID, sbxResp.JSON201.TemplateID)
+
+	// Promote the tag to the second build
+	tagResp, err = c.PutTemplatesTemplateIDTagsTagWithResponse(t.Context(), first.TemplateID, "stable", api.TemplateTagAssign{BuildID: second.BuildID}, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, tagResp.StatusCode())
+	require.NotNil(t, tagResp.JSON200)
+	assert.Equal(t, second.BuildID, tagResp.JSON200.BuildID)
+
+	listResp, err := c.GetTemplatesTemplateIDTagsWithResponse(t.Context(), first.TemplateID, setup.WithAPIKey())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	require.Len(t, *listResp.JSON200, 1)
+	assert.Equal(t, "stable", (*listResp.JSON200)[0].Tag)
+	assert.Equal(t, second.BuildID, (*listResp.JSON200)[0].BuildID)
+
+	deleteResp, err := c.DeleteTemplatesTemplateIDTagsTagWithResponse(t.Context(), first.TemplateID, "stable", setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode())
+
+	sbxResp, err = c.PostSandboxesWithResponse(t.Context(), api.NewSandbox{TemplateID: alias + ":stable"}, setup.WithAPIKey())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, sbxResp.StatusCode())
+}
+
+func TestTemplateTagsInvalid(t *testing.T) {
+	t.Parallel()
+
+	c := setup.GetAPIClient()
+
+	t.Run("reserved tag", func(t *testing.T) {
+		resp, err := c.PutTemplatesTemplateIDTagsTagWithResponse(t.Context(), setup.SandboxTemplateID, "latest", api.TemplateTagAssign{BuildID: "00000000-0000-0000-0000-000000000000"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("unknown build", func(t *testing.T) {
+		resp, err := c.PutTemplatesTemplateIDTagsTagWithResponse(t.Context(), setup.SandboxTemplateID, "stable", api.TemplateTagAssign{BuildID: "00000000-0000-0000-0000-000000000000"}, setup.WithAPIKey())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
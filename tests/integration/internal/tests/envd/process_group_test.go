@@ -0,0 +1,89 @@
+package envd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestProcessGroupSignal(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	group := "task"
+	start := func(command string, labels map[string]string) {
+		startProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+			Process: bashProcess(command),
+			Group:   &group,
+			Labels:  labels,
+		})
+	}
+
+	// The background children are in the process group of the started process
+	start("sleep 1201 & sleep 1201", map[string]string{"step": "first"})
+	start("sleep 1202 & sleep 1202", map[string]string{"step": "second"})
+
+	list := func(selector *process.ProcessGroupSelector) []*process.ProcessInfo {
+		return listProcesses(t, ctx, sbx.SandboxID, envdClient, selector)
+	}
+
+	assert.Len(t, list(&process.ProcessGroupSelector{Group: &group}), 2)
+
+	second := list(&process.ProcessGroupSelector{Labels: map[string]string{"step": "second"}})
+	require.Len(t, second, 1)
+	assert.Equal(t, group, second[0].GetGroup())
+	assert.Equal(t, map[string]string{"step": "second"}, second[0].GetLabels())
+
+	signalReq := connect.NewRequest(&process.SendGroupSignalRequest{
+		Selector: &process.ProcessGroupSelector{Group: &group},
+		Signal:   process.Signal_SIGNAL_SIGKILL,
+	})
+	setup.SetSandboxHeader(signalReq.Header(), sbx.SandboxID)
+	setup.SetUserHeader(signalReq.Header(), "user")
+
+	signalResp, err := envdClient.ProcessClient.SendGroupSignal(ctx, signalReq)
+	require.NoError(t, err)
+	assert.Len(t, signalResp.Msg.GetPids(), 2)
+
+	require.Eventually(t, func() bool {
+		return len(list(&process.ProcessGroupSelector{Group: &group})) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The background children were killed with the group
+	err = utils.ExecCommand(t, ctx, sbx, envdClient, "/bin/bash", "-c", "! pgrep -f 'sleep 120[12]'")
+	require.NoError(t, err)
+}
+
+func TestProcessGroupSignalEmptySelector(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	req := connect.NewRequest(&process.SendGroupSignalRequest{
+		Selector: &process.ProcessGroupSelector{},
+		Signal:   process.Signal_SIGNAL_SIGKILL,
+	})
+	setup.SetSandboxHeader(req.Header(), sbx.SandboxID)
+	setup.SetUserHeader(req.Header(), "user")
+
+	_, err := envdClient.ProcessClient.SendGroupSignal(ctx, req)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,112 @@
+package envd
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+)
+
+// bashProcess returns the config of the command run in the login shell.
+func bashProcess(command string) *process.ProcessConfig {
+	return &process.ProcessConfig{
+		Cmd:  "/bin/bash",
+		Args: []string{"-l", "-c", command},
+	}
+}
+
+// startProcess starts the process and returns the stream after the start event, the stream is closed when the test ends.
+func startProcess(t *testing.T, ctx context.Context, sandboxID string, envdClient *setup.EnvdClient, start *process.StartRequest) (*connect.ServerStreamForClient[process.StartResponse], uint32) {
+	t.Helper()
+
+	req := connect.NewRequest(start)
+	setup.SetSandboxHeader(req.Header(), sandboxID)
+	setup.SetUserHeader(req.Header(), "user")
+
+	stream, err := envdClient.ProcessClient.Start(ctx, req)
+	require.NoError(t, err)
+	t.Cleanup(func() { stream.Close() })
+
+	require.True(t, stream.Receive(), "process stream ended before the start event: %v", stream.Err())
+	started := stream.Msg().GetEvent().GetStart()
+	require.NotNil(t, started)
+
+	return stream, started.GetPid()
+}
+
+// startDetachedProcess starts the process and disconnects right after it started, the process keeps running.
+func startDetachedProcess(t *testing.T, ctx context.Context, sandboxID string, envdClient *setup.EnvdClient, start *process.StartRequest) uint32 {
+	t.Helper()
+
+	startCtx, startCancel := context.WithCancel(ctx)
+	defer startCancel()
+
+	stream, pid := startProcess(t, startCtx, sandboxID, envdClient, start)
+	startCancel()
+	stream.Close()
+
+	return pid
+}
+
+// runProcess starts the process and waits for its end event.
+func runProcess(t *testing.T, ctx context.Context, sandboxID string, envdClient *setup.EnvdClient, start *process.StartRequest) *process.ProcessEvent_EndEvent {
+	t.Helper()
+
+	stream, _ := startProcess(t, ctx, sandboxID, envdClient, start)
+
+	for stream.Receive() {
+		if end := stream.Msg().GetEvent().GetEnd(); end != nil {
+			return end
+		}
+	}
+	require.NoError(t, stream.Err())
+	t.Fatal("process stream ended without the end event")
+
+	return nil
+}
+
+// listProcesses returns the running processes matching the selector, all the processes are returned for a nil selector.
+func listProcesses(t *testing.T, ctx context.Context, sandboxID string, envdClient *setup.EnvdClient, selector *process.ProcessGroupSelector) []*process.ProcessInfo {
+	t.Helper()
+
+	req := connect.NewRequest(&process.ListRequest{Selector: selector})
+	setup.SetSandboxHeader(req.Header(), sandboxID)
+	setup.SetUserHeader(req.Header(), "user")
+
+	resp, err := envdClient.ProcessClient.List(ctx, req)
+	require.NoError(t, err)
+
+	return resp.Msg.GetProcesses()
+}
+
+// killTaggedProcess sends SIGKILL to the process with the tag.
+func killTaggedProcess(t *testing.T, ctx context.Context, sandboxID string, envdClient *setup.EnvdClient, tag string) {
+	t.Helper()
+
+	req := connect.NewRequest(&process.SendSignalRequest{
+		Process: &process.ProcessSelector{
+			Selector: &process.ProcessSelector_Tag{Tag: tag},
+		},
+		Signal: process.Signal_SIGNAL_SIGKILL,
+	})
+	setup.SetSandboxHeader(req.Header(), sandboxID)
+	setup.SetUserHeader(req.Header(), "user")
+
+	_, err := envdClient.ProcessClient.SendSignal(ctx, req)
+	require.NoError(t, err)
+}
+
+// hasTaggedProcess reports whether a process with the tag is listed.
+func hasTaggedProcess(processes []*process.ProcessInfo, tag string) bool {
+	for _, info := range processes {
+		if info.GetTag() == tag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+package envd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestProcessMemoryLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	// tail keeps the whole line without a newline in memory
+	end := runProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("head -c 512M /dev/zero | tail"),
+		Limits: &process.ProcessLimits{
+			MemoryMaxBytes: sharedUtils.ToPtr[uint64](64 << 20),
+		},
+	})
+
+	assert.False(t, end.GetExited())
+	assert.Equal(t, process.ProcessLimit_PROCESS_LIMIT_MEMORY, end.GetLimitExceeded())
+
+	// The rest of the sandbox keeps running
+	err := utils.ExecCommand(t, ctx, sbx, envdClient, "echo", "alive")
+	require.NoError(t, err)
+}
+
+func TestProcessTimeoutLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	start := time.Now()
+	// The background child is killed too, otherwise it would keep the output open
+	end := runProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("sleep 30 & sleep 30"),
+		Limits: &process.ProcessLimits{
+			TimeoutMs: sharedUtils.ToPtr[uint32](1000),
+		},
+	})
+
+	assert.Less(t, time.Since(start), 15*time.Second)
+	assert.Equal(t, process.ProcessLimit_PROCESS_LIMIT_TIMEOUT, end.GetLimitExceeded())
+}
+
+func TestProcessWithoutLimitExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	end := runProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("exit 3"),
+		Limits: &process.ProcessLimits{
+			MemoryMaxBytes:  sharedUtils.ToPtr[uint64](64 << 20),
+			CpuWeight:       sharedUtils.ToPtr[uint32](50),
+			CpuQuotaPercent: sharedUtils.ToPtr[uint32](50),
+			PidsMax:         sharedUtils.ToPtr[uint32](16),
+			TimeoutMs:       sharedUtils.ToPtr[uint32](10_000),
+		},
+	})
+
+	assert.True(t, end.GetExited())
+	assert.Equal(t, int32(3), end.GetExitCode())
+	assert.Nil(t, end.LimitExceeded)
+}
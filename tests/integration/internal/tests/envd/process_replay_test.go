@@ -0,0 +1,75 @@
+package envd
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestProcessOutputReplay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	tag := "replay"
+	startDetachedProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("for i in 1 2 3 4 5; do echo line$i; sleep 0.5; done"),
+		Tag:     &tag,
+	})
+
+	// Connect after the process exited
+	time.Sleep(5 * time.Second)
+
+	connectReq := connect.NewRequest(&process.ConnectRequest{
+		Process: &process.ProcessSelector{
+			Selector: &process.ProcessSelector_Tag{Tag: tag},
+		},
+		Offset: sharedUtils.ToPtr[uint64](0),
+	})
+	setup.SetSandboxHeader(connectReq.Header(), sbx.SandboxID)
+	setup.SetUserHeader(connectReq.Header(), "user")
+
+	connectStream, err := envdClient.ProcessClient.Connect(ctx, connectReq)
+	require.NoError(t, err)
+	defer connectStream.Close()
+
+	var output strings.Builder
+	var end *process.ProcessEvent_EndEvent
+	offset := uint64(0)
+	for connectStream.Receive() {
+		event := connectStream.Msg().GetEvent()
+
+		if data := event.GetData(); data != nil {
+			assert.Equal(t, offset, data.GetOffset())
+			offset += uint64(len(data.GetStdout()))
+
+			output.Write(data.GetStdout())
+		}
+
+		if event.GetEnd() != nil {
+			end = event.GetEnd()
+
+			break
+		}
+	}
+	require.NoError(t, connectStream.Err())
+
+	assert.Equal(t, "line1\nline2\nline3\nline4\nline5\n", output.String())
+	require.NotNil(t, end)
+	assert.True(t, end.GetExited())
+	assert.Equal(t, int32(0), end.GetExitCode())
+}
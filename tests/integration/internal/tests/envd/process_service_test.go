@@ -0,0 +1,138 @@
+package envd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestProcessServiceRestart(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	tag := "service"
+	// The service continues running after the stream is closed
+	firstPid := startDetachedProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("sleep 1; exit 3"),
+		Tag:     &tag,
+		Service: &process.ServiceConfig{
+			Restart:   process.RestartPolicy_RESTART_POLICY_ON_FAILURE,
+			BackoffMs: sharedUtils.ToPtr[uint32](100),
+		},
+	})
+
+	list := func() []*process.ProcessInfo {
+		return listProcesses(t, ctx, sbx.SandboxID, envdClient, nil)
+	}
+
+	var restarted *process.ProcessInfo
+	require.Eventually(t, func() bool {
+		for _, info := range list() {
+			if info.GetTag() == tag && info.GetPid() != firstPid &&
+				info.GetServiceState().GetStatus() == process.ServiceStatus_SERVICE_STATUS_RUNNING {
+				restarted = info
+
+				return true
+			}
+		}
+
+		return false
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.Equal(t, process.RestartPolicy_RESTART_POLICY_ON_FAILURE, restarted.GetService().GetRestart())
+	assert.GreaterOrEqual(t, restarted.GetServiceState().GetRestarts(), uint32(1))
+	assert.Equal(t, int32(3), restarted.GetServiceState().GetLastExitCode())
+
+	killTaggedProcess(t, ctx, sbx.SandboxID, envdClient, tag)
+
+	// The signalled service isn't restarted
+	require.Eventually(t, func() bool {
+		return !hasTaggedProcess(list(), tag)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	time.Sleep(time.Second)
+	for _, info := range list() {
+		assert.NotEqual(t, tag, info.GetTag())
+	}
+}
+
+func TestProcessServiceInvalidConfig(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	req := connect.NewRequest(&process.StartRequest{
+		Process: &process.ProcessConfig{
+			Cmd: "/bin/true",
+		},
+		Service: &process.ServiceConfig{},
+	})
+	setup.SetSandboxHeader(req.Header(), sbx.SandboxID)
+	setup.SetUserHeader(req.Header(), "user")
+
+	stream, err := envdClient.ProcessClient.Start(ctx, req)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+}
+
+func TestProcessServiceStopInBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	tag := "service-backoff"
+	startDetachedProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("exit 1"),
+		Tag:     &tag,
+		Service: &process.ServiceConfig{
+			Restart:   process.RestartPolicy_RESTART_POLICY_ALWAYS,
+			BackoffMs: sharedUtils.ToPtr[uint32](30_000),
+		},
+	})
+
+	list := func() []*process.ProcessInfo {
+		return listProcesses(t, ctx, sbx.SandboxID, envdClient, nil)
+	}
+
+	require.Eventually(t, func() bool {
+		for _, info := range list() {
+			if info.GetTag() == tag && info.GetServiceState().GetStatus() == process.ServiceStatus_SERVICE_STATUS_BACKOFF {
+				return true
+			}
+		}
+
+		return false
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The service waiting for its restart has no running process, it's still stopped by its tag
+	killTaggedProcess(t, ctx, sbx.SandboxID, envdClient, tag)
+
+	require.Eventually(t, func() bool {
+		return !hasTaggedProcess(list(), tag)
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,34 @@
+package envd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/envd/process"
+	"github.com/e2b-dev/infra/tests/integration/internal/setup"
+	"github.com/e2b-dev/infra/tests/integration/internal/utils"
+)
+
+func TestProcessEndUsage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	client := setup.GetAPIClient()
+	sbx := utils.SetupSandboxWithCleanup(t, client)
+
+	envdClient := setup.GetEnvdClient(t, ctx)
+
+	end := runProcess(t, ctx, sbx.SandboxID, envdClient, &process.StartRequest{
+		Process: bashProcess("head -c 10000000 /dev/urandom | sha256sum; sleep 1"),
+	})
+
+	usage := end.GetUsage()
+	require.NotNil(t, usage)
+	assert.GreaterOrEqual(t, usage.GetWallTimeMs(), uint64(1000))
+	assert.Positive(t, usage.GetCpuUserMs()+usage.GetCpuSystemMs())
+	assert.Positive(t, usage.GetMaxRssBytes())
+}